@@ -31,6 +31,10 @@ import (
 var (
 	configfile = flag.StringP("config", "f", "", "Configuration `file`")
 	help       = flag.BoolP("help", "h", false, "Display available options")
+
+	exportSnapshot = flag.String("export-snapshot", "", "Export the snapshot of the last irreversible block to `file` and exit")
+	importSnapshot = flag.String("import-snapshot", "", "Import the snapshot `file` into empty databases before starting")
+	snapshotBlock  = flag.String("snapshot-block", "", "Trusted `hash` of the block of the imported snapshot")
 )
 
 func initMetrics(metricsConfig *common.MetricsConfig) error {
@@ -88,6 +92,22 @@ func main() {
 		ilog.Errorf("init metrics failed. err=%v", err)
 	}
 
+	if *exportSnapshot != "" {
+		if err := iserver.ExportSnapshot(conf, *exportSnapshot); err != nil {
+			ilog.Fatalf("Export snapshot failed: %v", err)
+		}
+		ilog.Stop()
+		return
+	}
+	if *importSnapshot != "" {
+		if *snapshotBlock == "" {
+			ilog.Fatalf("Import snapshot failed: --snapshot-block is required")
+		}
+		if err := iserver.ImportSnapshot(conf, *importSnapshot, *snapshotBlock); err != nil {
+			ilog.Fatalf("Import snapshot failed: %v", err)
+		}
+	}
+
 	iserver := iserver.New(conf)
 	iserver.Start()
 
@@ -0,0 +1,43 @@
+package common
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// The node clock is the local time plus an offset. The offset is always 0, except that the dev consensus warps
+// the clock forward for testing the time related logic, such as the delay transactions.
+
+var (
+	clockOffset int64
+	clockWarped = make(chan struct{})
+	clockMu     sync.Mutex
+)
+
+// Now returns the time of the node clock in nanoseconds.
+func Now() int64 {
+	return time.Now().UnixNano() + atomic.LoadInt64(&clockOffset)
+}
+
+// ClockOffset returns how far the node clock is ahead of the local time.
+func ClockOffset() time.Duration {
+	return time.Duration(atomic.LoadInt64(&clockOffset))
+}
+
+// WarpClock moves the node clock forward by d and returns the new offset.
+func WarpClock(d time.Duration) time.Duration {
+	clockMu.Lock()
+	defer clockMu.Unlock()
+	offset := atomic.AddInt64(&clockOffset, int64(d))
+	close(clockWarped)
+	clockWarped = make(chan struct{})
+	return time.Duration(offset)
+}
+
+// ClockWarped returns a channel closed when the node clock is warped next time.
+func ClockWarped() <-chan struct{} {
+	clockMu.Lock()
+	defer clockMu.Unlock()
+	return clockWarped
+}
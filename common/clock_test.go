@@ -0,0 +1,25 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWarpClock(t *testing.T) {
+	warped := ClockWarped()
+	before := Now()
+	offset := WarpClock(time.Hour)
+	defer WarpClock(-time.Hour)
+
+	assert.Equal(t, time.Hour, offset)
+	assert.Equal(t, time.Hour, ClockOffset())
+	assert.True(t, Now() >= before+time.Hour.Nanoseconds())
+	select {
+	case <-warped:
+	default:
+		t.Fatal("warped channel not closed")
+	}
+	assert.NotEqual(t, warped, ClockWarped())
+}
@@ -45,7 +45,10 @@ type GenesisConfig struct {
 
 // DBConfig config of the database
 type DBConfig struct {
-	LdbPath string
+	LdbPath      string
+	Archive      bool
+	PruneDepth   int64
+	AccountIndex bool
 }
 
 // VMConfig config of the v8vm
@@ -72,8 +75,11 @@ type P2PConfig struct {
 type RPCConfig struct {
 	GatewayAddr  string
 	GRPCAddr     string
+	AdminAddr    string
 	AllowOrigins []string
 	TryTx        bool
+	// MaxReplayBlocks limits the blocks replayed by a subscription, the default is used if it's 0.
+	MaxReplayBlocks int64
 }
 
 // FileLogConfig is the config for filewriter of ilog.
@@ -116,18 +122,38 @@ type VersionConfig struct {
 	ProtocolVersion string
 }
 
+// SyncConfig is the config of synchronizer.
+type SyncConfig struct {
+	HeaderOnly bool
+}
+
+// ConsensusConfig is the config of consensus.
+type ConsensusConfig struct {
+	Engine string
+}
+
+// ForkConfig is the config of the block numbers from which protocol changes take effect.
+// A change is active from the genesis block if its number is zero.
+type ForkConfig struct {
+	StateRoot     int64
+	ActionReceipt int64
+}
+
 // Config provide all configuration for the application
 type Config struct {
-	ACC     *ACCConfig
-	Genesis string
-	VM      *VMConfig
-	DB      *DBConfig
-	P2P     *P2PConfig
-	RPC     *RPCConfig
-	Log     *LogConfig
-	Metrics *MetricsConfig
-	Debug   *DebugConfig
-	Version *VersionConfig
+	ACC       *ACCConfig
+	Genesis   string
+	VM        *VMConfig
+	DB        *DBConfig
+	P2P       *P2PConfig
+	RPC       *RPCConfig
+	Log       *LogConfig
+	Metrics   *MetricsConfig
+	Debug     *DebugConfig
+	Version   *VersionConfig
+	Sync      *SyncConfig
+	Consensus *ConsensusConfig
+	Fork      *ForkConfig
 }
 
 // LoadYamlAsViper load yaml file as viper object
@@ -0,0 +1,24 @@
+package common
+
+// The fork numbers are chain parameters, they are set once from the config before the chain is loaded.
+
+var forks ForkConfig
+
+// SetForks sets the fork numbers of the chain, nil activates every change from the genesis block.
+func SetForks(c *ForkConfig) {
+	if c == nil {
+		forks = ForkConfig{}
+		return
+	}
+	forks = *c
+}
+
+// IsStateRootFork returns whether the block of the number commits to the state root.
+func IsStateRootFork(number int64) bool {
+	return number >= forks.StateRoot
+}
+
+// IsActionReceiptFork returns whether the receipts of the txs in the block of the number have action receipts.
+func IsActionReceiptFork(number int64) bool {
+	return number >= forks.ActionReceipt
+}
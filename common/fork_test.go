@@ -0,0 +1,23 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForks(t *testing.T) {
+	defer SetForks(nil)
+	assert.True(t, IsStateRootFork(0))
+	assert.True(t, IsActionReceiptFork(0))
+
+	SetForks(&ForkConfig{StateRoot: 100, ActionReceipt: 200})
+	assert.False(t, IsStateRootFork(99))
+	assert.True(t, IsStateRootFork(100))
+	assert.False(t, IsActionReceiptFork(199))
+	assert.True(t, IsActionReceiptFork(200))
+
+	SetForks(nil)
+	assert.True(t, IsStateRootFork(1))
+	assert.True(t, IsActionReceiptFork(1))
+}
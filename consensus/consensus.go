@@ -1,8 +1,14 @@
 package consensus
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/consensus/dev"
 	"github.com/iost-official/go-iost/consensus/pob"
+	"github.com/iost-official/go-iost/core/block"
 	"github.com/iost-official/go-iost/core/blockcache"
 	"github.com/iost-official/go-iost/core/global"
 	"github.com/iost-official/go-iost/core/txpool"
@@ -16,12 +22,57 @@ type Type uint8
 const (
 	_ Type = iota
 	Pob
+	Dev
 )
 
-// Consensus is a consensus server.
+// Consensus is a consensus engine. It schedules the production of blocks, validates the blocks to link them
+// to the block cache, and decides which blocks are irreversible.
 type Consensus interface {
 	Start() error
 	Stop()
+	// VerifyBlock verifies the block on its linked parent, and tags the state executed by the block in the verify db.
+	VerifyBlock(blk *block.Block, parent *block.Block) error
+	// Finalize decides the irreversible blocks after the node is linked, and flushes them to the block chain.
+	Finalize(node *blockcache.BlockCacheNode)
+	// Witnesses returns the witnesses producing blocks currently.
+	Witnesses() []string
+}
+
+// Sealer is a consensus engine producing blocks on demand, which is only the dev consensus.
+type Sealer interface {
+	// Seal produces a block with the pending transactions at once.
+	Seal() (*block.Block, error)
+	// WarpTime moves the block time forward by d, and returns how far it is ahead of the local time.
+	WarpTime(d time.Duration) (time.Duration, error)
+}
+
+// Scheduler is a consensus engine scheduling the witnesses to produce blocks in slots, which is only pob.
+type Scheduler interface {
+	// Schedule returns the witnesses scheduled in the next num slots from the current one.
+	Schedule(num int) []pob.SlotSchedule
+	// ProducerStats returns the production statistics of the witnesses.
+	ProducerStats() map[string]pob.ProducerStat
+}
+
+// Evidencer is a consensus engine detecting the witnesses signing conflicting blocks, which is only pob.
+type Evidencer interface {
+	// DoubleSignEvidences returns the evidences of double signing saved by the node.
+	DoubleSignEvidences() ([]*block.DoubleSignEvidence, error)
+}
+
+// TypeOf returns the type of consensus set by the config, which is pob if not set.
+func TypeOf(conf *common.ConsensusConfig) (Type, error) {
+	if conf == nil {
+		return Pob, nil
+	}
+	switch conf.Engine {
+	case "", "pob":
+		return Pob, nil
+	case "dev":
+		return Dev, nil
+	default:
+		return 0, fmt.Errorf("unknown consensus engine %v", conf.Engine)
+	}
 }
 
 // New returns the different consensus strategy.
@@ -29,6 +80,8 @@ func New(cType Type, account *account.KeyPair, baseVariable global.BaseVariable,
 	switch cType {
 	case Pob:
 		return pob.New(account, baseVariable, blkcache, txPool, service)
+	case Dev:
+		return dev.New(account, baseVariable, blkcache, txPool)
 	default:
 		return pob.New(account, baseVariable, blkcache, txPool, service)
 	}
@@ -0,0 +1,39 @@
+package consensus
+
+import (
+	"testing"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/consensus/dev"
+	"github.com/iost-official/go-iost/consensus/pob"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypeOf(t *testing.T) {
+	cType, err := TypeOf(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, Pob, cType)
+	cType, err = TypeOf(&common.ConsensusConfig{Engine: "dev"})
+	assert.Nil(t, err)
+	assert.Equal(t, Dev, cType)
+	_, err = TypeOf(&common.ConsensusConfig{Engine: "bft"})
+	assert.NotNil(t, err)
+}
+
+func TestEngines(t *testing.T) {
+	var p Consensus = &pob.PoB{}
+	_, ok := p.(Scheduler)
+	assert.True(t, ok)
+	_, ok = p.(Evidencer)
+	assert.True(t, ok)
+	_, ok = p.(Sealer)
+	assert.False(t, ok)
+
+	var d Consensus = &dev.Dev{}
+	_, ok = d.(Sealer)
+	assert.True(t, ok)
+	_, ok = d.(Scheduler)
+	assert.False(t, ok)
+	_, ok = d.(Evidencer)
+	assert.False(t, ok)
+}
@@ -5,7 +5,9 @@ import (
 	"errors"
 	"time"
 
+	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/db"
 )
 
 var (
@@ -15,6 +17,9 @@ var (
 	errNumber     = errors.New("wrong number")
 	errTxHash     = errors.New("wrong txs hash")
 	errMerkleHash = errors.New("wrong tx receipt merkle hash")
+	errStateRoot  = errors.New("wrong state root")
+	errVersion    = errors.New("wrong version")
+	errReplacedTx = errors.New("tx and its replacement in block")
 	// errTxReceipt  = errors.New("wrong tx receipt")
 
 	// TxExecTimeLimit the maximum verify execution time of a transaction
@@ -24,7 +29,7 @@ var (
 // VerifyBlockHead verifies the block head.
 func VerifyBlockHead(blk *block.Block, parentBlock *block.Block, lib *block.Block) error {
 	bh := blk.Head
-	if bh.Time > time.Now().UnixNano() {
+	if bh.Time > common.Now() {
 		return errFutureBlk
 	}
 	if bh.Time < lib.Head.Time {
@@ -42,6 +47,29 @@ func VerifyBlockHead(blk *block.Block, parentBlock *block.Block, lib *block.Bloc
 	if !bytes.Equal(blk.CalculateTxReceiptMerkleHash(), bh.TxReceiptMerkleHash) {
 		return errMerkleHash
 	}
+	if bh.Version != block.VersionOf(bh.Number) {
+		return errVersion
+	}
+	if bh.Version >= block.V1 && len(bh.StateRoot) != db.StateHashLength || bh.Version < block.V1 && len(bh.StateRoot) != 0 {
+		return errStateRoot
+	}
+
+	return nil
+}
 
+// VerifyBlockTxs verifies that the block doesn't contain both a tx and its replacement, only one of them should be executed.
+func VerifyBlockTxs(blk *block.Block) error {
+	replaceSet := make(map[string][]byte, len(blk.Txs))
+	for i, t := range blk.Txs {
+		if i == 0 || t.IsDefer() {
+			// base tx and defer tx are generated by the chain
+			continue
+		}
+		key := t.ReplaceKey()
+		if h, ok := replaceSet[key]; ok && !bytes.Equal(h, t.Hash()) {
+			return errReplacedTx
+		}
+		replaceSet[key] = t.Hash()
+	}
 	return nil
 }
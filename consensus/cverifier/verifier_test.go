@@ -4,11 +4,13 @@ import (
 	"testing"
 
 	"github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/assert"
 
 	"time"
 
 	"github.com/iost-official/go-iost/core/block"
 	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/db"
 	. "github.com/smartystreets/goconvey/convey"
 )
 
@@ -35,6 +37,7 @@ func TestVerifyBlockHead(t *testing.T) {
 				Time:                stamp,
 				TxMerkleHash:        []byte{},
 				TxReceiptMerkleHash: []byte{},
+				StateRoot:           make([]byte, db.StateHashLength),
 			},
 		}
 		convey.Convey("Pass", func() {
@@ -73,5 +76,32 @@ func TestVerifyBlockHead(t *testing.T) {
 			err = VerifyBlockHead(blk, parentBlk, chainTop)
 			convey.So(err, convey.ShouldEqual, errTxHash)
 		})
+
+		convey.Convey("Wrong state root", func() {
+			blk.Head.StateRoot = []byte("fake hash")
+			err := VerifyBlockHead(blk, parentBlk, chainTop)
+			convey.So(err, convey.ShouldEqual, errStateRoot)
+		})
 	})
 }
+
+func TestVerifyBlockTxs(t *testing.T) {
+	now := time.Now().UnixNano()
+	newTx := func(gasRatio int64, sequence int64) *tx.Tx {
+		actions := []*tx.Action{tx.NewAction("contract1", "actionname1", "[]")}
+		t := tx.NewTx(actions, nil, 100000, gasRatio, now+int64(time.Minute), 0)
+		t.Time = now
+		t.Publisher = "alice"
+		t.Sequence = sequence
+		return t
+	}
+	base := newTx(100, 0)
+	blk := &block.Block{Txs: []*tx.Tx{base, newTx(100, 0), newTx(100, 1)}}
+	assert.Nil(t, VerifyBlockTxs(blk))
+
+	blk.Txs = append(blk.Txs, newTx(200, 0))
+	assert.Equal(t, errReplacedTx, VerifyBlockTxs(blk))
+
+	blk.Txs = []*tx.Tx{base, newTx(100, 1), newTx(200, 1)}
+	assert.Equal(t, errReplacedTx, VerifyBlockTxs(blk))
+}
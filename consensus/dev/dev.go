@@ -0,0 +1,290 @@
+package dev
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/consensus/cverifier"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/blockcache"
+	"github.com/iost-official/go-iost/core/global"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/core/txpool"
+	"github.com/iost-official/go-iost/db"
+	"github.com/iost-official/go-iost/ilog"
+	"github.com/iost-official/go-iost/verifier"
+)
+
+// The dev consensus is for the local testing. The node is the only producer, which seals a block as soon as
+// the txpool receives transactions, and every block sealed is irreversible at once. The blocks from the network
+// are not accepted, so a dev chain can not be joined by the other nodes.
+// The block time can be warped forward to test the delay transactions. It warps the node clock, so the txpool
+// releases the delay transactions due and checks the expiration by the warped time, the same as the blocks do.
+// The transactions sent after warping should be stamped with the node clock, which is the time of GetNodeInfo,
+// and iwallet does so.
+
+var (
+	sealInterval = time.Second
+	sealTimeout  = 2 * time.Second
+
+	errWitness = errors.New("block not produced by the dev producer")
+	errParent  = errors.New("parent block not linked")
+	errMode    = errors.New("node is not in normal mode")
+	errWarp    = errors.New("time can only be warped forward")
+)
+
+// Dev is the consensus engine sealing blocks instantly.
+type Dev struct {
+	account      *account.KeyPair
+	baseVariable global.BaseVariable
+	blockCache   blockcache.BlockCache
+	txPool       txpool.TxPool
+	verifyDB     db.MVCCDB
+	produceDB    db.MVCCDB
+	exitSignal   chan struct{}
+	wg           *sync.WaitGroup
+	mu           *sync.Mutex
+}
+
+// New returns a new Dev instance.
+func New(account *account.KeyPair, baseVariable global.BaseVariable, blockCache blockcache.BlockCache, txPool txpool.TxPool) *Dev {
+	d := &Dev{
+		account:      account,
+		baseVariable: baseVariable,
+		blockCache:   blockCache,
+		txPool:       txPool,
+		verifyDB:     baseVariable.StateDB(),
+		produceDB:    baseVariable.StateDB().Fork(),
+		exitSignal:   make(chan struct{}),
+		wg:           new(sync.WaitGroup),
+		mu:           new(sync.Mutex),
+	}
+	// the clock was warped before restarting if the head is ahead of the local time, keep the block time increasing
+	if ahead := time.Duration(blockCache.Head().Head.Time - common.Now()); ahead > 0 {
+		common.WarpClock(ahead)
+	}
+	if err := d.blockCache.Recover(d); err != nil {
+		ilog.Error("Failed to recover blockCache, err: ", err)
+		if err := d.blockCache.NewWAL(baseVariable.Config()); err != nil {
+			ilog.Error(" Failed to NewWAL, err: ", err)
+		}
+	}
+	ilog.Infof("dev consensus, the producer is %v", account.ID)
+	return d
+}
+
+// Start starts sealing blocks.
+func (d *Dev) Start() error {
+	d.wg.Add(1)
+	go d.sealLoop()
+	return nil
+}
+
+// Stop stops sealing blocks.
+func (d *Dev) Stop() {
+	close(d.exitSignal)
+	d.wg.Wait()
+}
+
+// Witnesses returns the dev producer.
+func (d *Dev) Witnesses() []string {
+	return []string{d.account.ID}
+}
+
+// Seal produces a block with the pending transactions at once, even if there is none.
+func (d *Dev) Seal() (*block.Block, error) {
+	if d.baseVariable.Mode() != global.ModeNormal {
+		return nil, errMode
+	}
+	return d.seal(true)
+}
+
+// WarpTime moves the node clock forward by du, and returns how far it is ahead of the local time.
+func (d *Dev) WarpTime(du time.Duration) (time.Duration, error) {
+	if du <= 0 {
+		return 0, errWarp
+	}
+	offset := common.WarpClock(du)
+	ilog.Infof("[dev] time warped by %v, the node clock is %v ahead", du, offset)
+	return offset, nil
+}
+
+// sealLoop seals a block when the txpool receives transactions. The ticker seals the ones pending without
+// notification, such as the transactions of forked blocks.
+func (d *Dev) sealLoop() {
+	defer d.wg.Done()
+	ticker := time.NewTicker(sealInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-d.txPool.PendingNotify():
+		case <-ticker.C:
+		case <-d.exitSignal:
+			return
+		}
+		if d.baseVariable.Mode() != global.ModeNormal {
+			continue
+		}
+		if pTx, _ := d.txPool.PendingTx(); pTx.Size() == 0 {
+			continue
+		}
+		if _, err := d.seal(false); err != nil {
+			ilog.Errorf("[dev] seal block failed, err:%v", err)
+		}
+	}
+}
+
+// seal generates a block with the pending transactions and makes it irreversible. The empty block is discarded
+// unless force is set.
+func (d *Dev) seal(force bool) (*block.Block, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.txPool.Lock()
+	blk, err := d.generateBlock()
+	d.txPool.Release()
+	if err != nil {
+		return nil, err
+	}
+	if len(blk.Txs) == 0 && !force {
+		return nil, nil
+	}
+	ilog.Infof("Seal block - num:%v, txs:%v", blk.Head.Number, len(blk.Txs))
+	node := d.blockCache.Add(blk)
+	if node == nil {
+		return nil, errParent
+	}
+	if err := d.linkBlock(node); err != nil {
+		return nil, err
+	}
+	return blk, nil
+}
+
+func (d *Dev) generateBlock() (*block.Block, error) {
+	pTx, head := d.txPool.PendingTx()
+	topBlock := head.Block
+	now := common.Now()
+	if now <= topBlock.Head.Time {
+		now = topBlock.Head.Time + 1
+	}
+	blk := &block.Block{
+		Head: &block.BlockHead{
+			Version:    block.VersionOf(topBlock.Head.Number + 1),
+			ParentHash: topBlock.HeadHash(),
+			Info:       make([]byte, 0),
+			Number:     topBlock.Head.Number + 1,
+			Witness:    d.account.ID,
+			Time:       now,
+		},
+		Txs:      []*tx.Tx{},
+		Receipts: []*tx.TxReceipt{},
+	}
+	d.produceDB.Checkout(string(topBlock.HeadHash()))
+	v := verifier.Verifier{}
+	dropList, errs, err := v.Gen(blk, topBlock, d.produceDB, pTx, &verifier.Config{
+		Mode:        0,
+		Timeout:     sealTimeout,
+		TxTimeLimit: cverifier.TxExecTimeLimit,
+	})
+	// the pool is locked while sealing, the dropped txs are removed after it's released,
+	// so they are not tried and reported as dropped again by the next seal.
+	go d.removeDroppedTxs(dropList, errs)
+	if err != nil {
+		return nil, err
+	}
+	blk.Head.TxMerkleHash = blk.CalculateTxMerkleHash()
+	blk.Head.TxReceiptMerkleHash = blk.CalculateTxReceiptMerkleHash()
+	if err := blk.CalculateHeadHash(); err != nil {
+		return nil, err
+	}
+	blk.Sign = d.account.Sign(blk.HeadHash())
+	if err := d.produceDB.Tag(string(blk.HeadHash())); err != nil {
+		return nil, err
+	}
+	return blk, nil
+}
+
+// removeDroppedTxs removes the dropped txs from the pending pool and the journal, the removal posts the TxDropped event.
+func (d *Dev) removeDroppedTxs(dropList []*tx.Tx, errs []error) {
+	for i, t := range dropList {
+		reason := "dropped in sealing block"
+		if errs[i] != nil {
+			reason = errs[i].Error()
+		}
+		d.txPool.RemoveTx(t.Hash(), reason)
+	}
+}
+
+// linkBlock verifies the block if it is not sealed by the node, then links it and flushes it as irreversible.
+func (d *Dev) linkBlock(node *blockcache.BlockCacheNode) error {
+	parent := node.GetParent()
+	if parent == nil || parent.Type != blockcache.Linked {
+		return errParent
+	}
+	if err := d.verifyBlock(node.Block, parent.Block); err != nil {
+		d.blockCache.Del(node)
+		return err
+	}
+	d.txPool.AddLinkedNode(node)
+	d.blockCache.Link(node)
+	d.finalize(node)
+	return nil
+}
+
+// VerifyBlock verifies the block on its linked parent, and tags the state executed by the block in the verify db.
+func (d *Dev) VerifyBlock(blk *block.Block, parent *block.Block) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.verifyBlock(blk, parent)
+}
+
+// Finalize flushes the linked node as irreversible at once, since the node is the only producer.
+func (d *Dev) Finalize(node *blockcache.BlockCacheNode) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.finalize(node)
+}
+
+func (d *Dev) verifyBlock(blk *block.Block, parent *block.Block) error {
+	if blk.Head.Witness != d.account.ID {
+		return errWitness
+	}
+	if d.verifyDB.Checkout(string(blk.HeadHash())) {
+		return nil
+	}
+	d.verifyDB.Checkout(string(blk.Head.ParentHash))
+	if err := cverifier.VerifyBlockHead(blk, parent, d.blockCache.LinkedRoot().Block); err != nil {
+		return err
+	}
+	if err := cverifier.VerifyBlockTxs(blk); err != nil {
+		return err
+	}
+	v := verifier.Verifier{}
+	err := v.Verify(blk, parent, d.verifyDB, &verifier.Config{
+		Mode:        0,
+		Timeout:     sealTimeout,
+		TxTimeLimit: cverifier.TxExecTimeLimit,
+	})
+	if err != nil {
+		return err
+	}
+	return d.verifyDB.Tag(string(blk.HeadHash()))
+}
+
+func (d *Dev) finalize(node *blockcache.BlockCacheNode) {
+	d.blockCache.Flush(node)
+}
+
+// RecoverBlock recovers the block from the WAL of block cache.
+func (d *Dev) RecoverBlock(blk *block.Block, witnessList blockcache.WitnessList) error {
+	if _, err := d.blockCache.Find(blk.HeadHash()); err == nil {
+		return nil
+	}
+	node := d.blockCache.AddWithWit(blk, witnessList)
+	if node == nil {
+		return errParent
+	}
+	return d.linkBlock(node)
+}
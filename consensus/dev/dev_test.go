@@ -0,0 +1,75 @@
+package dev
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/blockcache"
+	"github.com/iost-official/go-iost/core/global"
+	"github.com/iost-official/go-iost/core/mocks"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestDev(t *testing.T, ctl *gomock.Controller, mode global.TMode) *Dev {
+	kp, err := account.NewKeyPair(nil, crypto.Ed25519)
+	assert.Nil(t, err)
+	bv := core_mock.NewMockBaseVariable(ctl)
+	bv.EXPECT().Mode().AnyTimes().Return(mode)
+	return &Dev{
+		account:      kp,
+		baseVariable: bv,
+	}
+}
+
+func TestDevWitnesses(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	d := newTestDev(t, ctl, global.ModeNormal)
+	assert.Equal(t, []string{d.account.ID}, d.Witnesses())
+}
+
+func TestDevSealMode(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	d := newTestDev(t, ctl, global.ModeSync)
+	_, err := d.Seal()
+	assert.Equal(t, errMode, err)
+}
+
+func TestDevVerifyBlock(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	d := newTestDev(t, ctl, global.ModeNormal)
+	parent := &block.Block{Head: &block.BlockHead{Number: 1, Witness: d.account.ID}}
+	blk := &block.Block{Head: &block.BlockHead{Number: 2, Witness: "other"}}
+	assert.Equal(t, errWitness, d.verifyBlock(blk, parent))
+
+	node := blockcache.NewBCN(nil, blk)
+	assert.Equal(t, errParent, d.linkBlock(node))
+	parentNode := blockcache.NewBCN(nil, parent)
+	parentNode.Type = blockcache.Single
+	node = blockcache.NewBCN(parentNode, blk)
+	assert.Equal(t, errParent, d.linkBlock(node))
+}
+
+func TestDevWarpTime(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	d := newTestDev(t, ctl, global.ModeNormal)
+	_, err := d.WarpTime(0)
+	assert.Equal(t, errWarp, err)
+	_, err = d.WarpTime(-1)
+	assert.Equal(t, errWarp, err)
+
+	before := common.Now()
+	offset, err := d.WarpTime(time.Hour)
+	assert.Nil(t, err)
+	defer common.WarpClock(-time.Hour)
+	assert.Equal(t, common.ClockOffset(), offset)
+	assert.True(t, common.Now() >= before+int64(time.Hour))
+}
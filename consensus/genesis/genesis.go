@@ -185,7 +185,7 @@ func GenGenesis(db db.MVCCDB, gConf *common.GenesisConfig) (*block.Block, error)
 	}
 
 	blockHead := block.BlockHead{
-		Version:    0,
+		Version:    block.VersionOf(0),
 		ParentHash: nil,
 		Number:     0,
 		Witness:    acc.ID,
@@ -202,13 +202,22 @@ func GenGenesis(db db.MVCCDB, gConf *common.GenesisConfig) (*block.Block, error)
 		Txs:      []*tx.Tx{trx},
 		Receipts: []*tx.TxReceipt{txr},
 	}
+	if blk.Head.Version >= block.V1 {
+		blk.Head.StateRoot, err = db.StateRoot()
+		if err != nil {
+			return nil, err
+		}
+	}
 	blk.Head.TxMerkleHash = blk.CalculateTxMerkleHash()
 	blk.Head.TxReceiptMerkleHash = blk.CalculateTxReceiptMerkleHash()
 	err = blk.CalculateHeadHash()
 	if err != nil {
 		return nil, err
 	}
-	db.Tag(string(blk.HeadHash()))
+	err = db.Tag(string(blk.HeadHash()))
+	if err != nil {
+		return nil, err
+	}
 	return &blk, nil
 }
 
@@ -25,7 +25,6 @@ var (
 	errDoubleTx    = errors.New("double tx in block")
 	errTxSignature = errors.New("tx wrong signature")
 	errHeadHash    = errors.New("wrong head hash")
-	generateTxsNum = 0
 )
 
 func generateBlock(acc *account.KeyPair, txPool txpool.TxPool, db db.MVCCDB, limitTime time.Duration) (*block.Block, error) { // TODO 应传入account
@@ -35,7 +34,7 @@ func generateBlock(acc *account.KeyPair, txPool txpool.TxPool, db db.MVCCDB, lim
 	topBlock := head.Block
 	blk := block.Block{
 		Head: &block.BlockHead{
-			Version:    0,
+			Version:    block.VersionOf(topBlock.Head.Number + 1),
 			ParentHash: topBlock.HeadHash(),
 			Info:       make([]byte, 0),
 			Number:     topBlock.Head.Number + 1,
@@ -50,17 +49,18 @@ func generateBlock(acc *account.KeyPair, txPool txpool.TxPool, db db.MVCCDB, lim
 	// call vote
 	v := verifier.Verifier{}
 	t1 := time.Now()
-	dropList, _, err := v.Gen(&blk, topBlock, db, pTx, &verifier.Config{
+	dropList, errs, err := v.Gen(&blk, topBlock, db, pTx, &verifier.Config{
 		Mode:        0,
 		Timeout:     limitTime - time.Now().Sub(st),
 		TxTimeLimit: time.Millisecond * 100,
 	})
+	// the pool is locked while generating, the dropped txs are removed after it's released.
+	go removeDroppedTxs(txPool, dropList, errs)
 	t2 := time.Since(t1)
 	if len(blk.Txs) != 0 {
 		ilog.Debugf("time spent per tx: %v", t2.Nanoseconds()/int64(len(blk.Txs)))
 	}
 	if err != nil {
-		go txPool.DelTxList(dropList)
 		ilog.Errorf("Gen is err: %v", err)
 		return nil, err
 	}
@@ -71,12 +71,26 @@ func generateBlock(acc *account.KeyPair, txPool txpool.TxPool, db db.MVCCDB, lim
 		return nil, err
 	}
 	blk.Sign = acc.Sign(blk.HeadHash())
-	db.Tag(string(blk.HeadHash()))
+	err = db.Tag(string(blk.HeadHash()))
+	if err != nil {
+		return nil, err
+	}
 	metricsGeneratedBlockCount.Add(1, nil)
-	generateTxsNum += len(blk.Txs)
 	return &blk, nil
 }
 
+// removeDroppedTxs removes the txs dropped in generating a block from the pending pool and the journal.
+// The TxDropped event is posted by the removal, so only once for a tx even if it's dropped by several blocks.
+func removeDroppedTxs(txPool txpool.TxPool, dropList []*tx.Tx, errs []error) {
+	for i, t := range dropList {
+		reason := "dropped in generating block"
+		if errs[i] != nil {
+			reason = errs[i].Error()
+		}
+		txPool.RemoveTx(t.Hash(), reason)
+	}
+}
+
 func verifyBasics(head *block.BlockHead, signature *crypto.Signature) error {
 
 	signature.SetPubkey(account.GetPubkeyByID(head.Witness))
@@ -90,17 +104,36 @@ func verifyBasics(head *block.BlockHead, signature *crypto.Signature) error {
 	return nil
 }
 
-func verifyBlock(blk *block.Block, parent *block.Block, lib *block.Block, txPool txpool.TxPool, db db.MVCCDB, chain block.Chain, replay bool) error {
-	err := cverifier.VerifyBlockHead(blk, parent, lib)
+// verifyBlock verifies the block on the state of its parent, and tags the state executed by the block in the verify db.
+// The witness of the slot is not checked when the blocks are replayed.
+func (p *PoB) verifyBlock(blk *block.Block, parent *block.Block, replay bool) error {
+	if p.verifyDB.Checkout(string(blk.HeadHash())) {
+		return nil
+	}
+	p.verifyDB.Checkout(string(blk.Head.ParentHash))
+	p.txPool.Lock()
+	err := p.checkBlock(blk, parent, replay)
+	p.txPool.Release()
 	if err != nil {
 		return err
 	}
+	return p.verifyDB.Tag(string(blk.HeadHash()))
+}
 
-	if replay == false && witnessOfNanoSec(blk.Head.Time) != blk.Head.Witness {
+func (p *PoB) checkBlock(blk *block.Block, parent *block.Block, replay bool) error {
+	err := cverifier.VerifyBlockHead(blk, parent, p.blockCache.LinkedRoot().Block)
+	if err != nil {
+		return err
+	}
+
+	if replay == false && p.property.witnessOfNanoSec(blk.Head.Time) != blk.Head.Witness {
 		ilog.Errorf("blk num: %v, time: %v, witness: %v, witness len: %v, witness list: %v",
-			blk.Head.Number, blk.Head.Time, blk.Head.Witness, staticProperty.NumberOfWitnesses, staticProperty.WitnessList)
+			blk.Head.Number, blk.Head.Time, blk.Head.Witness, p.property.NumberOfWitnesses, p.property.WitnessList)
 		return errWitness
 	}
+	if err := cverifier.VerifyBlockTxs(blk); err != nil {
+		return err
+	}
 	ilog.Debugf("[pob] start to verify block if foundchain, number: %v, hash = %v, witness = %v", blk.Head.Number, common.Base58Encode(blk.HeadHash()), blk.Head.Witness[4:6])
 	blkTxSet := make(map[string]bool, len(blk.Txs))
 	for i, t := range blk.Txs {
@@ -113,7 +146,7 @@ func verifyBlock(blk *block.Block, parent *block.Block, lib *block.Block, txPool
 			// base tx
 			continue
 		}
-		exist := txPool.ExistTxs(t.Hash(), parent)
+		exist := p.txPool.ExistTxs(t.Hash(), parent)
 		switch exist {
 		case txpool.FoundChain:
 			ilog.Infof("FoundChain: %v, %v", t, common.Base58Encode(t.Hash()))
@@ -126,7 +159,7 @@ func verifyBlock(blk *block.Block, parent *block.Block, lib *block.Block, txPool
 
 		}
 		if t.IsDefer() {
-			referredTx, err := chain.GetTx(t.ReferredTx)
+			referredTx, err := p.blockChain.GetTx(t.ReferredTx)
 			if err != nil {
 				return fmt.Errorf("get referred tx error, %v", err)
 			}
@@ -137,30 +170,38 @@ func verifyBlock(blk *block.Block, parent *block.Block, lib *block.Block, txPool
 		}
 	}
 	v := verifier.Verifier{}
-	return v.Verify(blk, parent, db, &verifier.Config{
+	return v.Verify(blk, parent, p.verifyDB, &verifier.Config{
 		Mode:        0,
 		Timeout:     time.Millisecond * 250,
 		TxTimeLimit: time.Millisecond * 100,
 	})
 }
 
-func updateWaterMark(node *blockcache.BlockCacheNode) {
-	node.ConfirmUntil = staticProperty.Watermark[node.Head.Witness]
-	if node.Head.Number >= staticProperty.Watermark[node.Head.Witness] {
-		staticProperty.Watermark[node.Head.Witness] = node.Head.Number + 1
+func (property *StaticProperty) updateWaterMark(node *blockcache.BlockCacheNode) {
+	node.ConfirmUntil = property.Watermark[node.Head.Witness]
+	if node.Head.Number >= property.Watermark[node.Head.Witness] {
+		property.Watermark[node.Head.Witness] = node.Head.Number + 1
 	}
 }
 
-func updateLib(node *blockcache.BlockCacheNode, bc blockcache.BlockCache) {
-	confirmedNode := calculateConfirm(node, bc.LinkedRoot())
-	if confirmedNode != nil {
-		bc.Flush(confirmedNode)
-		metricsConfirmedLength.Set(float64(confirmedNode.Head.Number+1), nil)
+// finalize updates the watermark of the witness by the linked node, flushes the block confirmed by 2/3+1 witnesses,
+// and updates the witnesses by the new LIB.
+func (p *PoB) finalize(node *blockcache.BlockCacheNode) {
+	p.property.updateWaterMark(node)
+	if confirmedNode := p.property.calculateConfirm(node, p.blockCache.LinkedRoot()); confirmedNode != nil {
+		p.flushLib(confirmedNode)
 	}
+	p.updateWitness()
+}
+
+// flushLib makes the block and its ancestors irreversible.
+func (p *PoB) flushLib(node *blockcache.BlockCacheNode) {
+	p.blockCache.Flush(node)
+	metricsConfirmedLength.Set(float64(node.Head.Number+1), nil)
 }
 
-func calculateConfirm(node *blockcache.BlockCacheNode, root *blockcache.BlockCacheNode) *blockcache.BlockCacheNode {
-	confirmLimit := staticProperty.NumberOfWitnesses*2/3 + 1
+func (property *StaticProperty) calculateConfirm(node *blockcache.BlockCacheNode, root *blockcache.BlockCacheNode) *blockcache.BlockCacheNode {
+	confirmLimit := property.NumberOfWitnesses*2/3 + 1
 	startNumber := node.Head.Number
 	var confirmNum int64
 	confirmUntilMap := make(map[int64]int64, startNumber-root.Head.Number)
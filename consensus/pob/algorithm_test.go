@@ -71,7 +71,7 @@ func BenchmarkGenerateBlock(b *testing.B) { // 296275 = 0.3ms(0tx), 466353591 =
 		pendingTx.Add(trx)
 	}
 	mockTxPool.EXPECT().PendingTx().Return(pendingTx, &blockcache.BlockCacheNode{Block: topBlock}).AnyTimes()
-	mockTxPool.EXPECT().DelTxList(gomock.Any()).AnyTimes()
+	mockTxPool.EXPECT().RemoveTx(gomock.Any(), gomock.Any()).AnyTimes()
 	b.ResetTimer()
 	for j := 0; j < b.N; j++ {
 		generateBlock(account, mockTxPool, stateDB, time.Millisecond*1000)
@@ -109,7 +109,7 @@ func BenchmarkVerifyBlockWithVM(b *testing.B) { // 296275 = 0.3ms(0tx), 46635359
 		pendingTx.Add(trx)
 	}
 	mockTxPool.EXPECT().PendingTx().Return(pendingTx, &blockcache.BlockCacheNode{Block: topBlock}).AnyTimes()
-	mockTxPool.EXPECT().DelTxList(gomock.Any()).AnyTimes()
+	mockTxPool.EXPECT().RemoveTx(gomock.Any(), gomock.Any()).AnyTimes()
 	blk, _ := generateBlock(account, mockTxPool, stateDB, time.Millisecond*1000)
 
 	b.ResetTimer()
@@ -128,7 +128,7 @@ func TestConfirmNode(t *testing.T) {
 	convey.Convey("Test of Confirm node", t, func() {
 
 		acc, _ := account.NewKeyPair(nil, crypto.Secp256k1)
-		staticProperty = newStaticProperty(acc, []string{"id0", "id1", "id2", "id3", "id4"})
+		property := newStaticProperty(acc, []string{"id0", "id1", "id2", "id3", "id4"})
 
 		rootNode := &blockcache.BlockCacheNode{
 			Block: &block.Block{
@@ -145,7 +145,7 @@ func TestConfirmNode(t *testing.T) {
 			node = addNode(node, 4, 0, "id3")
 			node = addNode(node, 5, 0, "id4")
 
-			confirmNode := calculateConfirm(node, rootNode)
+			confirmNode := property.calculateConfirm(node, rootNode)
 			convey.So(confirmNode.Head.Number, convey.ShouldEqual, 2)
 		})
 
@@ -157,7 +157,7 @@ func TestConfirmNode(t *testing.T) {
 			node = addNode(node, 6, 3, "id1")
 			node = addNode(node, 7, 0, "id3")
 
-			confirmNode := calculateConfirm(node, rootNode)
+			confirmNode := property.calculateConfirm(node, rootNode)
 			convey.So(confirmNode.Head.Number, convey.ShouldEqual, 4)
 		})
 
@@ -166,15 +166,15 @@ func TestConfirmNode(t *testing.T) {
 			node = addNode(node, 3, 0, "id2")
 			node = addNode(node, 4, 0, "id3")
 			node = addNode(node, 5, 3, "id4")
-			confirmNode := calculateConfirm(node, rootNode)
+			confirmNode := property.calculateConfirm(node, rootNode)
 			convey.So(confirmNode, convey.ShouldBeNil)
 
 			node = addNode(node, 6, 4, "id5")
-			confirmNode = calculateConfirm(node, rootNode)
+			confirmNode = property.calculateConfirm(node, rootNode)
 			convey.So(confirmNode, convey.ShouldBeNil)
 
 			node = addNode(node, 7, 2, "id0")
-			confirmNode = calculateConfirm(node, rootNode)
+			confirmNode = property.calculateConfirm(node, rootNode)
 			convey.So(confirmNode.Head.Number, convey.ShouldEqual, 4)
 		})
 	})
@@ -182,7 +182,7 @@ func TestConfirmNode(t *testing.T) {
 
 func TestNodeInfoUpdate(t *testing.T) {
 	convey.Convey("Test of node info update", t, func() {
-		staticProperty = newStaticProperty(&account.KeyPair{ID: "id0"}, []string{"id0", "id1", "id2"})
+		property := newStaticProperty(&account.KeyPair{ID: "id0"}, []string{"id0", "id1", "id2"})
 		rootNode := &blockcache.BlockCacheNode{
 			Block: &block.Block{
 				Head: &block.BlockHead{
@@ -192,60 +192,60 @@ func TestNodeInfoUpdate(t *testing.T) {
 			},
 			Children: make(map[*blockcache.BlockCacheNode]bool),
 		}
-		staticProperty.Watermark["id0"] = 2
+		property.Watermark["id0"] = 2
 		convey.Convey("Normal", func() {
 			node := addBlock(rootNode, 2, "id1", 2)
-			updateWaterMark(node)
-			convey.So(staticProperty.Watermark["id1"], convey.ShouldEqual, 3)
+			property.updateWaterMark(node)
+			convey.So(property.Watermark["id1"], convey.ShouldEqual, 3)
 
 			node = addBlock(node, 3, "id2", 3)
-			updateWaterMark(node)
-			convey.So(staticProperty.Watermark["id2"], convey.ShouldEqual, 4)
+			property.updateWaterMark(node)
+			convey.So(property.Watermark["id2"], convey.ShouldEqual, 4)
 
 			node = addBlock(node, 4, "id0", 4)
-			updateWaterMark(node)
-			convey.So(staticProperty.Watermark["id0"], convey.ShouldEqual, 5)
+			property.updateWaterMark(node)
+			convey.So(property.Watermark["id0"], convey.ShouldEqual, 5)
 
-			node = calculateConfirm(node, rootNode)
+			node = property.calculateConfirm(node, rootNode)
 			convey.So(node.Head.Number, convey.ShouldEqual, 2)
 		})
 
 		convey.Convey("Slot witness error", func() {
 			node := addBlock(rootNode, 2, "id1", 2)
-			updateWaterMark(node)
+			property.updateWaterMark(node)
 
 			node = addBlock(node, 3, "id1", 2)
-			updateWaterMark(node)
+			property.updateWaterMark(node)
 		})
 
 		convey.Convey("Watermark test", func() {
 			node := addBlock(rootNode, 2, "id1", 2)
-			updateWaterMark(node)
+			property.updateWaterMark(node)
 			convey.So(node.ConfirmUntil, convey.ShouldEqual, 0)
 			branchNode := node
 
 			node = addBlock(node, 3, "id2", 3)
-			updateWaterMark(node)
+			property.updateWaterMark(node)
 
 			newNode := addBlock(branchNode, 3, "id0", 4)
-			updateWaterMark(newNode)
+			property.updateWaterMark(newNode)
 			convey.So(newNode.ConfirmUntil, convey.ShouldEqual, 2)
-			confirmNode := calculateConfirm(newNode, rootNode)
+			confirmNode := property.calculateConfirm(newNode, rootNode)
 			convey.So(confirmNode, convey.ShouldBeNil)
-			convey.So(staticProperty.Watermark["id0"], convey.ShouldEqual, 4)
+			convey.So(property.Watermark["id0"], convey.ShouldEqual, 4)
 			node = addBlock(node, 4, "id1", 5)
-			updateWaterMark(node)
+			property.updateWaterMark(node)
 			convey.So(node.ConfirmUntil, convey.ShouldEqual, 3)
 
 			node = addBlock(node, 5, "id0", 7)
-			updateWaterMark(node)
+			property.updateWaterMark(node)
 			convey.So(node.ConfirmUntil, convey.ShouldEqual, 4)
-			confirmNode = calculateConfirm(node, rootNode)
+			confirmNode = property.calculateConfirm(node, rootNode)
 			convey.So(confirmNode, convey.ShouldBeNil)
 
 			node = addBlock(node, 6, "id2", 9)
-			updateWaterMark(node)
-			confirmNode = calculateConfirm(node, rootNode)
+			property.updateWaterMark(node)
+			confirmNode = property.calculateConfirm(node, rootNode)
 			convey.So(confirmNode.Head.Number, convey.ShouldEqual, 4)
 		})
 	})
@@ -257,7 +257,6 @@ func TestVerifyBasics(t *testing.T) {
 		account0, _ := account.NewKeyPair(secKey, crypto.Secp256k1)
 		secKey = common.Sha3([]byte("secKey of id1"))
 		account1, _ := account.NewKeyPair(secKey, crypto.Secp256k1)
-		staticProperty = newStaticProperty(account1, []string{account0.ID, account1.ID, "id2"})
 		convey.Convey("Normal (self block)", func() {
 			blk := &block.Block{
 				Head: &block.BlockHead{
@@ -338,13 +337,13 @@ func TestVerifyBlock(t *testing.T) {
 		account1, _ := account.NewKeyPair(secKey, crypto.Secp256k1)
 		secKey = common.Sha3([]byte("sec of id2"))
 		account2, _ := account.NewKeyPair(secKey, crypto.Secp256k1)
-		staticProperty = newStaticProperty(account0, []string{account0.ID, account1.ID, account2.ID})
+		property := newStaticProperty(account0, []string{account0.ID, account1.ID, account2.ID})
 		rootTime := time.Now().UnixNano()
 		rootBlk := &block.Block{
 			Head: &block.BlockHead{
 				Number:  1,
 				Time:    rootTime,
-				Witness: witnessOfSlot(rootTime),
+				Witness: property.witnessOfSlot(rootTime),
 			},
 		}
 		tx0 := &tx.Tx{
@@ -361,13 +360,13 @@ func TestVerifyBlock(t *testing.T) {
 		}
 		curTime := time.Now().UnixNano()
 		hash, _ := rootBlk.Head.Hash()
-		witness := witnessOfSlot(curTime)
+		witness := property.witnessOfSlot(curTime)
 		blk := &block.Block{
 			Head: &block.BlockHead{
 				Number:     2,
 				ParentHash: hash,
 				Time:       curTime,
-				Witness:    witnessOfSlot(curTime),
+				Witness:    property.witnessOfSlot(curTime),
 			},
 			Txs:      []*tx.Tx{},
 			Receipts: []*tx.TxReceipt{},
@@ -0,0 +1,107 @@
+package pob
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/db/kv"
+	"github.com/iost-official/go-iost/ilog"
+)
+
+// The evidence pool detects the witnesses signing two different blocks with the same number in one slot.
+// The evidences are saved in EvidenceDB and broadcast to the other nodes, and anyone can report them to
+// vote_producer.iost by "ReportDoubleSign", which unregisters the witness.
+
+const evidenceSlotWindow = 1024
+
+var evidencePrefix = []byte("e")
+
+type headKey struct {
+	witness string
+	number  int64
+	slot    int64
+}
+
+type evidencePool struct {
+	db     *kv.Storage
+	heads  map[headKey]*block.Block
+	pruned int64
+	mu     sync.Mutex
+}
+
+func newEvidencePool(path string) (*evidencePool, error) {
+	db, err := kv.NewStorage(path, kv.LevelDBStorage)
+	if err != nil {
+		return nil, err
+	}
+	return &evidencePool{
+		db:    db,
+		heads: make(map[headKey]*block.Block),
+	}, nil
+}
+
+// check returns the evidence if the block conflicts with a block received before.
+func (ep *evidencePool) check(blk *block.Block) *block.DoubleSignEvidence {
+	ep.mu.Lock()
+	defer ep.mu.Unlock()
+	key := headKey{witness: blk.Head.Witness, number: blk.Head.Number, slot: block.SlotOf(blk.Head.Time)}
+	old, ok := ep.heads[key]
+	if !ok {
+		head := &block.Block{Head: blk.Head, Sign: blk.Sign}
+		head.CalculateHeadHash()
+		ep.heads[key] = head
+		ep.prune(key.slot)
+		return nil
+	}
+	if bytes.Equal(old.HeadHash(), blk.HeadHash()) {
+		return nil
+	}
+	return block.NewDoubleSignEvidence(old, blk)
+}
+
+// prune removes the block heads too old to be signed again.
+func (ep *evidencePool) prune(slot int64) {
+	if slot < ep.pruned+evidenceSlotWindow {
+		return
+	}
+	for key := range ep.heads {
+		if key.slot < slot-evidenceSlotWindow {
+			delete(ep.heads, key)
+		}
+	}
+	ep.pruned = slot
+}
+
+// add saves the evidence and returns whether it is a new one.
+func (ep *evidencePool) add(e *block.DoubleSignEvidence) (bool, error) {
+	ep.mu.Lock()
+	defer ep.mu.Unlock()
+	key := append(evidencePrefix, e.Hash()...)
+	has, err := ep.db.Has(key)
+	if err != nil || has {
+		return false, err
+	}
+	b, err := e.Encode()
+	if err != nil {
+		return false, err
+	}
+	return true, ep.db.Put(key, b)
+}
+
+func (ep *evidencePool) all() ([]*block.DoubleSignEvidence, error) {
+	ep.mu.Lock()
+	defer ep.mu.Unlock()
+	iter := ep.db.NewIteratorByPrefix(evidencePrefix)
+	defer iter.Release()
+	ret := make([]*block.DoubleSignEvidence, 0)
+	for iter.Next() {
+		e := &block.DoubleSignEvidence{}
+		if err := e.Decode(iter.Value()); err != nil {
+			ilog.Warnf("fail to decode evidence %x", iter.Key())
+			continue
+		}
+		ret = append(ret, e)
+	}
+	return ret, iter.Error()
+}
@@ -0,0 +1,66 @@
+package pob
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvidencePool(t *testing.T) {
+	os.RemoveAll("EvidenceDB")
+	defer os.RemoveAll("EvidenceDB")
+	ep, err := newEvidencePool("EvidenceDB")
+	assert.Nil(t, err)
+
+	kp, err := account.NewKeyPair(nil, crypto.Ed25519)
+	assert.Nil(t, err)
+	slotTime := time.Now().UnixNano() / (3 * 1e9) * (3 * 1e9)
+	newBlock := func(number int64, parent string, tm int64) *block.Block {
+		blk := &block.Block{
+			Head: &block.BlockHead{
+				ParentHash: []byte(parent),
+				Number:     number,
+				Witness:    kp.ID,
+				Time:       tm,
+			},
+		}
+		blk.CalculateHeadHash()
+		blk.Sign = kp.Sign(blk.HeadHash())
+		return blk
+	}
+	b1 := newBlock(1, "a", slotTime)
+	assert.Nil(t, ep.check(b1))
+	assert.Nil(t, ep.check(b1))
+	assert.Nil(t, ep.check(newBlock(2, "b", slotTime+1)))
+	e := ep.check(newBlock(1, "c", slotTime+2))
+	assert.NotNil(t, e)
+	assert.Nil(t, e.Verify())
+
+	isNew, err := ep.add(e)
+	assert.Nil(t, err)
+	assert.True(t, isNew)
+	isNew, err = ep.add(e)
+	assert.Nil(t, err)
+	assert.False(t, isNew)
+	all, err := ep.all()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(all))
+	assert.Equal(t, e.Hash(), all[0].Hash())
+
+	p := &PoB{}
+	all, err = p.DoubleSignEvidences()
+	assert.Nil(t, err)
+	assert.Empty(t, all)
+	p.evidences = ep
+	all, err = p.DoubleSignEvidences()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(all))
+
+	ep.check(newBlock(1, "d", slotTime+int64((evidenceSlotWindow+1)*3*time.Second)))
+	assert.Equal(t, 1, len(ep.heads))
+}
@@ -29,6 +29,7 @@ var (
 	metricsTransferCost          = metrics.NewGauge("iost_transfer_cost", nil)
 	metricsGenerateBlockTimeCost = metrics.NewGauge("iost_generate_block_time_cost", nil)
 	metricsDelayedBlock          = metrics.NewCounter("iost_delayed_block", nil)
+	metricsVoteEquivocation      = metrics.NewCounter("iost_pob_vote_equivocation", nil)
 )
 
 var (
@@ -38,12 +39,9 @@ var (
 
 var (
 	blockReqTimeout   = 3 * time.Second
-	continuousNum     int
 	subSlotTime       = 300 * time.Millisecond
 	genBlockTime      = 250 * time.Millisecond
 	last2GenBlockTime = 30 * time.Millisecond
-	tWitness          = ""
-	tContinuousNum    = 0
 )
 
 type verifyBlockMessage struct {
@@ -67,7 +65,18 @@ type PoB struct {
 	chRecvBlock      chan p2p.IncomingMessage
 	chRecvBlockHash  chan p2p.IncomingMessage
 	chQueryBlock     chan p2p.IncomingMessage
+	chRecvEvidence   chan p2p.IncomingMessage
+	chRecvVote       chan p2p.IncomingMessage
 	chVerifyBlock    chan *verifyBlockMessage
+	votes            *votePool
+	voteLock         *voteLock
+	producers        *producerStats
+	property         *StaticProperty
+	evidences        *evidencePool
+	continuousNum    int
+	generatedTxs     int
+	recvWitness      string
+	recvContinuous   int
 	wg               *sync.WaitGroup
 	mu               *sync.RWMutex
 }
@@ -89,17 +98,75 @@ func New(account *account.KeyPair, baseVariable global.BaseVariable, blockCache
 		chRecvBlock:      p2pService.Register("consensus channel", p2p.NewBlock, p2p.SyncBlockResponse),
 		chRecvBlockHash:  p2pService.Register("consensus block head", p2p.NewBlockHash),
 		chQueryBlock:     p2pService.Register("consensus query block", p2p.NewBlockRequest),
+		chRecvEvidence:   p2pService.Register("consensus evidence", p2p.DoubleSignEvidence),
+		chRecvVote:       p2pService.Register("consensus vote", p2p.PreCommitVote, p2p.CommitVote),
 		chVerifyBlock:    make(chan *verifyBlockMessage, 1024),
+		votes:            newVotePool(),
+		producers:        newProducerStats(),
+		property:         newStaticProperty(account, blockCache.LinkedRoot().Active()),
+		continuousNum:    baseVariable.Continuous(),
 		wg:               new(sync.WaitGroup),
 		mu:               new(sync.RWMutex),
 	}
-	continuousNum = baseVariable.Continuous()
-	staticProperty = newStaticProperty(p.account, blockCache.LinkedRoot().Active())
+	ep, err := newEvidencePool(baseVariable.Config().DB.LdbPath + "EvidenceDB")
+	if err != nil {
+		ilog.Errorf("Failed to open evidence db, err: %v", err)
+	}
+	p.evidences = ep
+	p.voteLock = openVoteLock(baseVariable.Config().DB.LdbPath + "VoteDB")
 	p.recoverBlockcache()
 	close(p.quitGenerateMode)
 	return &p
 }
 
+// VerifyBlock verifies the block on its linked parent, and tags the state executed by the block in the verify db.
+func (p *PoB) VerifyBlock(blk *block.Block, parent *block.Block) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.verifyBlock(blk, parent, false)
+}
+
+// Finalize updates the confirmation by the linked node, and flushes the block confirmed by 2/3+1 witnesses.
+func (p *PoB) Finalize(node *blockcache.BlockCacheNode) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.finalize(node)
+}
+
+// Witnesses returns the witnesses of the irreversible block.
+func (p *PoB) Witnesses() []string {
+	return p.property.WitnessList
+}
+
+// Schedule returns the witnesses scheduled in the next num slots from the current one.
+func (p *PoB) Schedule(num int) []SlotSchedule {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	current := block.SlotOf(time.Now().UnixNano())
+	ret := make([]SlotSchedule, 0, num)
+	for slot := current; slot < current+int64(num); slot++ {
+		ret = append(ret, SlotSchedule{
+			Slot:    slot,
+			Witness: p.property.witnessOfSlot(slot),
+			Time:    slot * common.SlotLength * second2nanosecond,
+		})
+	}
+	return ret
+}
+
+// ProducerStats returns the production statistics of the witnesses since the node started.
+func (p *PoB) ProducerStats() map[string]ProducerStat {
+	return p.producers.all()
+}
+
+// DoubleSignEvidences returns the evidences of double signing saved by the node.
+func (p *PoB) DoubleSignEvidences() ([]*block.DoubleSignEvidence, error) {
+	if p.evidences == nil {
+		return nil, nil
+	}
+	return p.evidences.all()
+}
+
 func (p *PoB) recoverBlockcache() error {
 	err := p.blockCache.Recover(p)
 	if err != nil {
@@ -166,6 +233,28 @@ func (p *PoB) messageLoop() {
 				}
 				p.handleBlockQuery(&rh, incomingMessage.From())
 			}
+		case incomingMessage, ok := <-p.chRecvEvidence:
+			if !ok {
+				ilog.Infof("chRecvEvidence has closed")
+				return
+			}
+			var e block.DoubleSignEvidence
+			err := e.Decode(incomingMessage.Data())
+			if err != nil {
+				continue
+			}
+			p.handleRecvEvidence(&e)
+		case incomingMessage, ok := <-p.chRecvVote:
+			if !ok {
+				ilog.Infof("chRecvVote has closed")
+				return
+			}
+			var v block.Vote
+			err := v.Decode(incomingMessage.Data())
+			if err != nil {
+				continue
+			}
+			p.handleRecvVote(&v, incomingMessage.Data())
 		case <-p.exitSignal:
 			return
 		}
@@ -212,6 +301,118 @@ func (p *PoB) handleBlockQuery(rh *msgpb.BlockInfo, peerID p2p.PeerID) {
 	p.p2pService.SendToPeer(peerID, b, p2p.NewBlock, p2p.UrgentMessage)
 }
 
+func (p *PoB) handleRecvEvidence(e *block.DoubleSignEvidence) {
+	err := e.Verify()
+	if err != nil {
+		ilog.Debugf("invalid evidence, err:%v", err)
+		return
+	}
+	p.addEvidence(e)
+}
+
+// checkDoubleSign saves the evidence if the witness of the block signed another one with the same number in the slot.
+func (p *PoB) checkDoubleSign(blk *block.Block) {
+	if p.evidences == nil {
+		return
+	}
+	e := p.evidences.check(blk)
+	if e == nil {
+		return
+	}
+	ilog.Warnf("witness %v signed two blocks with number %v in slot %v", e.Witness(), blk.Head.Number, e.Slot())
+	p.addEvidence(e)
+}
+
+func (p *PoB) addEvidence(e *block.DoubleSignEvidence) {
+	if p.evidences == nil {
+		return
+	}
+	isNew, err := p.evidences.add(e)
+	if err != nil {
+		ilog.Errorf("fail to save evidence, err:%v", err)
+		return
+	}
+	if !isNew {
+		return
+	}
+	b, err := e.Encode()
+	if err != nil {
+		ilog.Errorf("fail to encode evidence, err:%v", err)
+		return
+	}
+	p.p2pService.Broadcast(b, p2p.DoubleSignEvidence, p2p.NormalMessage)
+}
+
+func (p *PoB) handleRecvVote(v *block.Vote, data []byte) {
+	err := v.Verify()
+	if err != nil {
+		ilog.Debugf("invalid vote, err:%v", err)
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	lib := p.blockCache.LinkedRoot().Head.Number
+	if v.Number <= lib || v.Number > lib+maxVoteAhead || !p.property.isWitness(v.Witness()) {
+		return
+	}
+	isNew, cast := p.votes.add(v)
+	if cast != nil {
+		ilog.Warnf("witness %v equivocates, voted both %v and %v of number %v with type %v",
+			v.Witness(), common.Base58Encode(cast.Hash), common.Base58Encode(v.Hash), v.Number, v.Type)
+		metricsVoteEquivocation.Add(1, nil)
+		return
+	}
+	if !isNew {
+		return
+	}
+	p.p2pService.Broadcast(data, voteMessageType(v.Type), p2p.UrgentMessage)
+	p.tallyVotes(v.Hash)
+}
+
+// vote signs the vote for the block and broadcasts it, if the node is a witness and the lock rule allows it.
+func (p *PoB) vote(t block.VoteType, node *blockcache.BlockCacheNode) {
+	if p.voteLock == nil || !p.property.isWitness(p.account.ID) {
+		return
+	}
+	if err := p.voteLock.vote(t, node, p.blockCache.LinkedRoot()); err != nil {
+		ilog.Debugf("not voting block %v, err:%v", node.Head.Number, err)
+		return
+	}
+	v := block.NewVote(t, node.Head.Number, node.HeadHash(), p.account)
+	p.votes.add(v)
+	b, err := v.Encode()
+	if err != nil {
+		ilog.Errorf("fail to encode vote, err:%v", err)
+		return
+	}
+	p.p2pService.Broadcast(b, voteMessageType(t), p2p.UrgentMessage)
+}
+
+// tallyVotes commits the linked block if 2/3+1 witnesses have pre-committed it, and flushes it if 2/3+1 witnesses
+// have committed it.
+func (p *PoB) tallyVotes(hash []byte) {
+	node, err := p.blockCache.Find(hash)
+	if err != nil || node.Type != blockcache.Linked || node.Head.Number <= p.blockCache.LinkedRoot().Head.Number {
+		return
+	}
+	confirmLimit := p.property.NumberOfWitnesses*2/3 + 1
+	if p.votes.count(hash, block.PreCommit, p.property.WitnessList) >= confirmLimit {
+		p.vote(block.Commit, node)
+	}
+	if p.votes.count(hash, block.Commit, p.property.WitnessList) >= confirmLimit {
+		ilog.Debugf("block %v is committed by votes", node.Head.Number)
+		p.flushLib(node)
+		p.updateWitness()
+	}
+}
+
+func voteMessageType(t block.VoteType) p2p.MessageType {
+	if t == block.Commit {
+		return p2p.CommitVote
+	}
+	return p2p.PreCommitVote
+}
+
 func (p *PoB) broadcastBlockHash(blk *block.Block) {
 	blkInfo := &msgpb.BlockInfo{
 		Number: blk.Head.Number,
@@ -317,25 +518,25 @@ func (p *PoB) scheduleLoop() {
 			time.Sleep(time.Millisecond)
 			metricsMode.Set(float64(p.baseVariable.Mode()), nil)
 			t := time.Now()
-			if !staticProperty.SlotUsed[t.Unix()] && p.baseVariable.Mode() == global.ModeNormal && witnessOfNanoSec(t.UnixNano()) == p.account.ID {
-				staticProperty.SlotUsed[t.Unix()] = true
+			if !p.property.SlotUsed[t.Unix()] && p.baseVariable.Mode() == global.ModeNormal && p.property.witnessOfNanoSec(t.UnixNano()) == p.account.ID {
+				p.property.SlotUsed[t.Unix()] = true
 				generateBlockTicker := time.NewTicker(subSlotTime)
-				generateTxsNum = 0
+				p.generatedTxs = 0
 				p.quitGenerateMode = make(chan struct{})
-				for num := 0; num < continuousNum; num++ {
+				for num := 0; num < p.continuousNum; num++ {
 					p.gen(num)
-					if num == continuousNum-1 {
+					if num == p.continuousNum-1 {
 						break
 					}
 					select {
 					case <-generateBlockTicker.C:
 					}
-					if witnessOfNanoSec(t.UnixNano()) != p.account.ID {
+					if p.property.witnessOfNanoSec(t.UnixNano()) != p.account.ID {
 						break
 					}
 				}
 				close(p.quitGenerateMode)
-				metricsTxSize.Set(float64(generateTxsNum), nil)
+				metricsTxSize.Set(float64(p.generatedTxs), nil)
 				generateBlockTicker.Stop()
 			}
 			nextSchedule = timeUntilNextSchedule(time.Now().UnixNano())
@@ -348,7 +549,7 @@ func (p *PoB) scheduleLoop() {
 
 func (p *PoB) gen(num int) {
 	limitTime := genBlockTime
-	if num >= continuousNum-2 {
+	if num >= p.continuousNum-2 {
 		limitTime = last2GenBlockTime
 	}
 	p.txPool.Lock()
@@ -358,6 +559,7 @@ func (p *PoB) gen(num int) {
 		ilog.Error(err)
 		return
 	}
+	p.generatedTxs += len(blk.Txs)
 	p.printStatistics(num, blk)
 	blkByte, err := blk.Encode()
 	if err != nil {
@@ -416,6 +618,7 @@ func (p *PoB) handleRecvBlock(blk *block.Block) error {
 	if err != nil {
 		return err
 	}
+	p.checkDoubleSign(blk)
 	parent, err := p.blockCache.Find(blk.Head.ParentHash)
 	p.blockCache.Add(blk)
 	if err == nil && parent.Type == blockcache.Linked {
@@ -426,32 +629,29 @@ func (p *PoB) handleRecvBlock(blk *block.Block) error {
 
 func (p *PoB) addExistingBlock(blk *block.Block, parentBlock *block.Block, replay bool) error {
 	node, _ := p.blockCache.Find(blk.HeadHash())
-	ok := p.verifyDB.Checkout(string(blk.HeadHash()))
-	if !ok {
-		p.verifyDB.Checkout(string(blk.Head.ParentHash))
-		p.txPool.Lock()
-		err := verifyBlock(blk, parentBlock, p.blockCache.LinkedRoot().Block, p.txPool, p.verifyDB, p.blockChain, replay)
-		p.txPool.Release()
-		if err != nil {
-			ilog.Errorf("verify block failed, blockNum:%v, blockHash:%v. err=%v", blk.Head.Number, common.Base58Encode(blk.HeadHash()), err)
-			p.blockCache.Del(node)
-			return err
-		}
-		p.verifyDB.Tag(string(blk.HeadHash()))
+	if err := p.verifyBlock(blk, parentBlock, replay); err != nil {
+		ilog.Errorf("verify block failed, blockNum:%v, blockHash:%v. err=%v", blk.Head.Number, common.Base58Encode(blk.HeadHash()), err)
+		p.blockCache.Del(node)
+		return err
 	}
 	p.txPool.AddLinkedNode(node)
 	p.blockCache.Link(node)
-	p.updateInfo(node)
+	p.finalize(node)
+	if !replay && p.baseVariable.Mode() == global.ModeNormal && node == p.blockCache.Head() {
+		p.producers.record(p.property, node.Block, parentBlock)
+		p.vote(block.PreCommit, node)
+	}
+	p.tallyVotes(node.HeadHash())
 	if node.Head.Witness != p.account.ID {
-		if tWitness != node.Head.Witness {
-			tWitness = node.Head.Witness
-			tContinuousNum = 0
+		if p.recvWitness != node.Head.Witness {
+			p.recvWitness = node.Head.Witness
+			p.recvContinuous = 0
 		}
 		ilog.Infof("Rec block - @%v id:%v..., num:%v, t:%v, txs:%v, confirmed:%v, et:%vms",
-			tContinuousNum, node.Head.Witness[:10], node.Head.Number, node.Head.Time, len(node.Txs), p.blockCache.LinkedRoot().Head.Number, calculateTime(node.Block))
-		tContinuousNum++
+			p.recvContinuous, node.Head.Witness[:10], node.Head.Number, node.Head.Time, len(node.Txs), p.blockCache.LinkedRoot().Head.Number, calculateTime(node.Block))
+		p.recvContinuous++
 	}
-	if witnessOfNanoSec(time.Now().UnixNano()) != node.Head.Witness {
+	if p.property.witnessOfNanoSec(time.Now().UnixNano()) != node.Head.Witness {
 		ilog.Debugf("hasn't process the block in the slot belonging to the witness")
 		metricsDelayedBlock.Add(1, nil)
 	}
@@ -461,11 +661,11 @@ func (p *PoB) addExistingBlock(blk *block.Block, parentBlock *block.Block, repla
 	return nil
 }
 
-func (p *PoB) updateInfo(node *blockcache.BlockCacheNode) {
-	updateWaterMark(node)
-	updateLib(node, p.blockCache)
-	staticProperty.updateWitness(p.blockCache.LinkedRoot().Active())
-	if staticProperty.isWitness(p.account.ID) {
+// updateWitness updates the witnesses by the LIB and drops the votes not needed any more.
+func (p *PoB) updateWitness() {
+	p.property.updateWitness(p.blockCache.LinkedRoot().Active())
+	if p.property.isWitness(p.account.ID) {
 		p.p2pService.ConnectBPs(p.blockCache.LinkedRoot().NetID())
 	}
+	p.votes.prune(p.blockCache.LinkedRoot().Head.Number)
 }
@@ -0,0 +1,82 @@
+package pob
+
+import (
+	"sync"
+
+	"github.com/iost-official/go-iost/core/block"
+)
+
+// The production statistics of the witnesses are counted since the node started, by the blocks linked as the head
+// in normal mode. The slots skipped between a block and its parent are missed by the witnesses scheduled in them,
+// so a missed slot is counted when the next block is linked.
+
+// ProducerStat is the production statistics of a witness.
+type ProducerStat struct {
+	Produced     int64
+	Missed       int64
+	LastProduced int64
+}
+
+// SlotSchedule is a slot and the witness scheduled to produce blocks in it.
+type SlotSchedule struct {
+	Slot    int64
+	Witness string
+	Time    int64
+}
+
+type producerStats struct {
+	stats map[string]*ProducerStat
+	mu    sync.RWMutex
+}
+
+func newProducerStats() *producerStats {
+	return &producerStats{
+		stats: make(map[string]*ProducerStat),
+	}
+}
+
+func (ps *producerStats) stat(witness string) *ProducerStat {
+	s, ok := ps.stats[witness]
+	if !ok {
+		s = &ProducerStat{}
+		ps.stats[witness] = s
+	}
+	return s
+}
+
+// record counts the block produced by the witness and the slots missed between it and its parent,
+// by the witnesses of the property.
+func (ps *producerStats) record(property *StaticProperty, blk *block.Block, parent *block.Block) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	s := ps.stat(blk.Head.Witness)
+	s.Produced++
+	if blk.Head.Time > s.LastProduced {
+		s.LastProduced = blk.Head.Time
+	}
+	n := property.NumberOfWitnesses
+	if n == 0 {
+		return
+	}
+	from := block.SlotOf(parent.Head.Time) + 1
+	to := block.SlotOf(blk.Head.Time)
+	if rounds := (to - from) / n; rounds > 0 {
+		for _, w := range property.WitnessList {
+			ps.stat(w).Missed += rounds
+		}
+		from += rounds * n
+	}
+	for slot := from; slot < to; slot++ {
+		ps.stat(property.witnessOfSlot(slot)).Missed++
+	}
+}
+
+func (ps *producerStats) all() map[string]ProducerStat {
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+	ret := make(map[string]ProducerStat, len(ps.stats))
+	for w, s := range ps.stats {
+		ret[w] = *s
+	}
+	return ret
+}
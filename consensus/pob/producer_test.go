@@ -0,0 +1,37 @@
+package pob
+
+import (
+	"testing"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProducerStats(t *testing.T) {
+	property := newStaticProperty(nil, []string{"id0", "id1", "id2"})
+	slotTime := func(slot int64) int64 {
+		return slot * common.SlotLength * second2nanosecond
+	}
+	newBlock := func(slot int64) *block.Block {
+		return &block.Block{Head: &block.BlockHead{Witness: property.witnessOfSlot(slot), Time: slotTime(slot) + 1}}
+	}
+	ps := newProducerStats()
+	ps.record(property, newBlock(301), newBlock(300))
+	ps.record(property, newBlock(301), newBlock(301))
+	stats := ps.all()
+	assert.Equal(t, ProducerStat{Produced: 2, LastProduced: slotTime(301) + 1}, stats["id1"])
+
+	ps.record(property, newBlock(304), newBlock(301))
+	stats = ps.all()
+	assert.Equal(t, int64(3), stats["id1"].Produced)
+	assert.Equal(t, int64(1), stats["id2"].Missed)
+	assert.Equal(t, int64(1), stats["id0"].Missed)
+
+	ps.record(property, newBlock(311), newBlock(304))
+	stats = ps.all()
+	assert.Equal(t, int64(3), stats["id0"].Missed)
+	assert.Equal(t, int64(2), stats["id1"].Missed)
+	assert.Equal(t, int64(3), stats["id2"].Missed)
+	assert.Equal(t, int64(1), stats["id2"].Produced)
+}
@@ -7,8 +7,6 @@ import (
 	"github.com/iost-official/go-iost/common"
 )
 
-var staticProperty *StaticProperty
-
 // StaticProperty handles the the static property of pob.
 type StaticProperty struct {
 	account           *account.KeyPair
@@ -50,17 +48,17 @@ var (
 	second2nanosecond int64 = 1000000000
 )
 
-func witnessOfNanoSec(nanosec int64) string {
-	return witnessOfSec(nanosec / second2nanosecond)
+func (property *StaticProperty) witnessOfNanoSec(nanosec int64) string {
+	return property.witnessOfSec(nanosec / second2nanosecond)
 }
 
-func witnessOfSec(sec int64) string {
-	return witnessOfSlot(sec / common.SlotLength)
+func (property *StaticProperty) witnessOfSec(sec int64) string {
+	return property.witnessOfSlot(sec / common.SlotLength)
 }
 
-func witnessOfSlot(slot int64) string {
-	index := slot % staticProperty.NumberOfWitnesses
-	witness := staticProperty.WitnessList[index]
+func (property *StaticProperty) witnessOfSlot(slot int64) string {
+	index := slot % property.NumberOfWitnesses
+	witness := property.WitnessList[index]
 	return witness
 }
 
@@ -68,8 +66,3 @@ func timeUntilNextSchedule(timeSec int64) int64 {
 	currentSlot := timeSec / (second2nanosecond * common.SlotLength)
 	return (currentSlot+1)*second2nanosecond*common.SlotLength - timeSec
 }
-
-// GetStaticProperty return property. RPC needs it.
-func GetStaticProperty() *StaticProperty {
-	return staticProperty
-}
@@ -0,0 +1,214 @@
+package pob
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"sync"
+
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/blockcache"
+	"github.com/iost-official/go-iost/db/kv"
+	"github.com/iost-official/go-iost/ilog"
+)
+
+// The finality votes make a block irreversible as soon as 2/3+1 witnesses have voted, instead of waiting for the
+// watermarks implied by the blocks produced after it. A witness pre-commits the head of its longest chain, and
+// commits a block when 2/3+1 witnesses have pre-committed it. The block is flushed when 2/3+1 witnesses have
+// committed it. The watermark rule still makes the blocks irreversible if the votes are lost.
+// The node keeps the lock rule for its own votes: it votes each type with increasing numbers only, and never votes
+// a block not descending from the block it committed last, until that block is not after the LIB. The lock applies to
+// the pre-commits as well, so the node doesn't help another fork to collect the pre-commits for a commit it can't join.
+// The last votes are saved in VoteDB before broadcasting, so the rule holds after restarting.
+// A witness has at most one vote of a type for a number in the pool, a second one for another block is the evidence
+// of equivocation, which is not counted nor relayed.
+
+// maxVoteAhead limits the number of blocks after the LIB that votes are kept for.
+const maxVoteAhead = 1024
+
+var lastVotePrefix = []byte("v")
+
+var (
+	errVoted  = errors.New("voted a block not before it")
+	errLocked = errors.New("block not descending from the last committed one")
+)
+
+type voteKey struct {
+	witness string
+	number  int64
+	t       block.VoteType
+}
+
+type votePool struct {
+	votes   map[string]map[block.VoteType]map[string]bool // block hash -> vote type -> witnesses
+	numbers map[string]int64
+	cast    map[voteKey]*block.Vote
+	pruned  int64
+	mu      sync.Mutex
+}
+
+func newVotePool() *votePool {
+	return &votePool{
+		votes:   make(map[string]map[block.VoteType]map[string]bool),
+		numbers: make(map[string]int64),
+		cast:    make(map[voteKey]*block.Vote),
+	}
+}
+
+// add saves the vote and returns whether it is a new one. If the witness has voted another block of the number
+// with the type, the vote is not saved, and the one saved is returned as the evidence of equivocation.
+func (vp *votePool) add(v *block.Vote) (bool, *block.Vote) {
+	vp.mu.Lock()
+	defer vp.mu.Unlock()
+	if v.Number <= vp.pruned {
+		return false, nil
+	}
+	key := voteKey{witness: v.Witness(), number: v.Number, t: v.Type}
+	if cast, ok := vp.cast[key]; ok {
+		if bytes.Equal(cast.Hash, v.Hash) {
+			return false, nil
+		}
+		return false, cast
+	}
+	vp.cast[key] = v
+	hash := string(v.Hash)
+	types, ok := vp.votes[hash]
+	if !ok {
+		types = make(map[block.VoteType]map[string]bool)
+		vp.votes[hash] = types
+		vp.numbers[hash] = v.Number
+	}
+	witnesses, ok := types[v.Type]
+	if !ok {
+		witnesses = make(map[string]bool)
+		types[v.Type] = witnesses
+	}
+	witnesses[key.witness] = true
+	return true, nil
+}
+
+// count returns the number of the witnesses voting the block with the type.
+func (vp *votePool) count(hash []byte, t block.VoteType, witnessList []string) int64 {
+	vp.mu.Lock()
+	defer vp.mu.Unlock()
+	witnesses := vp.votes[string(hash)][t]
+	var n int64
+	for _, w := range witnessList {
+		if witnesses[w] {
+			n++
+		}
+	}
+	return n
+}
+
+// prune removes the votes of the blocks not after the LIB.
+func (vp *votePool) prune(lib int64) {
+	vp.mu.Lock()
+	defer vp.mu.Unlock()
+	if lib <= vp.pruned {
+		return
+	}
+	for hash, number := range vp.numbers {
+		if number <= lib {
+			delete(vp.votes, hash)
+			delete(vp.numbers, hash)
+		}
+	}
+	for key := range vp.cast {
+		if key.number <= lib {
+			delete(vp.cast, key)
+		}
+	}
+	vp.pruned = lib
+}
+
+type lastVote struct {
+	number int64
+	hash   []byte
+}
+
+// voteLock keeps the last votes of the node of each type.
+type voteLock struct {
+	db   *kv.Storage
+	last map[block.VoteType]*lastVote
+	mu   sync.Mutex
+}
+
+// newVoteLock loads the last votes from db. The votes are kept in memory only if db is nil.
+func newVoteLock(db *kv.Storage) (*voteLock, error) {
+	vl := &voteLock{
+		db:   db,
+		last: make(map[block.VoteType]*lastVote),
+	}
+	if db == nil {
+		return vl, nil
+	}
+	for _, t := range []block.VoteType{block.PreCommit, block.Commit} {
+		b, err := db.Get(lastVoteKey(t))
+		if err != nil {
+			return nil, err
+		}
+		if len(b) < 8 {
+			continue
+		}
+		vl.last[t] = &lastVote{number: int64(binary.BigEndian.Uint64(b)), hash: b[8:]}
+	}
+	return vl, nil
+}
+
+// openVoteLock opens the vote lock saved at path. It returns nil if the last votes can't be loaded, then the node
+// doesn't vote, so it never breaks the lock rule, and the blocks are still made irreversible by the watermarks.
+func openVoteLock(path string) *voteLock {
+	db, err := kv.NewStorage(path, kv.LevelDBStorage)
+	if err != nil {
+		ilog.Errorf("Failed to open vote db, the node will not vote. err: %v", err)
+		return nil
+	}
+	vl, err := newVoteLock(db)
+	if err != nil {
+		ilog.Errorf("Failed to load the last votes, the node will not vote. err: %v", err)
+		return nil
+	}
+	return vl
+}
+
+func lastVoteKey(t block.VoteType) []byte {
+	return append(append([]byte{}, lastVotePrefix...), byte(t))
+}
+
+// vote checks the lock rule for voting the node with the type, and saves it as the last vote if it's allowed.
+func (vl *voteLock) vote(t block.VoteType, node *blockcache.BlockCacheNode, root *blockcache.BlockCacheNode) error {
+	vl.mu.Lock()
+	defer vl.mu.Unlock()
+	if last, ok := vl.last[t]; ok && node.Head.Number <= last.number {
+		return errVoted
+	}
+	if !vl.descends(node, root) {
+		return errLocked
+	}
+	v := &lastVote{number: node.Head.Number, hash: node.HeadHash()}
+	if vl.db != nil {
+		b := make([]byte, 8, 8+len(v.hash))
+		binary.BigEndian.PutUint64(b, uint64(v.number))
+		if err := vl.db.Put(lastVoteKey(t), append(b, v.hash...)); err != nil {
+			return err
+		}
+	}
+	vl.last[t] = v
+	return nil
+}
+
+// descends returns whether the node descends from the last committed block. The lock is released when the block
+// is not after the root, since the blocks linked all descend from the root.
+func (vl *voteLock) descends(node *blockcache.BlockCacheNode, root *blockcache.BlockCacheNode) bool {
+	lock, ok := vl.last[block.Commit]
+	if !ok || lock.number <= root.Head.Number {
+		return true
+	}
+	for n := node; n != nil && n.Head.Number >= lock.number; n = n.GetParent() {
+		if n.Head.Number == lock.number {
+			return bytes.Equal(n.HeadHash(), lock.hash)
+		}
+	}
+	return false
+}
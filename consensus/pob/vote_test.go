@@ -0,0 +1,106 @@
+package pob
+
+import (
+	"os"
+	"testing"
+
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/blockcache"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/iost-official/go-iost/db/kv"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVotePool(t *testing.T) {
+	vp := newVotePool()
+	witnessList := make([]string, 0)
+	keys := make([]*account.KeyPair, 0)
+	for i := 0; i < 3; i++ {
+		kp, err := account.NewKeyPair(nil, crypto.Ed25519)
+		assert.Nil(t, err)
+		keys = append(keys, kp)
+		witnessList = append(witnessList, kp.ID)
+	}
+	hash := []byte("hash")
+	add := func(v *block.Vote) bool {
+		isNew, cast := vp.add(v)
+		assert.Nil(t, cast)
+		return isNew
+	}
+	first := block.NewVote(block.PreCommit, 5, hash, keys[0])
+	assert.True(t, add(first))
+	assert.False(t, add(block.NewVote(block.PreCommit, 5, hash, keys[0])))
+	assert.True(t, add(block.NewVote(block.PreCommit, 5, hash, keys[1])))
+	assert.True(t, add(block.NewVote(block.Commit, 5, hash, keys[2])))
+
+	// the vote for another block of the same number and type is the evidence of equivocation
+	isNew, cast := vp.add(block.NewVote(block.PreCommit, 5, []byte("other"), keys[0]))
+	assert.False(t, isNew)
+	assert.Equal(t, first, cast)
+	assert.Equal(t, int64(0), vp.count([]byte("other"), block.PreCommit, witnessList))
+	assert.True(t, add(block.NewVote(block.Commit, 5, []byte("other"), keys[0])))
+	assert.True(t, add(block.NewVote(block.PreCommit, 6, []byte("next"), keys[0])))
+	assert.Equal(t, int64(2), vp.count(hash, block.PreCommit, witnessList))
+	assert.Equal(t, int64(1), vp.count(hash, block.Commit, witnessList))
+	assert.Equal(t, int64(1), vp.count(hash, block.PreCommit, witnessList[1:]))
+	assert.Equal(t, int64(0), vp.count([]byte("other"), block.PreCommit, witnessList))
+
+	vp.prune(6)
+	assert.Equal(t, int64(0), vp.count(hash, block.PreCommit, witnessList))
+	assert.False(t, add(block.NewVote(block.PreCommit, 5, hash, keys[0])))
+	assert.Equal(t, 0, len(vp.votes))
+	assert.Equal(t, 0, len(vp.cast))
+}
+
+func TestVoteLock(t *testing.T) {
+	os.RemoveAll("VoteDB")
+	defer os.RemoveAll("VoteDB")
+	newNode := func(parent *blockcache.BlockCacheNode, number int64, fork string) *blockcache.BlockCacheNode {
+		blk := &block.Block{Head: &block.BlockHead{Number: number, Witness: fork}}
+		if parent != nil {
+			blk.Head.ParentHash = parent.HeadHash()
+		}
+		blk.CalculateHeadHash()
+		return blockcache.NewBCN(parent, blk)
+	}
+	// two forks from the root: a2 <- a3, and b2 <- b3 <- b4
+	root := newNode(nil, 1, "root")
+	a2 := newNode(root, 2, "a")
+	a3 := newNode(a2, 3, "a")
+	b2 := newNode(root, 2, "b")
+	b3 := newNode(b2, 3, "b")
+	b4 := newNode(b3, 4, "b")
+
+	db, err := kv.NewStorage("VoteDB", kv.LevelDBStorage)
+	assert.Nil(t, err)
+	vl, err := newVoteLock(db)
+	assert.Nil(t, err)
+	assert.Nil(t, vl.vote(block.PreCommit, a2, root))
+	assert.Equal(t, errVoted, vl.vote(block.PreCommit, b2, root))
+	assert.Nil(t, vl.vote(block.PreCommit, b3, root))
+	assert.Nil(t, vl.vote(block.Commit, a2, root))
+
+	// locked on a2, the blocks of the other fork are refused even if they are higher
+	assert.Equal(t, errLocked, vl.vote(block.PreCommit, b4, root))
+	assert.Equal(t, errLocked, vl.vote(block.Commit, b3, root))
+	assert.Nil(t, vl.vote(block.Commit, a3, root))
+	assert.Equal(t, errVoted, vl.vote(block.PreCommit, a3, root))
+	db.Close()
+
+	// the last votes and the lock are kept after restarting
+	db, err = kv.NewStorage("VoteDB", kv.LevelDBStorage)
+	assert.Nil(t, err)
+	defer db.Close()
+	vl, err = newVoteLock(db)
+	assert.Nil(t, err)
+	assert.Equal(t, errVoted, vl.vote(block.Commit, b3, root))
+	assert.Equal(t, errLocked, vl.vote(block.Commit, b4, root))
+	a4 := newNode(a3, 4, "a")
+	assert.Nil(t, vl.vote(block.PreCommit, a4, root))
+
+	// the lock is released when the committed block is not after the root
+	b5 := newNode(b4, 5, "b")
+	assert.Equal(t, errLocked, vl.vote(block.PreCommit, b5, root))
+	assert.Nil(t, vl.vote(block.PreCommit, b5, a3))
+}
@@ -0,0 +1,479 @@
+package synchronizer
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/common"
+	msgpb "github.com/iost-official/go-iost/consensus/synchronizer/pb"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/blockcache"
+	"github.com/iost-official/go-iost/db"
+	"github.com/iost-official/go-iost/ilog"
+	"github.com/iost-official/go-iost/p2p"
+	"github.com/iost-official/go-iost/vm/database"
+)
+
+var (
+	maxHeaderQueryNumber int64 = 100
+
+	errHeaderParent    = errors.New("header doesn't link to its parent")
+	errHeaderNumber    = errors.New("wrong header number")
+	errHeaderTime      = errors.New("wrong header time")
+	errHeaderWitness   = errors.New("header isn't produced by the scheduled witness")
+	errHeaderSignature = errors.New("wrong header signature")
+	errWitnessProof    = errors.New("wrong witness proof")
+)
+
+// keys of the pending witness list in the state of vote_producer.iost
+const (
+	pendingWitnessListKey   = database.BasicPrefix + "vote_producer.iost" + database.Separator + "pendingProducerList"
+	pendingWitnessNumberKey = database.BasicPrefix + "vote_producer.iost" + database.Separator + "pendingBlockNumber"
+)
+
+type headerNode struct {
+	blk          *block.Block
+	confirmUntil int64
+}
+
+// HeaderSync is the synchronizer of header-only mode.
+// It requests block heads from full nodes, checks them against the witness schedule and
+// tracks the irreversible head without executing any transaction.
+//
+// Only the witness list which the node starts with is trusted. A change of the witness list is
+// adopted only if it's proven by the peer against the state root of the last irreversible header,
+// which is confirmed under the current schedule.
+type HeaderSync struct {
+	p2pService  p2p.Service
+	headerChain *block.HeaderChain
+	heightMap   *sync.Map
+
+	mu          sync.Mutex
+	lib         *block.Block
+	witnessList blockcache.WitnessList
+	pending     []*headerNode
+	watermark   map[string]int64
+
+	messageChan    chan p2p.IncomingMessage
+	syncHeightChan chan p2p.IncomingMessage
+	exitSignal     chan struct{}
+	wg             *sync.WaitGroup
+}
+
+// NewHeaderSync returns a HeaderSync instance.
+// The header chain must contain at least the genesis header.
+func NewHeaderSync(headerChain *block.HeaderChain, p2pserv p2p.Service) (*HeaderSync, error) {
+	lib, err := headerChain.Top()
+	if err != nil {
+		return nil, err
+	}
+	wlBytes, err := headerChain.WitnessList()
+	if err != nil {
+		return nil, err
+	}
+	hs := &HeaderSync{
+		p2pService:  p2pserv,
+		headerChain: headerChain,
+		heightMap:   new(sync.Map),
+		lib:         lib,
+		pending:     make([]*headerNode, 0),
+		watermark:   make(map[string]int64),
+		exitSignal:  make(chan struct{}),
+		wg:          new(sync.WaitGroup),
+	}
+	if err := proto.Unmarshal(wlBytes, &hs.witnessList); err != nil {
+		return nil, err
+	}
+	hs.messageChan = hs.p2pService.Register("header sync message", p2p.SyncHeaderResponse)
+	hs.syncHeightChan = hs.p2pService.Register("header sync height", p2p.SyncHeight)
+	ilog.Infof("NewHeaderSync lib:%v, active witness:%v", lib.Head.Number, hs.witnessList.Active())
+	return hs, nil
+}
+
+// Start starts the header synchronizer.
+func (hs *HeaderSync) Start() error {
+	hs.wg.Add(2)
+	go hs.syncHeightLoop()
+	go hs.messageLoop()
+	return nil
+}
+
+// Stop stops the header synchronizer.
+func (hs *HeaderSync) Stop() {
+	close(hs.exitSignal)
+	hs.wg.Wait()
+}
+
+// Head returns the newest header.
+func (hs *HeaderSync) Head() *block.Block {
+	hs.mu.Lock()
+	defer hs.mu.Unlock()
+	return hs.head()
+}
+
+// LinkedRoot returns the last irreversible header.
+func (hs *HeaderSync) LinkedRoot() *block.Block {
+	hs.mu.Lock()
+	defer hs.mu.Unlock()
+	return hs.lib
+}
+
+func (hs *HeaderSync) head() *block.Block {
+	if len(hs.pending) == 0 {
+		return hs.lib
+	}
+	return hs.pending[len(hs.pending)-1].blk
+}
+
+func (hs *HeaderSync) headerAt(number int64) *block.Block {
+	if number == hs.lib.Head.Number {
+		return hs.lib
+	}
+	idx := number - hs.lib.Head.Number - 1
+	if idx < 0 || idx >= int64(len(hs.pending)) {
+		return nil
+	}
+	return hs.pending[idx].blk
+}
+
+func (hs *HeaderSync) syncHeightLoop() {
+	defer hs.wg.Done()
+	checkTicker := time.NewTicker(checkTime)
+	for {
+		select {
+		case req := <-hs.syncHeightChan:
+			var sh msgpb.SyncHeight
+			err := proto.Unmarshal(req.Data(), &sh)
+			if err != nil {
+				ilog.Errorf("unmarshal syncheight failed. err=%v", err)
+				continue
+			}
+			hs.heightMap.Store(req.From(), &sh)
+		case <-checkTicker.C:
+			hs.requestHeaders()
+		case <-hs.exitSignal:
+			checkTicker.Stop()
+			return
+		}
+	}
+}
+
+func (hs *HeaderSync) requestHeaders() {
+	var peerID p2p.PeerID
+	var netHeight int64
+	now := time.Now().Unix()
+	hs.heightMap.Range(func(k, v interface{}) bool {
+		sh, ok := v.(*msgpb.SyncHeight)
+		if !ok || sh.Time+heightAvailableTime < now {
+			hs.heightMap.Delete(k)
+			return true
+		}
+		if sh.Height > netHeight {
+			netHeight = sh.Height
+			peerID, _ = k.(p2p.PeerID)
+		}
+		return true
+	})
+	hs.mu.Lock()
+	start := hs.head().Head.Number + 1
+	witnessNumber := hs.lib.Head.Number
+	hs.mu.Unlock()
+	if netHeight < start {
+		return
+	}
+	end := netHeight
+	if end > start+maxHeaderQueryNumber-1 {
+		end = start + maxHeaderQueryNumber - 1
+	}
+	bytes, err := proto.Marshal(&msgpb.HeaderQuery{Start: start, End: end, WitnessNumber: witnessNumber})
+	if err != nil {
+		ilog.Errorf("marshal headerquery failed. err=%v", err)
+		return
+	}
+	ilog.Debugf("[headersync] request headers. start=%v, end=%v, peer=%v", start, end, peerID.Pretty())
+	hs.p2pService.SendToPeer(peerID, bytes, p2p.SyncHeaderRequest, p2p.UrgentMessage)
+}
+
+func (hs *HeaderSync) messageLoop() {
+	defer hs.wg.Done()
+	for {
+		select {
+		case req := <-hs.messageChan:
+			var rh msgpb.HeaderResponse
+			err := proto.Unmarshal(req.Data(), &rh)
+			if err != nil {
+				ilog.Errorf("unmarshal HeaderResponse failed:%v", err)
+				break
+			}
+			hs.handleHeaderResp(&rh)
+		case <-hs.exitSignal:
+			return
+		}
+	}
+}
+
+func (hs *HeaderSync) handleHeaderResp(rh *msgpb.HeaderResponse) {
+	hs.mu.Lock()
+	defer hs.mu.Unlock()
+	if rh.WitnessProof != nil {
+		if err := hs.updateWitness(rh.WitnessProof); err != nil {
+			ilog.Warnf("[headersync] update witness failed:%v", err)
+		}
+	}
+	for _, b := range rh.Headers {
+		var blk block.Block
+		if err := blk.Decode(b); err != nil {
+			ilog.Warnf("decode header failed:%v", err)
+			return
+		}
+		number := blk.Head.Number
+		if number <= hs.lib.Head.Number {
+			continue
+		}
+		parent := hs.headerAt(number - 1)
+		if parent == nil {
+			ilog.Debugf("[headersync] header %v is not continuous with head %v", number, hs.head().Head.Number)
+			return
+		}
+		if old := hs.headerAt(number); old != nil && bytes.Equal(old.HeadHash(), blk.HeadHash()) {
+			continue
+		}
+		if err := hs.verifyHeader(&blk, parent); err != nil {
+			ilog.Warnf("[headersync] verify header %v failed:%v", number, err)
+			if err == errHeaderParent && len(hs.pending) > 0 {
+				// the peer is on another fork, drop the reversible headers and restart from lib.
+				hs.pending = hs.pending[:0]
+			}
+			return
+		}
+		hs.pending = hs.pending[:number-hs.lib.Head.Number-1]
+		hs.addHeader(&blk)
+	}
+}
+
+func witnessOfTime(witnessList []string, nanosec int64) string {
+	if len(witnessList) == 0 {
+		return ""
+	}
+	slot := nanosec / int64(time.Second) / common.SlotLength
+	return witnessList[slot%int64(len(witnessList))]
+}
+
+func (hs *HeaderSync) verifyHeader(blk *block.Block, parent *block.Block) error {
+	head := blk.Head
+	if blk.Sign == nil {
+		return errHeaderSignature
+	}
+	blk.Sign.SetPubkey(account.GetPubkeyByID(head.Witness))
+	if !blk.Sign.Verify(blk.HeadHash()) {
+		return errHeaderSignature
+	}
+	if !bytes.Equal(head.ParentHash, parent.HeadHash()) {
+		return errHeaderParent
+	}
+	if head.Number != parent.Head.Number+1 {
+		return errHeaderNumber
+	}
+	if head.Time > time.Now().UnixNano() || head.Time <= parent.Head.Time {
+		return errHeaderTime
+	}
+	if witnessOfTime(hs.witnessList.Active(), head.Time) != head.Witness {
+		return errHeaderWitness
+	}
+	return nil
+}
+
+func (hs *HeaderSync) addHeader(blk *block.Block) {
+	node := &headerNode{
+		blk:          blk,
+		confirmUntil: hs.watermark[blk.Head.Witness],
+	}
+	if blk.Head.Number >= hs.watermark[blk.Head.Witness] {
+		hs.watermark[blk.Head.Witness] = blk.Head.Number + 1
+	}
+	hs.pending = append(hs.pending, node)
+
+	confirmed := hs.calculateConfirm()
+	for i := 0; i <= confirmed; i++ {
+		if err := hs.flush(hs.pending[i].blk); err != nil {
+			ilog.Errorf("[headersync] flush header failed:%v", err)
+			hs.pending = hs.pending[i:]
+			return
+		}
+	}
+	hs.pending = hs.pending[confirmed+1:]
+}
+
+// calculateConfirm returns the index of the newest irreversible header in pending, or -1 if none.
+func (hs *HeaderSync) calculateConfirm() int {
+	confirmLimit := int64(len(hs.witnessList.Active()))*2/3 + 1
+	var confirmNum int64
+	confirmUntilMap := make(map[int64]int64, len(hs.pending))
+	for i := len(hs.pending) - 1; i >= 0; i-- {
+		node := hs.pending[i]
+		if node.confirmUntil <= node.blk.Head.Number {
+			confirmNum++
+			confirmUntilMap[node.confirmUntil]++
+		}
+		if confirmNum >= confirmLimit {
+			return i
+		}
+		confirmNum -= confirmUntilMap[node.blk.Head.Number]
+	}
+	return -1
+}
+
+func (hs *HeaderSync) flush(blk *block.Block) error {
+	if blk.Head.Number >= hs.witnessList.PendingNum() {
+		hs.witnessList.LibWitnessHandle()
+	}
+	wl, err := proto.Marshal(&hs.witnessList)
+	if err != nil {
+		return err
+	}
+	err = hs.headerChain.Push(blk, wl)
+	if err != nil {
+		return err
+	}
+	hs.lib = blk
+	ilog.Debugf("[headersync] confirm header: %v", blk.Head.Number)
+	return nil
+}
+
+// updateWitness adopts the pending witness list proven against the state root of the last irreversible header.
+func (hs *HeaderSync) updateWitness(wp *msgpb.WitnessProof) error {
+	if wp.Number != hs.lib.Head.Number || hs.lib.Head.Version < block.V1 {
+		return nil
+	}
+	root := hs.lib.Head.StateRoot
+	if !verifyStateProof(root, pendingWitnessListKey, wp.PendingList) ||
+		!verifyStateProof(root, pendingWitnessNumberKey, wp.PendingNumber) {
+		return errWitnessProof
+	}
+	spn, ok := database.Unmarshal(string(wp.PendingNumber.Value)).(string)
+	if !ok {
+		return errWitnessProof
+	}
+	pn, err := strconv.ParseInt(spn, 10, 64)
+	if err != nil {
+		return err
+	}
+	if pn <= hs.witnessList.PendingNum() {
+		return nil
+	}
+	jwl, ok := database.Unmarshal(string(wp.PendingList.Value)).(string)
+	if !ok {
+		return errWitnessProof
+	}
+	pending := make([]string, 0)
+	if err := json.Unmarshal([]byte(jwl), &pending); err != nil {
+		return err
+	}
+	hs.witnessList.SetPending(pending)
+	hs.witnessList.SetPendingNum(pn)
+	if pn <= hs.lib.Head.Number {
+		hs.witnessList.LibWitnessHandle()
+	}
+	ilog.Infof("[headersync] pending witness list updated: %v, number: %v", pending, pn)
+	return nil
+}
+
+func verifyStateProof(root []byte, key string, sp *msgpb.StateProof) bool {
+	if sp == nil {
+		return false
+	}
+	return db.VerifyStateProof(root, database.StateTable, key, string(sp.Value), true, &db.StateProof{
+		Siblings:  sp.Siblings,
+		LeafKey:   sp.LeafKey,
+		LeafValue: sp.LeafValue,
+	})
+}
+
+func newStateProof(stateDB db.MVCCDB, key string) (*msgpb.StateProof, error) {
+	value, err := stateDB.Get(database.StateTable, key)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := stateDB.Prove(database.StateTable, key)
+	if err != nil {
+		return nil, err
+	}
+	return &msgpb.StateProof{
+		Value:     []byte(value),
+		Siblings:  proof.Siblings,
+		LeafKey:   proof.LeafKey,
+		LeafValue: proof.LeafValue,
+	}, nil
+}
+
+// newWitnessProof proves the pending witness list in the state after the block.
+func newWitnessProof(blk *block.Block, stateDB db.MVCCDB) (*msgpb.WitnessProof, error) {
+	if blk.Head.Version < block.V1 {
+		return nil, nil
+	}
+	sdb := stateDB.Fork()
+	if !sdb.Checkout(string(blk.HeadHash())) {
+		var err error
+		sdb, err = stateDB.Historical(string(blk.HeadHash()))
+		if err != nil {
+			return nil, nil
+		}
+	}
+	pendingList, err := newStateProof(sdb, pendingWitnessListKey)
+	if err != nil {
+		return nil, err
+	}
+	pendingNumber, err := newStateProof(sdb, pendingWitnessNumberKey)
+	if err != nil {
+		return nil, err
+	}
+	return &msgpb.WitnessProof{
+		Number:        blk.Head.Number,
+		PendingList:   pendingList,
+		PendingNumber: pendingNumber,
+	}, nil
+}
+
+// NewHeaderResponse builds the response of header query from the block cache and the block chain,
+// with the proof of the pending witness list at the queried witness number if its state is available.
+func NewHeaderResponse(rh *msgpb.HeaderQuery, blkCache blockcache.BlockCache, chain block.Chain, stateDB db.MVCCDB) (*msgpb.HeaderResponse, error) {
+	end := rh.End
+	if end > rh.Start+maxHeaderQueryNumber-1 {
+		end = rh.Start + maxHeaderQueryNumber - 1
+	}
+	resp := &msgpb.HeaderResponse{
+		Headers: make([][]byte, 0, end-rh.Start+1),
+	}
+	for i := rh.Start; i <= end; i++ {
+		blk, err := blkCache.GetBlockByNumber(i)
+		if err != nil {
+			blk, err = chain.GetBlockByNumber(i)
+			if err != nil {
+				break
+			}
+		}
+		b, err := blk.HeaderOnly().Encode()
+		if err != nil {
+			return nil, err
+		}
+		resp.Headers = append(resp.Headers, b)
+	}
+	blk, err := blkCache.GetBlockByNumber(rh.WitnessNumber)
+	if err != nil {
+		blk, err = chain.GetBlockByNumber(rh.WitnessNumber)
+		if err != nil {
+			return resp, nil
+		}
+	}
+	resp.WitnessProof, err = newWitnessProof(blk, stateDB)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
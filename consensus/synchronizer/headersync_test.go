@@ -0,0 +1,200 @@
+package synchronizer
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/proto"
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/common"
+	msgpb "github.com/iost-official/go-iost/consensus/synchronizer/pb"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/blockcache"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/iost-official/go-iost/db"
+	"github.com/iost-official/go-iost/ilog"
+	"github.com/iost-official/go-iost/p2p"
+	"github.com/iost-official/go-iost/p2p/mocks"
+	"github.com/iost-official/go-iost/vm/database"
+	"github.com/stretchr/testify/assert"
+)
+
+func signedHeader(acc *account.KeyPair, parent *block.Block, slot int64) *block.Block {
+	blk := &block.Block{
+		Head: &block.BlockHead{
+			ParentHash: parent.HeadHash(),
+			Number:     parent.Head.Number + 1,
+			Witness:    acc.ID,
+			Time:       slot * common.SlotLength * int64(time.Second),
+		},
+	}
+	blk.CalculateHeadHash()
+	blk.Sign = acc.Sign(blk.HeadHash())
+	return blk
+}
+
+func TestHeaderSync(t *testing.T) {
+	ilog.Stop()
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	p2pMock := p2p_mock.NewMockService(ctl)
+	p2pMock.EXPECT().Register(gomock.Any(), gomock.Any()).AnyTimes().Return(make(chan p2p.IncomingMessage, 1))
+
+	accs := make([]*account.KeyPair, 3)
+	witnesses := make([]string, 3)
+	for i := range accs {
+		accs[i], _ = account.NewKeyPair(nil, crypto.Ed25519)
+		witnesses[i] = accs[i].ID
+	}
+	wl := blockcache.WitnessList{}
+	wl.SetPending(witnesses)
+	wl.LibWitnessHandle()
+	wlBytes, _ := proto.Marshal(&wl)
+
+	os.RemoveAll("HeaderChainDB")
+	defer os.RemoveAll("HeaderChainDB")
+	hc, err := block.NewHeaderChain("HeaderChainDB")
+	assert.Nil(t, err)
+	defer hc.Close()
+	genesis := &block.Block{Head: &block.BlockHead{Number: 0}, Sign: &crypto.Signature{}}
+	genesis.CalculateHeadHash()
+	assert.Nil(t, hc.Push(genesis, wlBytes))
+
+	hs, err := NewHeaderSync(hc, p2pMock)
+	assert.Nil(t, err)
+
+	slot := time.Now().Unix()/common.SlotLength - 100
+	headers := make([]*block.Block, 0)
+	parent := genesis
+	for i := 0; i < 6; i++ {
+		slot++
+		blk := signedHeader(accs[slot%3], parent, slot)
+		headers = append(headers, blk)
+		parent = blk
+	}
+	resp := &msgpb.HeaderResponse{}
+	for _, blk := range headers {
+		b, err := blk.Encode()
+		assert.Nil(t, err)
+		resp.Headers = append(resp.Headers, b)
+	}
+
+	hs.handleHeaderResp(&msgpb.HeaderResponse{Headers: resp.Headers[1:]})
+	assert.Equal(t, int64(0), hs.Head().Head.Number)
+
+	hs.handleHeaderResp(resp)
+	assert.Equal(t, int64(6), hs.Head().Head.Number)
+	assert.Equal(t, int64(4), hs.LinkedRoot().Head.Number)
+	assert.Equal(t, int64(5), hc.Length())
+
+	// a header of wrong witness
+	wrong := signedHeader(accs[(slot+2)%3], parent, slot+1)
+	b, _ := wrong.Encode()
+	hs.handleHeaderResp(&msgpb.HeaderResponse{Headers: [][]byte{b}})
+	assert.Equal(t, int64(6), hs.Head().Head.Number)
+
+	// a header of wrong signature
+	wrong = signedHeader(accs[(slot+1)%3], parent, slot+1)
+	wrong.Sign = accs[slot%3].Sign(wrong.HeadHash())
+	b, _ = wrong.Encode()
+	hs.handleHeaderResp(&msgpb.HeaderResponse{Headers: [][]byte{b}})
+	assert.Equal(t, int64(6), hs.Head().Head.Number)
+
+	// a fork of the reversible headers
+	fork := signedHeader(accs[(slot+1)%3], headers[4], slot+1)
+	b, _ = fork.Encode()
+	hs.handleHeaderResp(&msgpb.HeaderResponse{Headers: [][]byte{b}})
+	assert.Equal(t, fork.HeadHash(), hs.Head().HeadHash())
+
+	top, err := hc.Top()
+	assert.Nil(t, err)
+	assert.Equal(t, headers[3].HeadHash(), top.HeadHash())
+}
+
+func TestHeaderSyncWitnessProof(t *testing.T) {
+	ilog.Stop()
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	p2pMock := p2p_mock.NewMockService(ctl)
+	p2pMock.EXPECT().Register(gomock.Any(), gomock.Any()).AnyTimes().Return(make(chan p2p.IncomingMessage, 1))
+
+	accs := make([]*account.KeyPair, 6)
+	witnesses := make([]string, 6)
+	for i := range accs {
+		accs[i], _ = account.NewKeyPair(nil, crypto.Ed25519)
+		witnesses[i] = accs[i].ID
+	}
+	oldAccs, newAccs := accs[:3], accs[3:]
+	wl := blockcache.WitnessList{}
+	wl.SetPending(witnesses[:3])
+	wl.LibWitnessHandle()
+	wlBytes, _ := proto.Marshal(&wl)
+
+	os.RemoveAll("HeaderSyncStateDB")
+	defer os.RemoveAll("HeaderSyncStateDB")
+	stateDB, err := db.NewMVCCDB("HeaderSyncStateDB")
+	assert.Nil(t, err)
+	defer stateDB.Close()
+	pendingList, _ := json.Marshal(witnesses[3:])
+	stateDB.Put(database.StateTable, pendingWitnessListKey, database.MustMarshal(string(pendingList)))
+	stateDB.Put(database.StateTable, pendingWitnessNumberKey, database.MustMarshal("1"))
+	root, err := stateDB.StateRoot()
+	assert.Nil(t, err)
+	genesis := &block.Block{Head: &block.BlockHead{Version: block.V1, Number: 0, StateRoot: root}, Sign: &crypto.Signature{}}
+	genesis.CalculateHeadHash()
+	stateDB.Tag(string(genesis.HeadHash()))
+
+	os.RemoveAll("HeaderChainDB")
+	defer os.RemoveAll("HeaderChainDB")
+	hc, err := block.NewHeaderChain("HeaderChainDB")
+	assert.Nil(t, err)
+	defer hc.Close()
+	assert.Nil(t, hc.Push(genesis, wlBytes))
+	hs, err := NewHeaderSync(hc, p2pMock)
+	assert.Nil(t, err)
+
+	wp, err := newWitnessProof(genesis, stateDB)
+	assert.Nil(t, err)
+	forged := *wp.PendingList
+	forgedList, _ := json.Marshal(witnesses[:4])
+	forged.Value = []byte(database.MustMarshal(string(forgedList)))
+	hs.handleHeaderResp(&msgpb.HeaderResponse{WitnessProof: &msgpb.WitnessProof{
+		Number:        0,
+		PendingList:   &forged,
+		PendingNumber: wp.PendingNumber,
+	}})
+	assert.Equal(t, int64(0), hs.witnessList.PendingNum())
+
+	hs.handleHeaderResp(&msgpb.HeaderResponse{WitnessProof: wp})
+	assert.Equal(t, witnesses[3:], hs.witnessList.Pending())
+	assert.Equal(t, witnesses[:3], hs.witnessList.Active())
+
+	// the old witnesses confirm the pending number, then the new witnesses take over
+	slot := time.Now().Unix()/common.SlotLength - 100
+	parent := genesis
+	headers := make([][]byte, 0)
+	for i := 0; i < 3; i++ {
+		slot++
+		blk := signedHeader(oldAccs[slot%3], parent, slot)
+		b, _ := blk.Encode()
+		headers = append(headers, b)
+		parent = blk
+	}
+	hs.handleHeaderResp(&msgpb.HeaderResponse{Headers: headers})
+	assert.Equal(t, int64(1), hs.LinkedRoot().Head.Number)
+	assert.Equal(t, witnesses[3:], hs.witnessList.Active())
+
+	slot++
+	wrong := signedHeader(oldAccs[slot%3], parent, slot)
+	b, _ := wrong.Encode()
+	hs.handleHeaderResp(&msgpb.HeaderResponse{Headers: [][]byte{b}})
+	assert.Equal(t, int64(3), hs.Head().Head.Number)
+
+	next := signedHeader(newAccs[slot%3], parent, slot)
+	b, _ = next.Encode()
+	hs.handleHeaderResp(&msgpb.HeaderResponse{Headers: [][]byte{b}})
+	assert.Equal(t, int64(4), hs.Head().Head.Number)
+}
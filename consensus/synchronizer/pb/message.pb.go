@@ -241,12 +241,236 @@ func (m *SyncHeight) GetTime() int64 {
 	return 0
 }
 
+type HeaderQuery struct {
+	Start                int64    `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End                  int64    `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	WitnessNumber        int64    `protobuf:"varint,3,opt,name=witnessNumber,proto3" json:"witnessNumber,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HeaderQuery) Reset()         { *m = HeaderQuery{} }
+func (m *HeaderQuery) String() string { return proto.CompactTextString(m) }
+func (*HeaderQuery) ProtoMessage()    {}
+func (*HeaderQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e960d3736d18fa7, []int{4}
+}
+
+func (m *HeaderQuery) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HeaderQuery.Unmarshal(m, b)
+}
+func (m *HeaderQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HeaderQuery.Marshal(b, m, deterministic)
+}
+func (m *HeaderQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeaderQuery.Merge(m, src)
+}
+func (m *HeaderQuery) XXX_Size() int {
+	return xxx_messageInfo_HeaderQuery.Size(m)
+}
+func (m *HeaderQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeaderQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeaderQuery proto.InternalMessageInfo
+
+func (m *HeaderQuery) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *HeaderQuery) GetEnd() int64 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+func (m *HeaderQuery) GetWitnessNumber() int64 {
+	if m != nil {
+		return m.WitnessNumber
+	}
+	return 0
+}
+
+type StateProof struct {
+	Value                []byte   `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Siblings             [][]byte `protobuf:"bytes,2,rep,name=siblings,proto3" json:"siblings,omitempty"`
+	LeafKey              []byte   `protobuf:"bytes,3,opt,name=leafKey,proto3" json:"leafKey,omitempty"`
+	LeafValue            []byte   `protobuf:"bytes,4,opt,name=leafValue,proto3" json:"leafValue,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StateProof) Reset()         { *m = StateProof{} }
+func (m *StateProof) String() string { return proto.CompactTextString(m) }
+func (*StateProof) ProtoMessage()    {}
+func (*StateProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e960d3736d18fa7, []int{5}
+}
+
+func (m *StateProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateProof.Unmarshal(m, b)
+}
+func (m *StateProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateProof.Marshal(b, m, deterministic)
+}
+func (m *StateProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateProof.Merge(m, src)
+}
+func (m *StateProof) XXX_Size() int {
+	return xxx_messageInfo_StateProof.Size(m)
+}
+func (m *StateProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateProof proto.InternalMessageInfo
+
+func (m *StateProof) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *StateProof) GetSiblings() [][]byte {
+	if m != nil {
+		return m.Siblings
+	}
+	return nil
+}
+
+func (m *StateProof) GetLeafKey() []byte {
+	if m != nil {
+		return m.LeafKey
+	}
+	return nil
+}
+
+func (m *StateProof) GetLeafValue() []byte {
+	if m != nil {
+		return m.LeafValue
+	}
+	return nil
+}
+
+type WitnessProof struct {
+	Number               int64       `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	PendingList          *StateProof `protobuf:"bytes,2,opt,name=pendingList,proto3" json:"pendingList,omitempty"`
+	PendingNumber        *StateProof `protobuf:"bytes,3,opt,name=pendingNumber,proto3" json:"pendingNumber,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *WitnessProof) Reset()         { *m = WitnessProof{} }
+func (m *WitnessProof) String() string { return proto.CompactTextString(m) }
+func (*WitnessProof) ProtoMessage()    {}
+func (*WitnessProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e960d3736d18fa7, []int{6}
+}
+
+func (m *WitnessProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WitnessProof.Unmarshal(m, b)
+}
+func (m *WitnessProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WitnessProof.Marshal(b, m, deterministic)
+}
+func (m *WitnessProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WitnessProof.Merge(m, src)
+}
+func (m *WitnessProof) XXX_Size() int {
+	return xxx_messageInfo_WitnessProof.Size(m)
+}
+func (m *WitnessProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_WitnessProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WitnessProof proto.InternalMessageInfo
+
+func (m *WitnessProof) GetNumber() int64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *WitnessProof) GetPendingList() *StateProof {
+	if m != nil {
+		return m.PendingList
+	}
+	return nil
+}
+
+func (m *WitnessProof) GetPendingNumber() *StateProof {
+	if m != nil {
+		return m.PendingNumber
+	}
+	return nil
+}
+
+type HeaderResponse struct {
+	Headers              [][]byte      `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty"`
+	WitnessProof         *WitnessProof `protobuf:"bytes,3,opt,name=witnessProof,proto3" json:"witnessProof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *HeaderResponse) Reset()         { *m = HeaderResponse{} }
+func (m *HeaderResponse) String() string { return proto.CompactTextString(m) }
+func (*HeaderResponse) ProtoMessage()    {}
+func (*HeaderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e960d3736d18fa7, []int{7}
+}
+
+func (m *HeaderResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HeaderResponse.Unmarshal(m, b)
+}
+func (m *HeaderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HeaderResponse.Marshal(b, m, deterministic)
+}
+func (m *HeaderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeaderResponse.Merge(m, src)
+}
+func (m *HeaderResponse) XXX_Size() int {
+	return xxx_messageInfo_HeaderResponse.Size(m)
+}
+func (m *HeaderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeaderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeaderResponse proto.InternalMessageInfo
+
+func (m *HeaderResponse) GetHeaders() [][]byte {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *HeaderResponse) GetWitnessProof() *WitnessProof {
+	if m != nil {
+		return m.WitnessProof
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("msgpb.RequireType", RequireType_name, RequireType_value)
 	proto.RegisterType((*BlockInfo)(nil), "msgpb.BlockInfo")
 	proto.RegisterType((*BlockHashQuery)(nil), "msgpb.BlockHashQuery")
 	proto.RegisterType((*BlockHashResponse)(nil), "msgpb.BlockHashResponse")
 	proto.RegisterType((*SyncHeight)(nil), "msgpb.SyncHeight")
+	proto.RegisterType((*HeaderQuery)(nil), "msgpb.HeaderQuery")
+	proto.RegisterType((*StateProof)(nil), "msgpb.StateProof")
+	proto.RegisterType((*WitnessProof)(nil), "msgpb.WitnessProof")
+	proto.RegisterType((*HeaderResponse)(nil), "msgpb.HeaderResponse")
 }
 
 func init() {
@@ -254,25 +478,37 @@ func init() {
 }
 
 var fileDescriptor_1e960d3736d18fa7 = []byte{
-	// 308 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xcd, 0x4f, 0x83, 0x40,
-	0x10, 0xc5, 0xc5, 0x6d, 0x6b, 0x9c, 0x9a, 0x06, 0x37, 0xa6, 0x21, 0x9e, 0x08, 0x17, 0x89, 0x31,
-	0xad, 0xa9, 0x07, 0xbd, 0x78, 0x10, 0x43, 0xc4, 0xf8, 0x15, 0xb7, 0xf5, 0xe0, 0x11, 0x70, 0x2c,
-	0x44, 0x59, 0xe8, 0x0e, 0x1c, 0xe8, 0x5f, 0x6f, 0x76, 0xfb, 0x91, 0x7a, 0x7b, 0x6f, 0x98, 0xe1,
-	0xbd, 0x5f, 0x16, 0xce, 0xd2, 0x52, 0x12, 0x4a, 0x6a, 0x68, 0x4c, 0xad, 0x4c, 0x33, 0x55, 0xca,
-	0x7c, 0x89, 0x6a, 0x5c, 0x25, 0xe3, 0x02, 0x89, 0xe2, 0x39, 0x8e, 0x2a, 0x55, 0xd6, 0x25, 0xef,
-	0x16, 0x34, 0xaf, 0x12, 0xef, 0x1a, 0x0e, 0x83, 0xdf, 0x32, 0xfd, 0x79, 0x94, 0xdf, 0x25, 0x1f,
-	0x42, 0x4f, 0x36, 0x45, 0x82, 0xca, 0xb1, 0x5c, 0xcb, 0x67, 0x62, 0xed, 0x38, 0x87, 0x4e, 0x16,
-	0x53, 0xe6, 0xec, 0xbb, 0x96, 0x7f, 0x24, 0x8c, 0xf6, 0x96, 0x30, 0x30, 0x87, 0x51, 0x4c, 0xd9,
-	0x7b, 0x83, 0xaa, 0xe5, 0x17, 0x70, 0xa0, 0x70, 0x31, 0x6b, 0x2b, 0x34, 0xe7, 0x83, 0x09, 0x1f,
-	0x99, 0x8c, 0x91, 0xc0, 0x45, 0x93, 0x2b, 0xd4, 0x5f, 0xc4, 0x66, 0x85, 0x9f, 0x40, 0x97, 0xea,
-	0x58, 0xd5, 0xe6, 0xa7, 0x4c, 0xac, 0x0c, 0xb7, 0x81, 0xa1, 0xfc, 0x72, 0x98, 0x99, 0x69, 0xa9,
-	0xb3, 0x65, 0x53, 0x90, 0xd3, 0x71, 0x99, 0xcf, 0x84, 0xd1, 0x5e, 0x08, 0xc7, 0xdb, 0x6c, 0x81,
-	0x54, 0x69, 0x64, 0x7e, 0x09, 0x90, 0x6c, 0x48, 0xc8, 0xb1, 0x5c, 0xe6, 0xf7, 0x27, 0xf6, 0xba,
-	0xc1, 0x16, 0x51, 0xec, 0xec, 0x78, 0x37, 0x00, 0xd3, 0x56, 0xa6, 0x11, 0xe6, 0xf3, 0xac, 0xd6,
-	0xf0, 0x99, 0x51, 0x1b, 0xf8, 0x95, 0xd3, 0x05, 0xea, 0xbc, 0xc0, 0x75, 0x4f, 0xa3, 0xcf, 0x6f,
-	0xa1, 0xbf, 0x03, 0xc5, 0x39, 0x0c, 0x1e, 0xc2, 0x59, 0xf0, 0xfc, 0x76, 0xff, 0x14, 0xdd, 0x4d,
-	0xa3, 0x70, 0x6a, 0xef, 0xf1, 0x53, 0x18, 0xfe, 0x9f, 0x05, 0x9f, 0xaf, 0x1f, 0x2f, 0x41, 0x28,
-	0x6c, 0x2b, 0xe9, 0x99, 0x27, 0xb8, 0xfa, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x3f, 0x0f, 0x48, 0xd4,
-	0xad, 0x01, 0x00, 0x00,
+	// 498 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x41, 0x6f, 0xd3, 0x4c,
+	0x10, 0x86, 0x3f, 0xc7, 0x69, 0xd3, 0x4e, 0xdc, 0x28, 0xdd, 0x0f, 0x55, 0x56, 0xc5, 0x21, 0xb2,
+	0x90, 0xb0, 0x10, 0x4a, 0x50, 0x7a, 0x08, 0x17, 0x0e, 0x04, 0x45, 0x18, 0x5a, 0x0a, 0x6c, 0x0a,
+	0x08, 0x71, 0xb2, 0x93, 0x89, 0xbd, 0x22, 0x5e, 0xbb, 0xbb, 0x76, 0x2b, 0xf7, 0x77, 0xf0, 0x83,
+	0x91, 0x77, 0x6d, 0xc7, 0x91, 0xca, 0x6d, 0xde, 0xf1, 0xec, 0xbc, 0xf3, 0xcc, 0xae, 0xe1, 0xf9,
+	0x2a, 0xe1, 0x12, 0xb9, 0xcc, 0xe5, 0x44, 0x16, 0x7c, 0x15, 0x89, 0x84, 0xb3, 0x07, 0x14, 0x93,
+	0x34, 0x98, 0xc4, 0x28, 0xa5, 0x1f, 0xe2, 0x38, 0x15, 0x49, 0x96, 0x90, 0x83, 0x58, 0x86, 0x69,
+	0xe0, 0xcc, 0xe0, 0x78, 0xbe, 0x4d, 0x56, 0xbf, 0x3f, 0xf0, 0x4d, 0x42, 0xce, 0xe0, 0x90, 0xe7,
+	0x71, 0x80, 0xc2, 0x36, 0x46, 0x86, 0x6b, 0xd2, 0x4a, 0x11, 0x02, 0xdd, 0xc8, 0x97, 0x91, 0xdd,
+	0x19, 0x19, 0xae, 0x45, 0x55, 0xec, 0x3c, 0xc0, 0x40, 0x1d, 0xf4, 0x7c, 0x19, 0x7d, 0xcd, 0x51,
+	0x14, 0xe4, 0x25, 0xf4, 0x04, 0xde, 0xde, 0x14, 0x29, 0xaa, 0xe3, 0x83, 0x29, 0x19, 0x2b, 0x8f,
+	0x31, 0xc5, 0xdb, 0x9c, 0x09, 0x2c, 0xbf, 0xd0, 0xba, 0x84, 0x3c, 0x81, 0x03, 0x99, 0xf9, 0x22,
+	0x53, 0x4d, 0x4d, 0xaa, 0x05, 0x19, 0x82, 0x89, 0x7c, 0x6d, 0x9b, 0x2a, 0x57, 0x86, 0xa5, 0x37,
+	0xcf, 0x63, 0x69, 0x77, 0x47, 0xa6, 0x6b, 0x52, 0x15, 0x3b, 0x0b, 0x38, 0x6d, 0xbc, 0x29, 0xca,
+	0xb4, 0x44, 0x26, 0xaf, 0x00, 0x82, 0x9a, 0x44, 0xda, 0xc6, 0xc8, 0x74, 0xfb, 0xd3, 0x61, 0x35,
+	0x41, 0x83, 0x48, 0x5b, 0x35, 0xce, 0x6b, 0x80, 0x65, 0xc1, 0x57, 0x1e, 0xb2, 0x30, 0xca, 0x4a,
+	0xf8, 0x48, 0x45, 0x35, 0xbc, 0x56, 0xe5, 0x00, 0x19, 0x8b, 0xb1, 0x9a, 0x53, 0xc5, 0xce, 0x2f,
+	0xe8, 0x7b, 0xe8, 0xaf, 0x51, 0x68, 0xf2, 0x86, 0xc5, 0x78, 0x84, 0xa5, 0xb3, 0x63, 0x79, 0x06,
+	0x27, 0xf7, 0x2c, 0xe3, 0x28, 0xe5, 0xb5, 0x5e, 0xb3, 0xe6, 0xdc, 0x4f, 0x3a, 0x77, 0x00, 0xcb,
+	0xcc, 0xcf, 0xf0, 0x8b, 0x48, 0x92, 0x4d, 0xd9, 0xfb, 0xce, 0xdf, 0xe6, 0x7a, 0xa7, 0x16, 0xd5,
+	0x82, 0x9c, 0xc3, 0x91, 0x64, 0xc1, 0x96, 0xf1, 0x50, 0xda, 0x9d, 0x91, 0xe9, 0x5a, 0xb4, 0xd1,
+	0xc4, 0x86, 0xde, 0x16, 0xfd, 0xcd, 0x25, 0x16, 0xaa, 0xbf, 0x45, 0x6b, 0x49, 0x9e, 0xc2, 0x71,
+	0x19, 0x7e, 0x57, 0xfd, 0xba, 0xea, 0xdb, 0x2e, 0xe1, 0xfc, 0x31, 0xc0, 0xfa, 0xa1, 0x27, 0xd1,
+	0xd6, 0xff, 0x7a, 0x0e, 0x17, 0xd0, 0x4f, 0x91, 0xaf, 0x19, 0x0f, 0xaf, 0x98, 0xd4, 0x17, 0xd8,
+	0x9f, 0x9e, 0x56, 0xab, 0xde, 0x8d, 0x4e, 0xdb, 0x55, 0x64, 0x06, 0x27, 0x95, 0x6c, 0xb1, 0x3f,
+	0x7a, 0x6c, 0xbf, 0xce, 0x61, 0x30, 0xd0, 0xbb, 0x6e, 0x6e, 0xda, 0x86, 0x5e, 0xa4, 0x32, 0xfa,
+	0x9a, 0x2d, 0x5a, 0x4b, 0x32, 0x03, 0xeb, 0xbe, 0x45, 0x50, 0x79, 0xfc, 0x5f, 0x79, 0xb4, 0xe1,
+	0xe8, 0x5e, 0xe1, 0xc7, 0xee, 0x51, 0x67, 0x68, 0xbe, 0x78, 0x03, 0xfd, 0xd6, 0x5b, 0x25, 0x04,
+	0x06, 0xef, 0x17, 0x37, 0xf3, 0xab, 0xcf, 0xef, 0x2e, 0xbd, 0xb7, 0x4b, 0x6f, 0xb1, 0x1c, 0xfe,
+	0x47, 0xce, 0xe1, 0x6c, 0x3f, 0x37, 0xff, 0x79, 0xfd, 0xed, 0xd3, 0x7c, 0x41, 0x87, 0x46, 0x70,
+	0xa8, 0xfe, 0xac, 0x8b, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x4c, 0xfc, 0x96, 0xbe, 0x84, 0x03,
+	0x00, 0x00,
 }
@@ -76,6 +76,7 @@ func NewSynchronizer(basevariable global.BaseVariable, blkcache blockcache.Block
 		p2p.SyncBlockRequest,
 		p2p.SyncBlockHashRequest,
 		p2p.SyncBlockHashResponse,
+		p2p.SyncHeaderRequest,
 	)
 
 	sy.syncHeightChan = sy.p2pService.Register("sync height", p2p.SyncHeight)
@@ -315,6 +316,14 @@ func (sy *SyncImpl) messageLoop() {
 					break
 				}
 				go sy.handleBlockQuery(&rh, req.From())
+			case p2p.SyncHeaderRequest:
+				var rh msgpb.HeaderQuery
+				err := proto.Unmarshal(req.Data(), &rh)
+				if err != nil {
+					ilog.Errorf("unmarshal HeaderQuery failed:%v", err)
+					break
+				}
+				go sy.handleHeaderQuery(&rh, req.From())
 			}
 		case <-sy.exitSignal:
 			return
@@ -467,6 +476,26 @@ func (sy *SyncImpl) handleBlockQuery(rh *msgpb.BlockInfo, peerID p2p.PeerID) {
 	sy.p2pService.SendToPeer(peerID, b, p2p.SyncBlockResponse, p2p.NormalMessage)
 }
 
+func (sy *SyncImpl) handleHeaderQuery(rh *msgpb.HeaderQuery, peerID p2p.PeerID) {
+	if rh.End < rh.Start || rh.Start < 0 {
+		return
+	}
+	resp, err := NewHeaderResponse(rh, sy.blockCache, sy.baseVariable.BlockChain(), sy.baseVariable.StateDB())
+	if err != nil {
+		ilog.Errorf("get headers failed. err=%v", err)
+		return
+	}
+	if len(resp.Headers) == 0 {
+		return
+	}
+	bytes, err := proto.Marshal(resp)
+	if err != nil {
+		ilog.Errorf("marshal HeaderResponse failed. err=%v", err)
+		return
+	}
+	sy.p2pService.SendToPeer(peerID, bytes, p2p.SyncHeaderResponse, p2p.NormalMessage)
+}
+
 func (sy *SyncImpl) checkHasBlock(hash string, p interface{}) bool {
 	bn, ok := p.(int64)
 	if !ok {
@@ -225,6 +225,34 @@ class VoteContract {
             throw new Error("producer not exists");
         }
         const pro = this._mapGet("producerTable", account);
+        this._forceUnregister(admin, account, pro);
+    }
+
+    // report the evidence that a producer signed two blocks with the same number in one slot, the producer is unregistered
+    ReportDoubleSign(reporter, evidence) {
+        this._requireAuth(reporter, VOTE_PERMISSION);
+        const info = this._call("system.iost", "VerifyDoubleSign", [evidence]);
+        const key = info.witness + "-" + info.slot + "-" + info.number;
+        if (storage.mapHas("doubleSignEvidence", key)) {
+            throw new Error("evidence already reported");
+        }
+        const account = this._mapGet("producerKeyToId", info.witness);
+        if (!account) {
+            throw new Error("producer not exists");
+        }
+        this._mapPut("doubleSignEvidence", key, {
+            "account": account,
+            "reporter": reporter,
+            "time": block.time,
+        }, reporter);
+        const pro = this._mapGet("producerTable", account);
+        if (pro.status !== STATUS_APPROVED && pro.status !== STATUS_UNAPPLY) {
+            return;
+        }
+        this._forceUnregister(reporter, account, pro);
+    }
+
+    _forceUnregister(payer, account, pro) {
         const voteId = this._getVoteId();
         this._call("vote.iost", "RemoveOption", [
             voteId,
@@ -234,7 +262,7 @@ class VoteContract {
         // will clear votes and score of the producer on stat
         pro.status = STATUS_UNAPPLY_APPROVED;
         this._mapPut("producerTable", account, pro);
-        this._tryRemoveProducer(admin, account, pro);
+        this._tryRemoveProducer(payer, account, pro);
     }
 
     _tryRemoveProducer(admin, account, pro) {
@@ -0,0 +1,111 @@
+package block
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/tx"
+)
+
+// With the account index, every tx pushed to the block chain is also saved under the accounts it involves:
+// the publisher, the signers and the counterparties of the token transfers in its receipt.
+// accountTxPrefix + account + "/" + block number + tx index -> tx hash
+
+var accountTxPrefix = []byte("a")
+
+// ErrAccountIndexDisabled is returned when querying the txs of account without account index.
+var ErrAccountIndexDisabled = errors.New("account index is disabled")
+
+// transfer receipts whose args are [token, from, to, ...]
+var transferFuncNames = map[string]bool{
+	"token.iost/transfer":       true,
+	"token.iost/transferFreeze": true,
+	"token721.iost/transfer":    true,
+}
+
+// NewBlockChainWithAccountIndex returns a Chain instance which maintains the account index.
+func NewBlockChainWithAccountIndex(path string) (Chain, error) {
+	chain, err := NewBlockChain(path)
+	if chain != nil {
+		chain.(*BlockChain).accountIndex = true
+	}
+	return chain, err
+}
+
+// txAccounts returns the distinct accounts involved in the tx.
+func txAccounts(t *tx.Tx, r *tx.TxReceipt) []string {
+	accounts := make([]string, 0)
+	seen := make(map[string]bool)
+	add := func(acc string) {
+		if acc == "" || seen[acc] {
+			return
+		}
+		seen[acc] = true
+		accounts = append(accounts, acc)
+	}
+	add(t.Publisher)
+	for _, s := range t.Signers {
+		add(strings.Split(s, "@")[0])
+	}
+	if r == nil {
+		return accounts
+	}
+	for _, re := range r.Receipts {
+		if !transferFuncNames[re.FuncName] {
+			continue
+		}
+		var args []interface{}
+		if err := json.Unmarshal([]byte(re.Content), &args); err != nil || len(args) < 3 {
+			continue
+		}
+		for _, arg := range args[1:3] {
+			if acc, ok := arg.(string); ok {
+				add(acc)
+			}
+		}
+	}
+	return accounts
+}
+
+func accountTxKey(account string, number int64, index int) []byte {
+	key := append(append([]byte{}, accountTxPrefix...), account+"/"...)
+	key = append(key, common.Int64ToBytes(number)...)
+	return append(key, common.Int64ToBytes(int64(index))...)
+}
+
+// indexAccountTx saves the tx under its accounts, it must be called in the batch of push.
+func (bc *BlockChain) indexAccountTx(number int64, index int, t *tx.Tx, r *tx.TxReceipt) {
+	for _, acc := range txAccounts(t, r) {
+		bc.blockChainDB.Put(accountTxKey(acc, number, index), t.Hash())
+	}
+}
+
+// unindexAccountTx removes the tx from its accounts, it must be called in the batch of prune.
+func (bc *BlockChain) unindexAccountTx(number int64, index int, t *tx.Tx, r *tx.TxReceipt) {
+	for _, acc := range txAccounts(t, r) {
+		bc.blockChainDB.Delete(accountTxKey(acc, number, index))
+	}
+}
+
+// GetAccountTxs returns the hashes of at most limit txs involving the account from offset, in order of block number.
+func (bc *BlockChain) GetAccountTxs(account string, offset int64, limit int64) ([][]byte, error) {
+	if !bc.accountIndex {
+		return nil, ErrAccountIndexDisabled
+	}
+	iter := bc.blockChainDB.NewIteratorByPrefix(append(append([]byte{}, accountTxPrefix...), account+"/"...))
+	defer iter.Release()
+	hashes := make([][]byte, 0)
+	var i int64
+	for ; iter.Next() && int64(len(hashes)) < limit; i++ {
+		if i < offset {
+			continue
+		}
+		hashes = append(hashes, append([]byte{}, iter.Value()...))
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+	return hashes, nil
+}
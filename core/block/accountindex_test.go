@@ -0,0 +1,66 @@
+package block
+
+import (
+	"os"
+	"testing"
+
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountIndex(t *testing.T) {
+	os.RemoveAll("AccountIndexDB")
+	defer os.RemoveAll("AccountIndexDB")
+	bc, err := NewBlockChainWithAccountIndex("AccountIndexDB")
+	assert.Nil(t, err)
+	defer bc.Close()
+
+	actions := []*tx.Action{tx.NewAction("token.iost", "transfer", `["iost","alice","carol","1",""]`)}
+	hashes := make([][]byte, 0)
+	for i := 0; i < 3; i++ {
+		blk := &Block{
+			Head: &BlockHead{Number: int64(i), Time: int64(i)},
+			Sign: &crypto.Signature{},
+		}
+		txn := tx.NewTx(actions, []string{"bob@active"}, 100000, 100, int64(i), 0)
+		txn.Publisher = "alice"
+		receipt := tx.NewTxReceipt(txn.Hash())
+		receipt.Receipts = append(receipt.Receipts, &tx.Receipt{
+			FuncName: "token.iost/transfer",
+			Content:  `["iost","alice","carol","1",""]`,
+		})
+		blk.Txs = append(blk.Txs, txn)
+		blk.Receipts = append(blk.Receipts, receipt)
+		blk.CalculateHeadHash()
+		assert.Nil(t, bc.Push(blk))
+		hashes = append(hashes, txn.Hash())
+	}
+
+	for _, acc := range []string{"alice", "bob", "carol"} {
+		txs, err := bc.GetAccountTxs(acc, 0, 10)
+		assert.Nil(t, err)
+		assert.Equal(t, hashes, txs)
+	}
+	txs, err := bc.GetAccountTxs("alice", 1, 1)
+	assert.Nil(t, err)
+	assert.Equal(t, hashes[1:2], txs)
+	txs, err = bc.GetAccountTxs("ali", 0, 10)
+	assert.Nil(t, err)
+	assert.Empty(t, txs)
+
+	assert.Nil(t, bc.Prune(2))
+	txs, err = bc.GetAccountTxs("carol", 0, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, hashes[2:], txs)
+}
+
+func TestAccountIndexDisabled(t *testing.T) {
+	os.RemoveAll("AccountIndexDB")
+	defer os.RemoveAll("AccountIndexDB")
+	bc, err := NewBlockChain("AccountIndexDB")
+	assert.Nil(t, err)
+	defer bc.Close()
+	_, err = bc.GetAccountTxs("alice", 0, 10)
+	assert.Equal(t, ErrAccountIndexDisabled, err)
+}
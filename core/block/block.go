@@ -1,6 +1,7 @@
 package block
 
 import (
+	"encoding/hex"
 	"errors"
 
 	"github.com/golang/protobuf/proto"
@@ -11,6 +12,21 @@ import (
 	"github.com/iost-official/go-iost/crypto"
 )
 
+// version of block head
+const (
+	V0 int64 = iota
+	// V1 block head commits to the state root after the block
+	V1
+)
+
+// VersionOf returns the version of the block head of the number.
+func VersionOf(number int64) int64 {
+	if common.IsStateRootFork(number) {
+		return V1
+	}
+	return V0
+}
+
 // BlockHead is the struct of block head.
 type BlockHead struct { // nolint
 	Version             int64
@@ -22,6 +38,7 @@ type BlockHead struct { // nolint
 	Witness             string
 	Time                int64
 	GasUsage            int64
+	StateRoot           []byte
 }
 
 // ToPb convert BlockHead to proto buf data structure.
@@ -35,6 +52,7 @@ func (b *BlockHead) ToPb() *blockpb.BlockHead {
 		Number:              b.Number,
 		Witness:             b.Witness,
 		Time:                b.Time,
+		StateRoot:           b.StateRoot,
 	}
 }
 
@@ -49,6 +67,9 @@ func (b *BlockHead) ToBytes() []byte {
 	sn.WriteInt64(b.Number, true)
 	sn.WriteString(b.Witness, true)
 	sn.WriteInt64(b.Time, true)
+	if b.Version >= V1 {
+		sn.WriteBytes(b.StateRoot, false)
+	}
 	return sn.Bytes()
 }
 
@@ -62,6 +83,7 @@ func (b *BlockHead) FromPb(bh *blockpb.BlockHead) *BlockHead {
 	b.Number = bh.Number
 	b.Witness = bh.Witness
 	b.Time = bh.Time
+	b.StateRoot = bh.StateRoot
 	return b
 }
 
@@ -129,6 +151,32 @@ func (b *Block) CalculateTxReceiptMerkleHash() []byte {
 	return m.RootHash()
 }
 
+// TxMerklePath returns the merkle path of the transaction in the transaction merkle tree.
+func (b *Block) TxMerklePath(txHash []byte) ([][]byte, error) {
+	m := merkletree.MerkleTree{}
+	hashes := make([][]byte, 0, len(b.Txs))
+	for _, tx := range b.Txs {
+		hashes = append(hashes, tx.Hash())
+	}
+	m.Build(hashes)
+	return m.MerklePath(txHash)
+}
+
+// TxReceiptMerklePath returns the receipt hash and its merkle path in the transaction receipt merkle tree.
+func (b *Block) TxReceiptMerklePath(txHash []byte) ([]byte, [][]byte, error) {
+	m := merkletree.TXRMerkleTree{}
+	m.Build(b.Receipts)
+	receiptHash, ok := m.Tx2Txr[hex.EncodeToString(txHash)]
+	if !ok {
+		return nil, nil, errors.New("tx receipt isn't in the block")
+	}
+	mp, err := m.MerklePath(receiptHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	return receiptHash, mp, nil
+}
+
 // Encode is marshal
 func (b *Block) Encode() ([]byte, error) {
 	br := &blockpb.Block{
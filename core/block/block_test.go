@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/core/merkletree"
 	"github.com/iost-official/go-iost/core/tx"
 	"github.com/iost-official/go-iost/crypto"
 	"github.com/smartystreets/goconvey/convey"
@@ -68,3 +69,49 @@ func TestBlockSerialize(t *testing.T) {
 		})
 	})
 }
+
+func TestBlockMerklePath(t *testing.T) {
+	convey.Convey("Test of block merkle path", t, func() {
+		blk := Block{
+			Head: &BlockHead{
+				Number: 1,
+			},
+		}
+		for i := int64(0); i < 5; i++ {
+			t := &tx.Tx{Time: i}
+			blk.Txs = append(blk.Txs, t)
+			blk.Receipts = append(blk.Receipts, &tx.TxReceipt{
+				TxHash:   t.Hash(),
+				GasUsage: i,
+				Status:   &tx.Status{Code: tx.Success},
+			})
+		}
+		m := merkletree.MerkleTree{}
+		for _, t := range blk.Txs {
+			mp, err := blk.TxMerklePath(t.Hash())
+			convey.So(err, convey.ShouldBeNil)
+			ok, err := m.MerkleProve(t.Hash(), blk.CalculateTxMerkleHash(), mp)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(ok, convey.ShouldBeTrue)
+
+			rHash, mp, err := blk.TxReceiptMerklePath(t.Hash())
+			convey.So(err, convey.ShouldBeNil)
+			ok, err = m.MerkleProve(rHash, blk.CalculateTxReceiptMerkleHash(), mp)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(ok, convey.ShouldBeTrue)
+		}
+
+		_, err := blk.TxMerklePath([]byte("fake hash"))
+		convey.So(err, convey.ShouldNotBeNil)
+		_, _, err = blk.TxReceiptMerklePath([]byte("fake hash"))
+		convey.So(err, convey.ShouldNotBeNil)
+
+		blk.Txs = blk.Txs[:1]
+		blk.Receipts = blk.Receipts[:1]
+		mp, err := blk.TxMerklePath(blk.Txs[0].Hash())
+		convey.So(err, convey.ShouldBeNil)
+		ok, err := m.MerkleProve(blk.Txs[0].Hash(), blk.CalculateTxMerkleHash(), mp)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ok, convey.ShouldBeTrue)
+	})
+}
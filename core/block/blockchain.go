@@ -16,11 +16,14 @@ import (
 type BlockChain struct { //nolint:golint
 	blockChainDB *kv.Storage
 	rw           sync.RWMutex
+	batchMu      sync.Mutex
 	length       int64
+	accountIndex bool
 }
 
 var (
 	blockLength       = []byte("BlockLength")
+	prunedLength      = []byte("PrunedLength")
 	blockNumberPrefix = []byte("n")
 	blockPrefix       = []byte("H")
 	txPrefix          = []byte("t")      // txPrefix + tx hash -> block hash + tx hash
@@ -76,6 +79,9 @@ func (bc *BlockChain) Length() int64 {
 
 // Push save the block to database
 func (bc *BlockChain) Push(block *Block) error {
+	bc.batchMu.Lock()
+	defer bc.batchMu.Unlock()
+
 	err := bc.blockChainDB.BeginBatch()
 	if err != nil {
 		return errors.New("fail to begin batch")
@@ -101,10 +107,14 @@ func (bc *BlockChain) Push(block *Block) error {
 		bc.blockChainDB.Put(append(receiptPrefix, rHash...), append(hash, rHash...))
 		bc.blockChainDB.Put(append(bReceiptPrefix, append(hash, rHash...)...), block.Receipts[i].Encode())
 
+		if bc.accountIndex {
+			bc.indexAccountTx(number, i, t, block.Receipts[i])
+		}
+
 		if t.Delay > 0 {
 			bc.blockChainDB.Put(append(delaytxPrefix, tHash...), txBytes)
 		}
-		if t.IsDefer() {
+		if t.IsDefer() && bc.isLastDefertx(t) {
 			bc.blockChainDB.Delete(append(delaytxPrefix, t.ReferredTx...))
 		}
 		if cancelHash, exist := t.CanceledDelaytxHash(); exist {
@@ -273,6 +283,18 @@ func (bc *BlockChain) GetTx(hash []byte) (*tx.Tx, error) {
 	return &tx, nil
 }
 
+// GetBlockByTxHash gets the block including the tx with tx's hash.
+func (bc *BlockChain) GetBlockByTxHash(hash []byte) (*Block, error) {
+	bTx, err := bc.blockChainDB.Get(append(txPrefix, hash...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to Get the tx: %v", err)
+	}
+	if len(bTx) <= len(hash) {
+		return nil, fmt.Errorf("failed to Get the tx: not found")
+	}
+	return bc.GetBlockByHash(bTx[:len(bTx)-len(hash)])
+}
+
 // HasTx checks if database has tx.
 func (bc *BlockChain) HasTx(hash []byte) (bool, error) {
 	return bc.blockChainDB.Has(append(txPrefix, hash...))
@@ -330,6 +352,20 @@ func (bc *BlockChain) Close() {
 	bc.blockChainDB.Close()
 }
 
+// isLastDefertx returns whether the defer tx is the last one generated by its delay tx.
+func (bc *BlockChain) isLastDefertx(t *tx.Tx) bool {
+	b, err := bc.blockChainDB.Get(append(delaytxPrefix, t.ReferredTx...))
+	if err != nil || len(b) == 0 {
+		return true
+	}
+	var delayTx tx.Tx
+	if err := delayTx.Decode(b); err != nil {
+		return true
+	}
+	k, ok := delayTx.Occurrence(t.Time)
+	return !ok || k+1 >= delayTx.Occurrences()
+}
+
 // AllDelaytx returns all delay transactions.
 func (bc *BlockChain) AllDelaytx() ([]*tx.Tx, error) {
 	iter := bc.blockChainDB.NewIteratorByPrefix(delaytxPrefix)
@@ -345,6 +381,100 @@ func (bc *BlockChain) AllDelaytx() ([]*tx.Tx, error) {
 	return ret, nil
 }
 
+// PutDelaytx saves the delay tx which is packed in the block of blockHash, used when the block itself isn't pushed.
+func (bc *BlockChain) PutDelaytx(t *tx.Tx, blockHash []byte) error {
+	bc.batchMu.Lock()
+	defer bc.batchMu.Unlock()
+
+	err := bc.blockChainDB.BeginBatch()
+	if err != nil {
+		return errors.New("fail to begin batch")
+	}
+	tHash := t.Hash()
+	txBytes := t.Encode()
+	bc.blockChainDB.Put(append(txPrefix, tHash...), append(blockHash, tHash...))
+	bc.blockChainDB.Put(append(bTxPrefix, append(blockHash, tHash...)...), txBytes)
+	bc.blockChainDB.Put(append(delaytxPrefix, tHash...), txBytes)
+	err = bc.blockChainDB.CommitBatch()
+	if err != nil {
+		return fmt.Errorf("fail to put delay tx, err:%s", err)
+	}
+	return nil
+}
+
+// Prune removes the txs and receipts of the blocks whose number is less than number, the heads are kept.
+// The delay txs which haven't been executed are kept too, since the deferred txs refer to them.
+func (bc *BlockChain) Prune(number int64) error {
+	if number > bc.Length()-1 {
+		number = bc.Length() - 1
+	}
+	var start int64
+	startByte, err := bc.blockChainDB.Get(prunedLength)
+	if err != nil {
+		return fmt.Errorf("fail to get pruned length, %v", err)
+	}
+	if len(startByte) != 0 {
+		start = common.BytesToInt64(startByte)
+	}
+	for i := start; i < number; i++ {
+		if err := bc.pruneBlock(i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (bc *BlockChain) pruneBlock(number int64) error {
+	bc.batchMu.Lock()
+	defer bc.batchMu.Unlock()
+
+	err := bc.blockChainDB.BeginBatch()
+	if err != nil {
+		return errors.New("fail to begin batch")
+	}
+	if err := bc.prunePut(number); err != nil {
+		bc.blockChainDB.RollbackBatch()
+		return err
+	}
+	err = bc.blockChainDB.CommitBatch()
+	if err != nil {
+		return fmt.Errorf("fail to prune block, err:%s", err)
+	}
+	return nil
+}
+
+// prunePut writes the pruning of the block into the batch.
+func (bc *BlockChain) prunePut(number int64) error {
+	// the block may not exist if the chain is started from a snapshot
+	if blk, err := bc.GetBlockByNumber(number); err == nil {
+		hash := blk.HeadHash()
+		for i, t := range blk.Txs {
+			tHash := t.Hash()
+			delay, err := bc.blockChainDB.Has(append(delaytxPrefix, tHash...))
+			if err != nil {
+				return fmt.Errorf("fail to check delay tx, %v", err)
+			}
+			if !delay {
+				bc.blockChainDB.Delete(append(txPrefix, tHash...))
+				bc.blockChainDB.Delete(append(bTxPrefix, append(hash, tHash...)...))
+			}
+			rHash := blk.Receipts[i].Hash()
+			bc.blockChainDB.Delete(append(txReceiptPrefix, tHash...))
+			bc.blockChainDB.Delete(append(receiptPrefix, rHash...))
+			bc.blockChainDB.Delete(append(bReceiptPrefix, append(hash, rHash...)...))
+			if bc.accountIndex {
+				bc.unindexAccountTx(number, i, t, blk.Receipts[i])
+			}
+		}
+		blockByte, err := (&Block{Head: blk.Head, Sign: blk.Sign}).EncodeM()
+		if err != nil {
+			return errors.New("fail to encode block")
+		}
+		bc.blockChainDB.Put(append(blockPrefix, hash...), blockByte)
+	}
+	return bc.blockChainDB.Put(prunedLength, common.Int64ToBytes(number+1))
+}
+
 // Draw the graph about blockchain
 func (bc *BlockChain) Draw(start int64, end int64) string {
 	ret := ""
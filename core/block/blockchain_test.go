@@ -86,6 +86,65 @@ func TestChainImpl(t *testing.T) {
 	})
 }
 
+func TestBlockChainPrune(t *testing.T) {
+	Convey("test Prune", t, func() {
+		os.RemoveAll("./BlockChainDB/")
+		defer os.RemoveAll("./BlockChainDB/")
+		bc, err := NewBlockChain("./BlockChainDB/")
+		So(err, ShouldBeNil)
+		actions := []*tx.Action{tx.NewAction("contract1", "actionname1", "[]")}
+		var delayTx *tx.Tx
+		blocks := make([]*Block, 0)
+		for i := 0; i < 4; i++ {
+			tBlock := &Block{
+				Head: &BlockHead{
+					Number: int64(i),
+					Time:   int64(i),
+				},
+				Sign: &crypto.Signature{},
+			}
+			txn := tx.NewTx(actions, nil, 100000, 100, int64(i), 0)
+			if i == 1 {
+				txn = tx.NewTx(actions, nil, 100000, 100, int64(i), 10)
+				delayTx = txn
+			}
+			tBlock.Txs = append(tBlock.Txs, txn)
+			tBlock.Receipts = append(tBlock.Receipts, tx.NewTxReceipt(txn.Hash()))
+			tBlock.CalculateHeadHash()
+			So(bc.Push(tBlock), ShouldBeNil)
+			blocks = append(blocks, tBlock)
+		}
+
+		So(bc.Prune(2), ShouldBeNil)
+		blk, err := bc.GetBlockByNumber(0)
+		So(err, ShouldBeNil)
+		So(blk.HeadHash(), ShouldResemble, blocks[0].HeadHash())
+		So(len(blk.Txs), ShouldEqual, 0)
+		_, err = bc.GetTx(blocks[0].Txs[0].Hash())
+		So(err, ShouldNotBeNil)
+		_, err = bc.GetReceiptByTxHash(blocks[0].Txs[0].Hash())
+		So(err, ShouldNotBeNil)
+
+		_, err = bc.GetTx(delayTx.Hash())
+		So(err, ShouldBeNil)
+		delaytxs, err := bc.AllDelaytx()
+		So(err, ShouldBeNil)
+		So(len(delaytxs), ShouldEqual, 1)
+
+		blk, err = bc.GetBlockByNumber(2)
+		So(err, ShouldBeNil)
+		So(len(blk.Txs), ShouldEqual, 1)
+
+		So(bc.Prune(10), ShouldBeNil)
+		blk, err = bc.GetBlockByNumber(2)
+		So(err, ShouldBeNil)
+		So(len(blk.Txs), ShouldEqual, 0)
+		blk, err = bc.Top()
+		So(err, ShouldBeNil)
+		So(len(blk.Txs), ShouldEqual, 1)
+	})
+}
+
 func BenchmarkBlock(b *testing.B) {
 	a1, _ := account.NewKeyPair(nil, crypto.Secp256k1)
 	a2, _ := account.NewKeyPair(nil, crypto.Secp256k1)
@@ -0,0 +1,146 @@
+package block
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/common"
+	blockpb "github.com/iost-official/go-iost/core/block/pb"
+	"github.com/iost-official/go-iost/crypto"
+)
+
+// errors of double sign evidence
+var (
+	ErrEvidenceWitness    = errors.New("blocks of evidence are not signed by the same witness")
+	ErrEvidenceSameHead   = errors.New("blocks of evidence are the same")
+	ErrEvidenceNoConflict = errors.New("blocks of evidence are not in the same slot with the same number")
+	ErrEvidenceSignature  = errors.New("wrong signature of evidence")
+	ErrEvidenceSize       = errors.New("evidence is too large")
+)
+
+// MaxEvidenceSize is the max size of the encoded evidence, which is far above the size of two block heads.
+const MaxEvidenceSize = 2048
+
+// DoubleSignEvidence proves that a witness signed two different blocks with the same number in one slot.
+type DoubleSignEvidence struct {
+	Head1 *BlockHead
+	Sign1 *crypto.Signature
+	Head2 *BlockHead
+	Sign2 *crypto.Signature
+}
+
+// NewDoubleSignEvidence returns the evidence of two blocks, which is the same whatever the order of blocks is.
+func NewDoubleSignEvidence(b1, b2 *Block) *DoubleSignEvidence {
+	if bytes.Compare(b1.HeadHash(), b2.HeadHash()) > 0 {
+		b1, b2 = b2, b1
+	}
+	return &DoubleSignEvidence{
+		Head1: b1.Head,
+		Sign1: b1.Sign,
+		Head2: b2.Head,
+		Sign2: b2.Sign,
+	}
+}
+
+// SlotOf returns the slot of the block time.
+func SlotOf(t int64) int64 {
+	return t / (common.SlotLength * 1e9)
+}
+
+// Conflict returns whether the two block heads are signed twice by the witness in a slot.
+func Conflict(h1, h2 *BlockHead) bool {
+	return h1.Witness == h2.Witness && h1.Number == h2.Number && SlotOf(h1.Time) == SlotOf(h2.Time) &&
+		!bytes.Equal(h1.ToBytes(), h2.ToBytes())
+}
+
+// Witness returns the witness signing the blocks.
+func (e *DoubleSignEvidence) Witness() string {
+	return e.Head1.Witness
+}
+
+// Slot returns the slot of the blocks.
+func (e *DoubleSignEvidence) Slot() int64 {
+	return SlotOf(e.Head1.Time)
+}
+
+// Verify checks whether the evidence is valid.
+func (e *DoubleSignEvidence) Verify() error {
+	w := e.Head1.Witness
+	if w != e.Head2.Witness || !strings.HasPrefix(w, "IOST") || len(common.Base58Decode(w[4:])) <= 4 {
+		return ErrEvidenceWitness
+	}
+	if bytes.Equal(e.Head1.ToBytes(), e.Head2.ToBytes()) {
+		return ErrEvidenceSameHead
+	}
+	if !Conflict(e.Head1, e.Head2) {
+		return ErrEvidenceNoConflict
+	}
+	pubkey := account.GetPubkeyByID(e.Witness())
+	for _, s := range []struct {
+		head *BlockHead
+		sign *crypto.Signature
+	}{{e.Head1, e.Sign1}, {e.Head2, e.Sign2}} {
+		if s.sign == nil {
+			return ErrEvidenceSignature
+		}
+		s.sign.SetPubkey(pubkey)
+		hash, _ := s.head.Hash()
+		if !s.sign.Verify(hash) {
+			return ErrEvidenceSignature
+		}
+	}
+	return nil
+}
+
+// Hash returns the hash of the evidence.
+func (e *DoubleSignEvidence) Hash() []byte {
+	sn := common.NewSimpleNotation()
+	sn.WriteBytes(e.Head1.ToBytes(), false)
+	sn.WriteBytes(e.Head2.ToBytes(), false)
+	return common.Sha3(sn.Bytes())
+}
+
+// ToPb converts the evidence to proto buf data structure.
+func (e *DoubleSignEvidence) ToPb() *blockpb.DoubleSignEvidence {
+	return &blockpb.DoubleSignEvidence{
+		Head1: e.Head1.ToPb(),
+		Sign1: e.Sign1.ToPb(),
+		Head2: e.Head2.ToPb(),
+		Sign2: e.Sign2.ToPb(),
+	}
+}
+
+// FromPb converts the evidence from proto buf data structure.
+func (e *DoubleSignEvidence) FromPb(ep *blockpb.DoubleSignEvidence) *DoubleSignEvidence {
+	e.Head1 = (&BlockHead{}).FromPb(ep.Head1)
+	e.Sign1 = (&crypto.Signature{}).FromPb(ep.Sign1)
+	e.Head2 = (&BlockHead{}).FromPb(ep.Head2)
+	e.Sign2 = (&crypto.Signature{}).FromPb(ep.Sign2)
+	return e
+}
+
+// Encode is marshal
+func (e *DoubleSignEvidence) Encode() ([]byte, error) {
+	b, err := proto.Marshal(e.ToPb())
+	if err != nil {
+		return nil, errors.New("fail to encode evidence")
+	}
+	return b, nil
+}
+
+// Decode is unmarshal
+func (e *DoubleSignEvidence) Decode(b []byte) error {
+	if len(b) > MaxEvidenceSize {
+		return ErrEvidenceSize
+	}
+	ep := &blockpb.DoubleSignEvidence{}
+	err := proto.Unmarshal(b, ep)
+	if err != nil || ep.Head1 == nil || ep.Head2 == nil || ep.Sign1 == nil || ep.Sign2 == nil {
+		return errors.New("fail to decode evidence")
+	}
+	e.FromPb(ep)
+	return nil
+}
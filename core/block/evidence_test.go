@@ -0,0 +1,55 @@
+package block
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDoubleSignEvidence(t *testing.T) {
+	kp, err := account.NewKeyPair(nil, crypto.Ed25519)
+	assert.Nil(t, err)
+	slotTime := time.Now().UnixNano() / (3 * 1e9) * (3 * 1e9)
+	newBlock := func(parent []byte, tm int64) *Block {
+		blk := &Block{
+			Head: &BlockHead{
+				ParentHash: parent,
+				Number:     10,
+				Witness:    kp.ID,
+				Time:       tm,
+			},
+		}
+		blk.CalculateHeadHash()
+		blk.Sign = kp.Sign(blk.HeadHash())
+		return blk
+	}
+	b1 := newBlock([]byte("parent1"), slotTime)
+	b2 := newBlock([]byte("parent2"), slotTime+1)
+	assert.True(t, Conflict(b1.Head, b2.Head))
+	assert.False(t, Conflict(b1.Head, b1.Head))
+
+	e := NewDoubleSignEvidence(b1, b2)
+	assert.Equal(t, e.Hash(), NewDoubleSignEvidence(b2, b1).Hash())
+	assert.Equal(t, kp.ID, e.Witness())
+	assert.Nil(t, e.Verify())
+
+	b, err := e.Encode()
+	assert.Nil(t, err)
+	e2 := &DoubleSignEvidence{}
+	assert.Nil(t, e2.Decode(b))
+	assert.Equal(t, e.Hash(), e2.Hash())
+	assert.Nil(t, e2.Verify())
+	assert.Equal(t, ErrEvidenceSize, e2.Decode(append(b, make([]byte, MaxEvidenceSize)...)))
+
+	b3 := newBlock([]byte("parent3"), slotTime+int64(10*time.Second))
+	assert.Equal(t, ErrEvidenceNoConflict, NewDoubleSignEvidence(b1, b3).Verify())
+	assert.Equal(t, ErrEvidenceSameHead, NewDoubleSignEvidence(b1, b1).Verify())
+
+	e.Sign2 = e.Sign1
+	assert.Equal(t, ErrEvidenceSignature, e.Verify())
+	e.Head2.Witness = "witness"
+	assert.Equal(t, ErrEvidenceWitness, e.Verify())
+}
@@ -0,0 +1,141 @@
+package block
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/db/kv"
+)
+
+// HeaderChain stores the irreversible block heads of a header-only node.
+type HeaderChain struct {
+	headerChainDB *kv.Storage
+	rw            sync.RWMutex
+	length        int64
+}
+
+var (
+	headerLength       = []byte("HeaderLength")
+	headerWitnessList  = []byte("HeaderWitnessList") // witness list of the top header
+	headerNumberPrefix = []byte("n")
+	headerPrefix       = []byte("H")
+)
+
+// NewHeaderChain returns a HeaderChain instance
+func NewHeaderChain(path string) (*HeaderChain, error) {
+	levelDB, err := kv.NewStorage(path, kv.LevelDBStorage)
+	if err != nil {
+		return nil, fmt.Errorf("fail to init headerchaindb, %v", err)
+	}
+	var length int64
+	lengthByte, err := levelDB.Get(headerLength)
+	if err != nil {
+		return nil, fmt.Errorf("fail to get headerlength, %v", err)
+	}
+	if len(lengthByte) != 0 {
+		length = common.BytesToInt64(lengthByte)
+	}
+	return &HeaderChain{headerChainDB: levelDB, length: length}, nil
+}
+
+// HeaderOnly returns a copy of the block which only contains the head and the signature.
+func (b *Block) HeaderOnly() *Block {
+	return &Block{
+		hash: b.hash,
+		Head: b.Head,
+		Sign: b.Sign,
+	}
+}
+
+// Length return length of header chain
+func (hc *HeaderChain) Length() int64 {
+	hc.rw.RLock()
+	defer hc.rw.RUnlock()
+	return hc.length
+}
+
+// Push saves the block head and the witness list after it to database.
+// The blocks must be pushed in order of number.
+func (hc *HeaderChain) Push(blk *Block, witnessList []byte) error {
+	number := blk.Head.Number
+	if number != hc.Length() {
+		return fmt.Errorf("fail to push header, number %v != length %v", number, hc.Length())
+	}
+	err := hc.headerChainDB.BeginBatch()
+	if err != nil {
+		return errors.New("fail to begin batch")
+	}
+	hash := blk.HeadHash()
+	headerByte, err := blk.HeaderOnly().Encode()
+	if err != nil {
+		return errors.New("fail to encode header")
+	}
+	hc.headerChainDB.Put(append(headerNumberPrefix, common.Int64ToBytes(number)...), hash)
+	hc.headerChainDB.Put(append(headerPrefix, hash...), headerByte)
+	hc.headerChainDB.Put(headerWitnessList, witnessList)
+	hc.headerChainDB.Put(headerLength, common.Int64ToBytes(number+1))
+	err = hc.headerChainDB.CommitBatch()
+	if err != nil {
+		return fmt.Errorf("fail to put header, err:%s", err)
+	}
+	hc.rw.Lock()
+	hc.length = number + 1
+	hc.rw.Unlock()
+	return nil
+}
+
+// Top returns the last irreversible header.
+func (hc *HeaderChain) Top() (*Block, error) {
+	if hc.Length() == 0 {
+		return nil, errors.New("no header in headerChaindb")
+	}
+	return hc.GetHeaderByNumber(hc.Length() - 1)
+}
+
+// WitnessList returns the witness list saved with the top header.
+func (hc *HeaderChain) WitnessList() ([]byte, error) {
+	wl, err := hc.headerChainDB.Get(headerWitnessList)
+	if err != nil || len(wl) == 0 {
+		return nil, errors.New("fail to get witness list")
+	}
+	return wl, nil
+}
+
+// GetHashByNumber is get hash by number
+func (hc *HeaderChain) GetHashByNumber(number int64) ([]byte, error) {
+	hash, err := hc.headerChainDB.Get(append(headerNumberPrefix, common.Int64ToBytes(number)...))
+	if err != nil || len(hash) == 0 {
+		return nil, errors.New("fail to get hash by number")
+	}
+	return hash, nil
+}
+
+// GetHeaderByHash is get header by hash
+func (hc *HeaderChain) GetHeaderByHash(hash []byte) (*Block, error) {
+	headerByte, err := hc.headerChainDB.Get(append(headerPrefix, hash...))
+	if err != nil || len(headerByte) == 0 {
+		return nil, errors.New("fail to get header byte by hash")
+	}
+	var blk Block
+	err = blk.Decode(headerByte)
+	if err != nil {
+		return nil, errors.New("fail to decode headerByte")
+	}
+	return &blk, nil
+}
+
+// GetHeaderByNumber is get header by number
+func (hc *HeaderChain) GetHeaderByNumber(number int64) (*Block, error) {
+	hash, err := hc.GetHashByNumber(number)
+	if err != nil {
+		return nil, err
+	}
+	return hc.GetHeaderByHash(hash)
+}
+
+// Close is close database
+func (hc *HeaderChain) Close() {
+	hc.headerChainDB.Close()
+}
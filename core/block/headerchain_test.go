@@ -0,0 +1,59 @@
+package block
+
+import (
+	"os"
+	"testing"
+
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeaderChain(t *testing.T) {
+	os.RemoveAll("HeaderChainDB")
+	defer os.RemoveAll("HeaderChainDB")
+	hc, err := NewHeaderChain("HeaderChainDB")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), hc.Length())
+	_, err = hc.Top()
+	assert.NotNil(t, err)
+
+	var parent []byte
+	for i := int64(0); i < 3; i++ {
+		blk := &Block{
+			Head: &BlockHead{
+				ParentHash: parent,
+				Number:     i,
+				Witness:    "witness",
+				Time:       i * 3,
+			},
+			Sign: &crypto.Signature{},
+		}
+		blk.CalculateHeadHash()
+		assert.Nil(t, hc.Push(blk, []byte{byte(i)}))
+		parent = blk.HeadHash()
+	}
+	blk := &Block{Head: &BlockHead{Number: 5}, Sign: &crypto.Signature{}}
+	blk.CalculateHeadHash()
+	assert.NotNil(t, hc.Push(blk, nil))
+	hc.Close()
+
+	hc, err = NewHeaderChain("HeaderChainDB")
+	assert.Nil(t, err)
+	defer hc.Close()
+	assert.Equal(t, int64(3), hc.Length())
+	top, err := hc.Top()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), top.Head.Number)
+	assert.Equal(t, parent, top.HeadHash())
+	wl, err := hc.WitnessList()
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{2}, wl)
+
+	first, err := hc.GetHeaderByNumber(1)
+	assert.Nil(t, err)
+	second, err := hc.GetHeaderByNumber(2)
+	assert.Nil(t, err)
+	assert.Equal(t, first.HeadHash(), second.Head.ParentHash)
+	_, err = hc.GetHeaderByNumber(3)
+	assert.NotNil(t, err)
+}
@@ -14,11 +14,15 @@ type Chain interface {
 	GetBlockByNumber(number int64) (*Block, error)
 	GetBlockByHash(blockHash []byte) (*Block, error)
 	GetTx(hash []byte) (*tx.Tx, error)
+	GetBlockByTxHash(hash []byte) (*Block, error)
 	HasTx(hash []byte) (bool, error)
 	GetReceipt(Hash []byte) (*tx.TxReceipt, error)
 	GetReceiptByTxHash(Hash []byte) (*tx.TxReceipt, error)
 	HasReceipt(hash []byte) (bool, error)
 	Close()
 	AllDelaytx() ([]*tx.Tx, error)
+	PutDelaytx(t *tx.Tx, blockHash []byte) error
+	Prune(number int64) error
+	GetAccountTxs(account string, offset int64, limit int64) ([][]byte, error)
 	Draw(int64, int64) string
 }
@@ -56,6 +56,7 @@ type BlockHead struct {
 	Number               int64    `protobuf:"varint,6,opt,name=number,proto3" json:"number,omitempty"`
 	Witness              string   `protobuf:"bytes,7,opt,name=witness,proto3" json:"witness,omitempty"`
 	Time                 int64    `protobuf:"varint,8,opt,name=time,proto3" json:"time,omitempty"`
+	StateRoot            []byte   `protobuf:"bytes,9,opt,name=stateRoot,proto3" json:"stateRoot,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -142,6 +143,13 @@ func (m *BlockHead) GetTime() int64 {
 	return 0
 }
 
+func (m *BlockHead) GetStateRoot() []byte {
+	if m != nil {
+		return m.StateRoot
+	}
+	return nil
+}
+
 type Block struct {
 	Head                 *BlockHead       `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
 	Sign                 *pb.Signature    `protobuf:"bytes,2,opt,name=sign,proto3" json:"sign,omitempty"`
@@ -229,41 +237,175 @@ func (m *Block) GetBlockType() BlockType {
 	return BlockType_NORMAL
 }
 
+type DoubleSignEvidence struct {
+	Head1                *BlockHead    `protobuf:"bytes,1,opt,name=head1,proto3" json:"head1,omitempty"`
+	Sign1                *pb.Signature `protobuf:"bytes,2,opt,name=sign1,proto3" json:"sign1,omitempty"`
+	Head2                *BlockHead    `protobuf:"bytes,3,opt,name=head2,proto3" json:"head2,omitempty"`
+	Sign2                *pb.Signature `protobuf:"bytes,4,opt,name=sign2,proto3" json:"sign2,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *DoubleSignEvidence) Reset()         { *m = DoubleSignEvidence{} }
+func (m *DoubleSignEvidence) String() string { return proto.CompactTextString(m) }
+func (*DoubleSignEvidence) ProtoMessage()    {}
+func (*DoubleSignEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc6664e18d413fc7, []int{2}
+}
+
+func (m *DoubleSignEvidence) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DoubleSignEvidence.Unmarshal(m, b)
+}
+func (m *DoubleSignEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DoubleSignEvidence.Marshal(b, m, deterministic)
+}
+func (m *DoubleSignEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DoubleSignEvidence.Merge(m, src)
+}
+func (m *DoubleSignEvidence) XXX_Size() int {
+	return xxx_messageInfo_DoubleSignEvidence.Size(m)
+}
+func (m *DoubleSignEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_DoubleSignEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DoubleSignEvidence proto.InternalMessageInfo
+
+func (m *DoubleSignEvidence) GetHead1() *BlockHead {
+	if m != nil {
+		return m.Head1
+	}
+	return nil
+}
+
+func (m *DoubleSignEvidence) GetSign1() *pb.Signature {
+	if m != nil {
+		return m.Sign1
+	}
+	return nil
+}
+
+func (m *DoubleSignEvidence) GetHead2() *BlockHead {
+	if m != nil {
+		return m.Head2
+	}
+	return nil
+}
+
+func (m *DoubleSignEvidence) GetSign2() *pb.Signature {
+	if m != nil {
+		return m.Sign2
+	}
+	return nil
+}
+
+type Vote struct {
+	Type                 int32         `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Number               int64         `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	Hash                 []byte        `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	Sign                 *pb.Signature `protobuf:"bytes,4,opt,name=sign,proto3" json:"sign,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *Vote) Reset()         { *m = Vote{} }
+func (m *Vote) String() string { return proto.CompactTextString(m) }
+func (*Vote) ProtoMessage()    {}
+func (*Vote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc6664e18d413fc7, []int{3}
+}
+
+func (m *Vote) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Vote.Unmarshal(m, b)
+}
+func (m *Vote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Vote.Marshal(b, m, deterministic)
+}
+func (m *Vote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vote.Merge(m, src)
+}
+func (m *Vote) XXX_Size() int {
+	return xxx_messageInfo_Vote.Size(m)
+}
+func (m *Vote) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Vote proto.InternalMessageInfo
+
+func (m *Vote) GetType() int32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *Vote) GetNumber() int64 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *Vote) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *Vote) GetSign() *pb.Signature {
+	if m != nil {
+		return m.Sign
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("blockpb.BlockType", BlockType_name, BlockType_value)
 	proto.RegisterType((*BlockHead)(nil), "blockpb.BlockHead")
 	proto.RegisterType((*Block)(nil), "blockpb.Block")
+	proto.RegisterType((*DoubleSignEvidence)(nil), "blockpb.DoubleSignEvidence")
+	proto.RegisterType((*Vote)(nil), "blockpb.Vote")
 }
 
 func init() { proto.RegisterFile("core/block/pb/block.proto", fileDescriptor_dc6664e18d413fc7) }
 
 var fileDescriptor_dc6664e18d413fc7 = []byte{
-	// 427 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x4f, 0x6f, 0xd3, 0x40,
-	0x10, 0xc5, 0x71, 0xed, 0x3a, 0xce, 0x24, 0x40, 0x34, 0x48, 0x68, 0xc9, 0x01, 0x59, 0x51, 0x41,
-	0x16, 0xa8, 0x76, 0x15, 0x38, 0x71, 0x2b, 0xa7, 0x1c, 0xfa, 0x47, 0xda, 0xf6, 0xc2, 0xd1, 0x76,
-	0x37, 0xc9, 0xaa, 0x89, 0x77, 0xb5, 0xbb, 0x01, 0xf7, 0x6b, 0xf0, 0x5d, 0xb9, 0xa3, 0x1d, 0x3b,
-	0xa1, 0x45, 0x48, 0xdc, 0xf6, 0xbd, 0xf9, 0xed, 0x78, 0xde, 0x78, 0xe1, 0x4d, 0xad, 0x8c, 0x28,
-	0xaa, 0x8d, 0xaa, 0xef, 0x0b, 0x5d, 0x75, 0x87, 0x5c, 0x1b, 0xe5, 0x14, 0x0e, 0x48, 0xe8, 0x6a,
-	0xfa, 0x65, 0x25, 0xdd, 0x7a, 0x57, 0xe5, 0xb5, 0xda, 0x16, 0x52, 0x59, 0x77, 0xaa, 0x96, 0x4b,
-	0x59, 0xcb, 0x72, 0x53, 0xac, 0xd4, 0xa9, 0x37, 0x8a, 0xda, 0x3c, 0x68, 0xa7, 0x7c, 0x03, 0x2b,
-	0x57, 0x4d, 0xe9, 0x76, 0x46, 0x74, 0x4d, 0xa6, 0x9f, 0xff, 0x7f, 0xd7, 0x0f, 0xe0, 0x5a, 0x7f,
-	0xd9, 0xb5, 0xdd, 0xad, 0xd9, 0xaf, 0x00, 0x86, 0x5f, 0xfd, 0xd7, 0x17, 0xa2, 0xbc, 0x43, 0x06,
-	0x83, 0xef, 0xc2, 0x58, 0xa9, 0x1a, 0x16, 0xa4, 0x41, 0x16, 0xf2, 0xbd, 0xc4, 0xb7, 0x00, 0xba,
-	0x34, 0xa2, 0x71, 0x8b, 0xd2, 0xae, 0xd9, 0x51, 0x1a, 0x64, 0x63, 0xfe, 0xc8, 0xc1, 0x19, 0x8c,
-	0x5d, 0x7b, 0x29, 0xcc, 0xfd, 0x46, 0x10, 0x11, 0x12, 0xf1, 0xc4, 0xc3, 0x33, 0x78, 0xe5, 0x5a,
-	0x2e, 0x6a, 0x21, 0xb5, 0x7b, 0x84, 0x46, 0x84, 0xfe, 0xab, 0x84, 0x08, 0x91, 0x6c, 0x96, 0x8a,
-	0x1d, 0x13, 0x42, 0x67, 0x7c, 0x0d, 0x71, 0xb3, 0xdb, 0x56, 0xc2, 0xb0, 0x98, 0x46, 0xec, 0x95,
-	0x9f, 0xfd, 0x87, 0x74, 0x8d, 0xb0, 0x96, 0x0d, 0xd2, 0x20, 0x1b, 0xf2, 0xbd, 0xf4, 0x5d, 0x9c,
-	0xdc, 0x0a, 0x96, 0x10, 0x4f, 0xe7, 0xd9, 0xcf, 0x23, 0x38, 0xa6, 0xdc, 0xf8, 0x1e, 0xa2, 0xb5,
-	0x28, 0xef, 0x28, 0xf0, 0x68, 0x8e, 0x79, 0xff, 0x2f, 0xf2, 0xc3, 0x56, 0x38, 0xd5, 0xf1, 0x04,
-	0x22, 0xbf, 0x72, 0xca, 0x3e, 0x9a, 0x4f, 0x72, 0x2b, 0x57, 0xba, 0xca, 0x6f, 0xf6, 0x7f, 0x81,
-	0x53, 0x15, 0xa7, 0x10, 0xba, 0xd6, 0xb2, 0x30, 0x0d, 0xb3, 0xd1, 0x3c, 0xc9, 0x5d, 0xab, 0xab,
-	0xfc, 0xb6, 0xe5, 0xde, 0xc4, 0x8f, 0x90, 0x98, 0x2e, 0xa2, 0x65, 0x11, 0x01, 0x2f, 0x0f, 0x40,
-	0xe7, 0xf3, 0x03, 0x80, 0x53, 0x48, 0x5c, 0xeb, 0x97, 0x20, 0x2c, 0x3b, 0x4e, 0xc3, 0x6c, 0xcc,
-	0x0f, 0x1a, 0x4f, 0xe0, 0x79, 0xcf, 0xf5, 0x40, 0x4c, 0xc0, 0x53, 0x13, 0xcf, 0x60, 0x48, 0x59,
-	0x6e, 0x1f, 0xb4, 0xa0, 0x95, 0xbc, 0xf8, 0x3b, 0x9d, 0xaf, 0xf0, 0x3f, 0xd0, 0x87, 0x77, 0xfd,
-	0x5b, 0xf0, 0x02, 0x01, 0xe2, 0xab, 0x6b, 0x7e, 0x79, 0x7e, 0x31, 0x79, 0x86, 0x63, 0x48, 0xae,
-	0xaf, 0x2e, 0xbe, 0x2d, 0xce, 0x6f, 0x16, 0x93, 0xa0, 0x8a, 0xe9, 0xe9, 0x7c, 0xfa, 0x1d, 0x00,
-	0x00, 0xff, 0xff, 0xb4, 0x8b, 0xe6, 0x72, 0xd2, 0x02, 0x00, 0x00,
+	// 528 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xdb, 0x6e, 0xd3, 0x40,
+	0x10, 0xc5, 0xb1, 0x9d, 0xcb, 0x24, 0x40, 0x34, 0x48, 0x68, 0x89, 0x10, 0x8a, 0xa2, 0x52, 0x59,
+	0xa0, 0x3a, 0xad, 0xe1, 0x89, 0xb7, 0x22, 0x90, 0xf2, 0xd0, 0x8b, 0xb4, 0xad, 0x90, 0x78, 0xb4,
+	0x9d, 0x4d, 0xb2, 0x6a, 0xe2, 0xb5, 0xbc, 0x9b, 0xe2, 0xfe, 0x06, 0xfc, 0x0c, 0x9f, 0x87, 0x76,
+	0x9c, 0x2b, 0x82, 0xf4, 0x6d, 0xe6, 0xcc, 0xd9, 0xe3, 0x99, 0x33, 0x63, 0x78, 0x95, 0xaa, 0x42,
+	0x0c, 0x93, 0xb9, 0x4a, 0xef, 0x86, 0x79, 0x52, 0x05, 0x61, 0x5e, 0x28, 0xa3, 0xb0, 0x41, 0x49,
+	0x9e, 0xf4, 0x3e, 0x4d, 0xa5, 0x99, 0x2d, 0x93, 0x30, 0x55, 0x8b, 0xa1, 0x54, 0xda, 0x9c, 0xa8,
+	0xc9, 0x44, 0xa6, 0x32, 0x9e, 0x0f, 0xa7, 0xea, 0xc4, 0x02, 0xc3, 0xb4, 0x78, 0xc8, 0x8d, 0xb2,
+	0x02, 0x5a, 0x4e, 0xb3, 0xd8, 0x2c, 0x0b, 0x51, 0x89, 0xf4, 0x3e, 0x3e, 0xfe, 0xd6, 0x36, 0x60,
+	0x4a, 0xfb, 0xd8, 0x94, 0xd5, 0xab, 0xc1, 0xaf, 0x1a, 0xb4, 0x3e, 0xdb, 0xaf, 0x8f, 0x44, 0x3c,
+	0x46, 0x06, 0x8d, 0x7b, 0x51, 0x68, 0xa9, 0x32, 0xe6, 0xf4, 0x9d, 0xc0, 0xe5, 0xeb, 0x14, 0xdf,
+	0x00, 0xe4, 0x71, 0x21, 0x32, 0x33, 0x8a, 0xf5, 0x8c, 0xd5, 0xfa, 0x4e, 0xd0, 0xe1, 0x3b, 0x08,
+	0x0e, 0xa0, 0x63, 0xca, 0x4b, 0x51, 0xdc, 0xcd, 0x05, 0x31, 0x5c, 0x62, 0xec, 0x61, 0x78, 0x0a,
+	0x2f, 0x4c, 0xc9, 0x45, 0x2a, 0x64, 0x6e, 0x76, 0xa8, 0x1e, 0x51, 0xff, 0x55, 0x42, 0x04, 0x4f,
+	0x66, 0x13, 0xc5, 0x7c, 0xa2, 0x50, 0x8c, 0x2f, 0xa1, 0x9e, 0x2d, 0x17, 0x89, 0x28, 0x58, 0x9d,
+	0x5a, 0x5c, 0x65, 0xb6, 0xf7, 0x1f, 0xd2, 0x64, 0x42, 0x6b, 0xd6, 0xe8, 0x3b, 0x41, 0x8b, 0xaf,
+	0x53, 0xab, 0x62, 0xe4, 0x42, 0xb0, 0x26, 0xf1, 0x29, 0xc6, 0xd7, 0xd0, 0xd2, 0x26, 0x36, 0x82,
+	0x2b, 0x65, 0x58, 0x8b, 0xe4, 0xb7, 0xc0, 0xe0, 0x67, 0x0d, 0x7c, 0x72, 0x05, 0x8f, 0xc1, 0x9b,
+	0x89, 0x78, 0x4c, 0x76, 0xb4, 0x23, 0x0c, 0x57, 0x9b, 0x0a, 0x37, 0x9e, 0x71, 0xaa, 0xe3, 0x11,
+	0x78, 0x76, 0x21, 0xe4, 0x4c, 0x3b, 0xea, 0x86, 0x5a, 0x4e, 0xf3, 0x24, 0xbc, 0x59, 0xef, 0x88,
+	0x53, 0x15, 0x7b, 0xe0, 0x9a, 0x52, 0x33, 0xb7, 0xef, 0x06, 0xed, 0xa8, 0x19, 0x9a, 0x32, 0x4f,
+	0xc2, 0xdb, 0x92, 0x5b, 0x10, 0xdf, 0x43, 0xb3, 0xa8, 0x0c, 0xd0, 0xcc, 0x23, 0xc2, 0xf3, 0x0d,
+	0xa1, 0xc2, 0xf9, 0x86, 0x80, 0x3d, 0x68, 0x9a, 0xd2, 0x5a, 0x24, 0x34, 0xf3, 0xfb, 0x6e, 0xd0,
+	0xe1, 0x9b, 0x1c, 0x8f, 0xe0, 0xe9, 0x8a, 0xb7, 0x22, 0xd4, 0x89, 0xb0, 0x0f, 0xe2, 0x29, 0xb4,
+	0x68, 0x96, 0xdb, 0x87, 0x5c, 0x90, 0x61, 0xcf, 0xfe, 0x9e, 0xce, 0x56, 0xf8, 0x96, 0x34, 0xf8,
+	0xed, 0x00, 0x7e, 0x51, 0xcb, 0x64, 0x2e, 0xec, 0x58, 0x5f, 0xef, 0xe5, 0x58, 0x64, 0xa9, 0xc0,
+	0x00, 0x7c, 0xeb, 0xc0, 0xd9, 0x01, 0x8b, 0x2a, 0x02, 0x1e, 0x83, 0x6f, 0x5d, 0x38, 0xfb, 0xaf,
+	0x49, 0x55, 0x79, 0xad, 0x18, 0x31, 0xf7, 0xb0, 0x62, 0xb4, 0x56, 0x8c, 0x98, 0x77, 0x48, 0x31,
+	0x1a, 0xcc, 0xc1, 0xfb, 0xa6, 0x8c, 0xa0, 0x4b, 0xb0, 0xf3, 0xda, 0x56, 0x7d, 0x4e, 0xf1, 0xce,
+	0x3d, 0xd5, 0xf6, 0xee, 0x09, 0xc1, 0x9b, 0x6d, 0x2f, 0x99, 0xe2, 0xcd, 0x96, 0xbd, 0x43, 0x5b,
+	0x7e, 0xf7, 0x76, 0xf5, 0x4b, 0x59, 0xd7, 0x10, 0xa0, 0x7e, 0x75, 0xcd, 0x2f, 0xcf, 0x2f, 0xba,
+	0x4f, 0xb0, 0x03, 0xcd, 0xeb, 0xab, 0x8b, 0xef, 0xa3, 0xf3, 0x9b, 0x51, 0xd7, 0x49, 0xea, 0xf4,
+	0x07, 0x7e, 0xf8, 0x13, 0x00, 0x00, 0xff, 0xff, 0x54, 0xae, 0x7a, 0x2b, 0x19, 0x04, 0x00, 0x00,
 }
@@ -0,0 +1,32 @@
+package block
+
+import (
+	"testing"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockHeadVersion(t *testing.T) {
+	head := BlockHead{
+		Version:    V0,
+		Number:     1,
+		ParentHash: []byte("parent"),
+	}
+	hash, _ := head.Hash()
+	head.StateRoot = []byte("root")
+	hashWithRoot, _ := head.Hash()
+	assert.Equal(t, hash, hashWithRoot)
+
+	head.Version = V1
+	hashV1, _ := head.Hash()
+	head.StateRoot = []byte("another root")
+	hashV1Another, _ := head.Hash()
+	assert.NotEqual(t, hashWithRoot, hashV1)
+	assert.NotEqual(t, hashV1, hashV1Another)
+
+	common.SetForks(&common.ForkConfig{StateRoot: 10})
+	defer common.SetForks(nil)
+	assert.Equal(t, V0, VersionOf(9))
+	assert.Equal(t, V1, VersionOf(10))
+}
@@ -0,0 +1,120 @@
+package block
+
+import (
+	"errors"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/common"
+	blockpb "github.com/iost-official/go-iost/core/block/pb"
+	"github.com/iost-official/go-iost/crypto"
+)
+
+// errors of finality vote
+var (
+	ErrVoteType      = errors.New("unknown vote type")
+	ErrVoteSignature = errors.New("wrong signature of vote")
+)
+
+// VoteType is the type of finality vote.
+type VoteType int32
+
+// The types of finality vote. A witness pre-commits a block when it is linked, and commits it when 2/3+1 witnesses
+// have pre-committed it. The block is irreversible when 2/3+1 witnesses have committed it.
+const (
+	PreCommit VoteType = iota
+	Commit
+)
+
+func (t VoteType) String() string {
+	switch t {
+	case PreCommit:
+		return "PreCommit"
+	case Commit:
+		return "Commit"
+	default:
+		return "Unknown"
+	}
+}
+
+// Vote is a finality vote of a witness for a block.
+type Vote struct {
+	Type   VoteType
+	Number int64
+	Hash   []byte
+	Sign   *crypto.Signature
+}
+
+// NewVote returns the vote for the block signed by the witness.
+func NewVote(t VoteType, number int64, hash []byte, acc *account.KeyPair) *Vote {
+	v := &Vote{
+		Type:   t,
+		Number: number,
+		Hash:   hash,
+	}
+	v.Sign = acc.Sign(v.contentHash())
+	return v
+}
+
+func (v *Vote) contentHash() []byte {
+	sn := common.NewSimpleNotation()
+	sn.WriteInt32(int32(v.Type), true)
+	sn.WriteInt64(v.Number, true)
+	sn.WriteBytes(v.Hash, true)
+	return common.Sha3(sn.Bytes())
+}
+
+// Witness returns the witness signing the vote.
+func (v *Vote) Witness() string {
+	return account.GetIDByPubkey(v.Sign.Pubkey)
+}
+
+// Verify checks whether the vote is valid.
+func (v *Vote) Verify() error {
+	if v.Type != PreCommit && v.Type != Commit {
+		return ErrVoteType
+	}
+	if v.Sign == nil || !v.Sign.Verify(v.contentHash()) {
+		return ErrVoteSignature
+	}
+	return nil
+}
+
+// ToPb converts the vote to proto buf data structure.
+func (v *Vote) ToPb() *blockpb.Vote {
+	return &blockpb.Vote{
+		Type:   int32(v.Type),
+		Number: v.Number,
+		Hash:   v.Hash,
+		Sign:   v.Sign.ToPb(),
+	}
+}
+
+// FromPb converts the vote from proto buf data structure.
+func (v *Vote) FromPb(vp *blockpb.Vote) *Vote {
+	v.Type = VoteType(vp.Type)
+	v.Number = vp.Number
+	v.Hash = vp.Hash
+	v.Sign = (&crypto.Signature{}).FromPb(vp.Sign)
+	return v
+}
+
+// Encode is marshal
+func (v *Vote) Encode() ([]byte, error) {
+	b, err := proto.Marshal(v.ToPb())
+	if err != nil {
+		return nil, errors.New("fail to encode vote")
+	}
+	return b, nil
+}
+
+// Decode is unmarshal
+func (v *Vote) Decode(b []byte) error {
+	vp := &blockpb.Vote{}
+	err := proto.Unmarshal(b, vp)
+	if err != nil || vp.Sign == nil {
+		return errors.New("fail to decode vote")
+	}
+	v.FromPb(vp)
+	return nil
+}
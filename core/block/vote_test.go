@@ -0,0 +1,30 @@
+package block
+
+import (
+	"testing"
+
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVote(t *testing.T) {
+	kp, err := account.NewKeyPair(nil, crypto.Ed25519)
+	assert.Nil(t, err)
+	v := NewVote(PreCommit, 10, []byte("hash"), kp)
+	assert.Equal(t, kp.ID, v.Witness())
+	assert.Nil(t, v.Verify())
+
+	b, err := v.Encode()
+	assert.Nil(t, err)
+	v2 := &Vote{}
+	assert.Nil(t, v2.Decode(b))
+	assert.Equal(t, v, v2)
+	assert.Nil(t, v2.Verify())
+
+	v2.Type = Commit
+	assert.Equal(t, ErrVoteSignature, v2.Verify())
+	v2.Type = 3
+	assert.Equal(t, ErrVoteType, v2.Verify())
+	assert.NotNil(t, v2.Decode([]byte("vote")))
+}
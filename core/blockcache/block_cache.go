@@ -11,6 +11,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/event"
 	"github.com/iost-official/go-iost/core/global"
 	"github.com/iost-official/go-iost/db"
 	"github.com/iost-official/go-iost/db/wal"
@@ -195,6 +196,7 @@ type BlockCacheImpl struct { //nolint:golint
 	baseVariable global.BaseVariable
 	stateDB      db.MVCCDB
 	wal          *wal.WAL
+	pruneCh      chan int64
 }
 
 // CleanDir used in test to clean dir
@@ -240,6 +242,7 @@ func NewBlockCache(baseVariable global.BaseVariable) (*BlockCacheImpl, error) {
 		baseVariable: baseVariable,
 		stateDB:      baseVariable.StateDB().Fork(),
 		wal:          w,
+		pruneCh:      make(chan int64, 1),
 	}
 	bc.linkedRoot.Head.Number = -1
 	lib, err := baseVariable.BlockChain().Top()
@@ -264,10 +267,30 @@ func NewBlockCache(baseVariable global.BaseVariable) (*BlockCacheImpl, error) {
 		}
 	}
 	bc.head = bc.linkedRoot
+	if baseVariable.Config().DB.PruneDepth > 0 {
+		go bc.pruneLoop()
+	}
 
 	return &bc, nil
 }
 
+// pruneLoop prunes the block chain in background, since the first prune after it's enabled may take long.
+func (bc *BlockCacheImpl) pruneLoop() {
+	for number := range bc.pruneCh {
+		if err := bc.baseVariable.BlockChain().Prune(number); err != nil {
+			ilog.Errorf("Database error, BlockChain Prune err:%v", err)
+		}
+	}
+}
+
+// prune requests pruning the blocks below number, it's dropped if a request is still pending.
+func (bc *BlockCacheImpl) prune(number int64) {
+	select {
+	case bc.pruneCh <- number:
+	default:
+	}
+}
+
 // NewWAL New wal when old one is not recoverable. Move Old File into Corrupted for later analysis.
 func (bc *BlockCacheImpl) NewWAL(config *common.Config) (err error) {
 	walPath := config.DB.LdbPath + blockCacheWALDir
@@ -358,6 +381,19 @@ func (bc *BlockCacheImpl) Link(bcn *BlockCacheNode) {
 	bc.setHead(bcn)
 	if bcn.Head.Number > bc.Head().Head.Number {
 		bc.SetHead(bcn)
+		event.GetCollector().Post(event.NewDataEvent(event.NewBlock, BlockEventData(bcn.Block)), nil)
+	}
+}
+
+// BlockEventData returns the data of block events.
+func BlockEventData(blk *block.Block) *event.BlockData {
+	return &event.BlockData{
+		Number:     blk.Head.Number,
+		Hash:       common.Base58Encode(blk.HeadHash()),
+		ParentHash: common.Base58Encode(blk.Head.ParentHash),
+		Witness:    blk.Head.Witness,
+		Time:       blk.Head.Time,
+		TxCount:    len(blk.Txs),
 	}
 }
 
@@ -518,6 +554,9 @@ func (bc *BlockCacheImpl) flush(retain *BlockCacheNode) error {
 			return err
 		}
 		ilog.Debug("confirm: ", retain.Head.Number)
+		if depth := bc.baseVariable.Config().DB.PruneDepth; depth > 0 {
+			bc.prune(retain.Head.Number - depth)
+		}
 		err = bc.baseVariable.StateDB().Flush(string(retain.HeadHash()))
 
 		if err != nil {
@@ -528,6 +567,7 @@ func (bc *BlockCacheImpl) flush(retain *BlockCacheNode) error {
 		retain.SetParent(nil)
 		retain.LibWitnessHandle()
 		bc.SetLinkedRoot(retain)
+		event.GetCollector().Post(event.NewDataEvent(event.IrreversibleBlock, BlockEventData(retain.Block)), nil)
 	}
 	return nil
 }
@@ -0,0 +1,75 @@
+package blockcache
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/event"
+	"github.com/iost-official/go-iost/core/mocks"
+	"github.com/iost-official/go-iost/db/mocks"
+	"github.com/iost-official/go-iost/vm/database"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlushIrreversibleEvents(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	b0 := &block.Block{
+		Head: &block.BlockHead{
+			ParentHash: []byte("nothing"),
+			Witness:    "w0",
+		},
+	}
+	b1 := genBlock(b0, "w1", 1)
+	b2 := genBlock(b1, "w2", 2)
+	b3 := genBlock(b2, "w3", 3)
+
+	statedb := db_mock.NewMockMVCCDB(ctl)
+	statedb.EXPECT().Flush(gomock.Any()).AnyTimes().Return(nil)
+	statedb.EXPECT().Fork().AnyTimes().Return(statedb)
+	statedb.EXPECT().Checkout(gomock.Any()).AnyTimes().Return(true)
+	statedb.EXPECT().Get("state", "b-vote_producer.iost-pendingBlockNumber").AnyTimes().Return(database.MustMarshal("1"), nil)
+	statedb.EXPECT().Get("state", "b-vote_producer.iost-pendingProducerList").AnyTimes().Return(database.MustMarshal(`["w1","w2","w3"]`), nil)
+	statedb.EXPECT().Get("state", gomock.Any()).AnyTimes().Return(database.MustMarshal(`{"loc":"11","url":"22","netId":"33","online":true,"score":0,"votes":0}`), nil)
+	chain := core_mock.NewMockChain(ctl)
+	chain.EXPECT().Top().AnyTimes().Return(b0, nil)
+	chain.EXPECT().Push(gomock.Any()).Times(3).Return(nil)
+	global := core_mock.NewMockBaseVariable(ctl)
+	global.EXPECT().BlockChain().AnyTimes().Return(chain)
+	global.EXPECT().StateDB().AnyTimes().Return(statedb)
+	global.EXPECT().Config().AnyTimes().Return(&common.Config{DB: &common.DBConfig{LdbPath: "./"}})
+
+	CleanBlockCacheWAL()
+	bc, err := NewBlockCache(global)
+	require.Nil(t, err)
+	defer bc.CleanDir()
+	var last *BlockCacheNode
+	for _, blk := range []*block.Block{b1, b2, b3} {
+		last = bc.Add(blk)
+		bc.Link(last)
+	}
+
+	id := time.Now().UnixNano()
+	ch := event.GetCollector().Subscribe(id, []event.Topic{event.IrreversibleBlock}, nil)
+	defer event.GetCollector().Unsubscribe(id, []event.Topic{event.IrreversibleBlock})
+	bc.Flush(last)
+
+	numbers := make([]int64, 0)
+	for len(numbers) < 3 {
+		select {
+		case ev := <-ch:
+			var data event.BlockData
+			require.Nil(t, json.Unmarshal([]byte(ev.Data), &data))
+			numbers = append(numbers, data.Number)
+		case <-time.After(time.Second):
+			t.Fatalf("irreversible events of the flushed blocks are missing, got %v", numbers)
+		}
+	}
+	sort.Slice(numbers, func(i, j int) bool { return numbers[i] < numbers[j] })
+	require.Equal(t, []int64{1, 2, 3}, numbers)
+}
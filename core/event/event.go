@@ -1,8 +1,10 @@
 package event
 
 import (
+	"encoding/json"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/iost-official/go-iost/ilog"
@@ -18,6 +20,10 @@ type Topic int
 const (
 	ContractReceipt Topic = iota
 	ContractEvent
+	NewBlock
+	IrreversibleBlock
+	ChainReorg
+	TxDropped
 )
 
 func (t Topic) String() string {
@@ -26,6 +32,14 @@ func (t Topic) String() string {
 		return "ContractReceipt"
 	case ContractEvent:
 		return "ContractEvent"
+	case NewBlock:
+		return "NewBlock"
+	case IrreversibleBlock:
+		return "IrreversibleBlock"
+	case ChainReorg:
+		return "ChainReorg"
+	case TxDropped:
+		return "TxDropped"
 	default:
 		return "unknown_topic:" + strconv.Itoa(int(t))
 	}
@@ -38,6 +52,39 @@ type Event struct {
 	Time  int64
 }
 
+// BlockData is the json data of NewBlock and IrreversibleBlock events.
+type BlockData struct {
+	Number     int64  `json:"number"`
+	Hash       string `json:"hash"`
+	ParentHash string `json:"parent_hash"`
+	Witness    string `json:"witness"`
+	Time       int64  `json:"time"`
+	TxCount    int    `json:"tx_count"`
+}
+
+// ReorgData is the json data of ChainReorg event.
+type ReorgData struct {
+	OldHead *BlockData `json:"old_head"`
+	NewHead *BlockData `json:"new_head"`
+	// Fork is the common ancestor of the two heads, nil if it's not found in block cache.
+	Fork *BlockData `json:"fork"`
+}
+
+// TxDroppedData is the json data of TxDropped event.
+type TxDroppedData struct {
+	Hash   string `json:"hash"`
+	Reason string `json:"reason"`
+}
+
+// NewDataEvent generate new event with topic and the json of data
+func NewDataEvent(topic Topic, data interface{}) *Event {
+	b, err := json.Marshal(data)
+	if err != nil {
+		ilog.Errorf("marshal event data failed. topic=%s, err=%v", topic, err)
+	}
+	return NewEvent(topic, string(b))
+}
+
 // NewEvent generate new event with topic and data
 func NewEvent(topic Topic, data string) *Event {
 	return &Event{
@@ -47,9 +94,15 @@ func NewEvent(topic Topic, data string) *Event {
 	}
 }
 
-// Meta is the information abount event.
+// Meta is the information abount event. As a filter, the empty fields match any event.
 type Meta struct {
 	ContractID string
+	ActionName string
+	Publisher  string
+	TxHash     string
+	StatusCode int32
+	// StatusCodes is only used by filter, the event matches if its StatusCode is one of them.
+	StatusCodes []int32
 }
 
 // Match checks whether the given meta argument is matched to self.
@@ -57,17 +110,31 @@ func (m *Meta) Match(meta *Meta) bool {
 	if meta == nil {
 		return true
 	}
-
 	if m.ContractID != "" && m.ContractID != meta.ContractID {
 		return false
 	}
-	return true
+	if m.ActionName != "" && m.ActionName != meta.ActionName {
+		return false
+	}
+	if m.Publisher != "" && m.Publisher != meta.Publisher {
+		return false
+	}
+	if len(m.StatusCodes) == 0 {
+		return true
+	}
+	for _, c := range m.StatusCodes {
+		if c == meta.StatusCode {
+			return true
+		}
+	}
+	return false
 }
 
 // Subscription is a struct used for listening specific topics
 type Subscription struct {
-	C      chan<- *Event
-	filter *Meta
+	C       chan<- *Event
+	filter  *Meta
+	dropped int64
 }
 
 var ec *Collector
@@ -76,12 +143,16 @@ var o sync.Once
 // Collector is the struct for posting event.
 type Collector struct {
 	subMap *sync.Map // map[Topic]map[int64]*Subscription
+	subs   *sync.Map // map[int64]*Subscription
 }
 
 // GetCollector returns single-instance event collector.
 func GetCollector() *Collector {
 	o.Do(func() {
-		ec = &Collector{new(sync.Map)}
+		ec = &Collector{
+			subMap: new(sync.Map),
+			subs:   new(sync.Map),
+		}
 	})
 	return ec
 }
@@ -89,9 +160,11 @@ func GetCollector() *Collector {
 // Subscribe registers a subscription in event collector.
 func (ec *Collector) Subscribe(id int64, topics []Topic, filter *Meta) <-chan *Event {
 	c := make(chan *Event, EventChSize)
+	sub := &Subscription{C: c, filter: filter}
+	ec.subs.Store(id, sub)
 	for _, topic := range topics {
 		m, _ := ec.subMap.LoadOrStore(topic, new(sync.Map))
-		m.(*sync.Map).Store(id, &Subscription{c, filter})
+		m.(*sync.Map).Store(id, sub)
 		ilog.Debugf("Subscribe id = %d, topic = %s, filter = %v", id, topic, filter)
 	}
 	return c
@@ -99,6 +172,7 @@ func (ec *Collector) Subscribe(id int64, topics []Topic, filter *Meta) <-chan *E
 
 // Unsubscribe deregisters a subscription from event collector.
 func (ec *Collector) Unsubscribe(id int64, topics []Topic) {
+	ec.subs.Delete(id)
 	for _, topic := range topics {
 		m, ok := ec.subMap.Load(topic)
 		if ok && m != nil {
@@ -118,6 +192,7 @@ func (ec *Collector) sendEvent(e *Event, meta *Meta) {
 			select {
 			case sub.C <- e:
 			default:
+				atomic.AddInt64(&sub.dropped, 1)
 				ilog.Debugf("sending event failed. id=%d, topic=%s", k.(int64), e.Topic)
 			}
 			return true
@@ -125,6 +200,15 @@ func (ec *Collector) sendEvent(e *Event, meta *Meta) {
 	}
 }
 
+// TakeDropped returns the number of events dropped since last call because the channel of subscription is full.
+func (ec *Collector) TakeDropped(id int64) int64 {
+	sub, ok := ec.subs.Load(id)
+	if !ok {
+		return 0
+	}
+	return atomic.SwapInt64(&sub.(*Subscription).dropped, 0)
+}
+
 // Post a event.
 func (ec *Collector) Post(e *Event, meta *Meta) {
 	go ec.sendEvent(e, meta)
@@ -110,3 +110,40 @@ func TestEventCollectorFullPost(t *testing.T) {
 
 	assert.EqualValues(t, event.EventChSize, atomic.LoadInt32(&count))
 }
+
+func TestEventCollectorDropped(t *testing.T) {
+	ilog.Stop()
+	ec := event.GetCollector()
+	ch := ec.Subscribe(4, []event.Topic{event.ContractReceipt}, nil)
+	defer ec.Unsubscribe(4, []event.Topic{event.ContractReceipt})
+	for i := 0; i < event.EventChSize+10; i++ {
+		ec.Post(event.NewEvent(event.ContractReceipt, "test1"), &event.Meta{ContractID: "token.iost"})
+	}
+	time.Sleep(time.Millisecond * 100)
+	assert.Equal(t, event.EventChSize, len(ch))
+	assert.EqualValues(t, 10, ec.TakeDropped(4))
+	assert.EqualValues(t, 0, ec.TakeDropped(4))
+	assert.EqualValues(t, 0, ec.TakeDropped(5))
+}
+
+func TestMetaMatch(t *testing.T) {
+	meta := &event.Meta{
+		ContractID: "token.iost",
+		ActionName: "transfer",
+		Publisher:  "alice",
+		StatusCode: 0,
+	}
+	assert.True(t, (&event.Meta{}).Match(meta))
+	assert.True(t, (&event.Meta{ContractID: "token.iost", ActionName: "transfer"}).Match(meta))
+	assert.False(t, (&event.Meta{ActionName: "issue"}).Match(meta))
+	assert.True(t, (&event.Meta{Publisher: "alice", StatusCodes: []int32{0, 4}}).Match(meta))
+	assert.False(t, (&event.Meta{Publisher: "bob"}).Match(meta))
+	assert.False(t, (&event.Meta{StatusCodes: []int32{4}}).Match(meta))
+}
+
+func TestNewDataEvent(t *testing.T) {
+	e := event.NewDataEvent(event.TxDropped, &event.TxDroppedData{Hash: "abc", Reason: "expired"})
+	assert.Equal(t, event.TxDropped, e.Topic)
+	assert.Equal(t, `{"hash":"abc","reason":"expired"}`, e.Data)
+	assert.Equal(t, "ChainReorg", event.ChainReorg.String())
+}
@@ -54,12 +54,20 @@ type BaseVariableImpl struct {
 // New return a BaseVariable instance
 // nolint: gocyclo
 func New(conf *common.Config) (*BaseVariableImpl, error) {
-	blockChain, err := block.NewBlockChain(conf.DB.LdbPath + "BlockChainDB")
+	newBlockChain := block.NewBlockChain
+	if conf.DB.AccountIndex {
+		newBlockChain = block.NewBlockChainWithAccountIndex
+	}
+	blockChain, err := newBlockChain(conf.DB.LdbPath + "BlockChainDB")
 	if err != nil {
 		return nil, fmt.Errorf("new blockchain failed, stop the program. err: %v", err)
 	}
 
-	stateDB, err := db.NewMVCCDB(conf.DB.LdbPath + "StateDB")
+	newStateDB := db.NewMVCCDB
+	if conf.DB.Archive {
+		newStateDB = db.NewArchiveMVCCDB
+	}
+	stateDB, err := newStateDB(conf.DB.LdbPath + "StateDB")
 	if err != nil {
 		return nil, fmt.Errorf("new statedb failed, stop the program. err: %v", err)
 	}
@@ -62,6 +62,10 @@ func (m *MerkleTree) MerklePath(hash []byte) ([][]byte, error) {
 	if !ok {
 		return nil, errors.New("hash isn't in the tree")
 	}
+	if m.LeafNum == 1 {
+		// the root of a single leaf tree is the hash of the leaf with itself
+		return [][]byte{hash}, nil
+	}
 	mp := make([][]byte, int32(math.Log2(float64(m.LeafNum))))
 	for i := 0; idx != 0; i++ {
 		p := (idx - 1) / 2
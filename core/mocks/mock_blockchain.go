@@ -79,6 +79,19 @@ func (mr *MockChainMockRecorder) Draw(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Draw", reflect.TypeOf((*MockChain)(nil).Draw), arg0, arg1)
 }
 
+// GetAccountTxs mocks base method
+func (m *MockChain) GetAccountTxs(arg0 string, arg1, arg2 int64) ([][]byte, error) {
+	ret := m.ctrl.Call(m, "GetAccountTxs", arg0, arg1, arg2)
+	ret0, _ := ret[0].([][]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountTxs indicates an expected call of GetAccountTxs
+func (mr *MockChainMockRecorder) GetAccountTxs(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountTxs", reflect.TypeOf((*MockChain)(nil).GetAccountTxs), arg0, arg1, arg2)
+}
+
 // GetBlockByHash mocks base method
 func (m *MockChain) GetBlockByHash(arg0 []byte) (*block.Block, error) {
 	ret := m.ctrl.Call(m, "GetBlockByHash", arg0)
@@ -105,6 +118,19 @@ func (mr *MockChainMockRecorder) GetBlockByNumber(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockByNumber", reflect.TypeOf((*MockChain)(nil).GetBlockByNumber), arg0)
 }
 
+// GetBlockByTxHash mocks base method
+func (m *MockChain) GetBlockByTxHash(arg0 []byte) (*block.Block, error) {
+	ret := m.ctrl.Call(m, "GetBlockByTxHash", arg0)
+	ret0, _ := ret[0].(*block.Block)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockByTxHash indicates an expected call of GetBlockByTxHash
+func (mr *MockChainMockRecorder) GetBlockByTxHash(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockByTxHash", reflect.TypeOf((*MockChain)(nil).GetBlockByTxHash), arg0)
+}
+
 // GetHashByNumber mocks base method
 func (m *MockChain) GetHashByNumber(arg0 int64) ([]byte, error) {
 	ret := m.ctrl.Call(m, "GetHashByNumber", arg0)
@@ -195,6 +221,18 @@ func (mr *MockChainMockRecorder) Length() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Length", reflect.TypeOf((*MockChain)(nil).Length))
 }
 
+// Prune mocks base method
+func (m *MockChain) Prune(arg0 int64) error {
+	ret := m.ctrl.Call(m, "Prune", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Prune indicates an expected call of Prune
+func (mr *MockChainMockRecorder) Prune(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Prune", reflect.TypeOf((*MockChain)(nil).Prune), arg0)
+}
+
 // Push mocks base method
 func (m *MockChain) Push(arg0 *block.Block) error {
 	ret := m.ctrl.Call(m, "Push", arg0)
@@ -207,6 +245,18 @@ func (mr *MockChainMockRecorder) Push(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Push", reflect.TypeOf((*MockChain)(nil).Push), arg0)
 }
 
+// PutDelaytx mocks base method
+func (m *MockChain) PutDelaytx(arg0 *tx.Tx, arg1 []byte) error {
+	ret := m.ctrl.Call(m, "PutDelaytx", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutDelaytx indicates an expected call of PutDelaytx
+func (mr *MockChainMockRecorder) PutDelaytx(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutDelaytx", reflect.TypeOf((*MockChain)(nil).PutDelaytx), arg0, arg1)
+}
+
 // Top mocks base method
 func (m *MockChain) Top() (*block.Block, error) {
 	ret := m.ctrl.Call(m, "Top")
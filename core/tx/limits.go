@@ -0,0 +1,43 @@
+package tx
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Limits are the chain parameters restricting transactions, which are set on chain through the system.iost contract.
+type Limits struct {
+	MaxExpiration int64 `json:"max_expiration"`
+	MinGasRatio   int64 `json:"min_gas_ratio"`
+	MaxGasRatio   int64 `json:"max_gas_ratio"`
+	MinGasLimit   int64 `json:"min_gas_limit"`
+	MaxSize       int64 `json:"max_size"`
+}
+
+// DefaultLimits returns the limits used when they are not set on chain.
+func DefaultLimits() *Limits {
+	return &Limits{
+		MaxExpiration: MaxExpiration,
+		MinGasRatio:   minGasRatio,
+		MaxGasRatio:   maxGasRatio,
+		MinGasLimit:   minGasLimit,
+		MaxSize:       txSizeLimit,
+	}
+}
+
+// Validate checks whether the limits are valid.
+func (l *Limits) Validate() error {
+	if l.MaxExpiration <= 0 || l.MinGasRatio <= 0 || l.MinGasLimit <= 0 || l.MaxSize <= 0 {
+		return errors.New("tx limits should be positive")
+	}
+	if l.MaxExpiration > MaxExpiration {
+		return fmt.Errorf("max expiration should not be above %v, the window that the txs are deduped in", MaxExpiration)
+	}
+	if l.MinGasRatio > l.MaxGasRatio {
+		return errors.New("min gas ratio should not be above max gas ratio")
+	}
+	if l.MaxGasRatio > gasRatioBound {
+		return fmt.Errorf("max gas ratio should not be above %v", gasRatioBound)
+	}
+	return nil
+}
@@ -90,6 +90,13 @@ type Tx struct {
 	Delay                int64              `protobuf:"varint,10,opt,name=delay,proto3" json:"delay,omitempty"`
 	ReferredTx           []byte             `protobuf:"bytes,11,opt,name=referredTx,proto3" json:"referredTx,omitempty"`
 	AmountLimit          []*contract.Amount `protobuf:"bytes,12,rep,name=amountLimit,proto3" json:"amountLimit,omitempty"`
+	Sequence             int64              `protobuf:"varint,13,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Sponsor              string             `protobuf:"bytes,14,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	SponsorGasLimit      int64              `protobuf:"varint,15,opt,name=sponsorGasLimit,proto3" json:"sponsorGasLimit,omitempty"`
+	SponsorRAMLimit      int64              `protobuf:"varint,16,opt,name=sponsorRAMLimit,proto3" json:"sponsorRAMLimit,omitempty"`
+	SponsorSigns         []*pb.Signature    `protobuf:"bytes,17,rep,name=sponsorSigns,proto3" json:"sponsorSigns,omitempty"`
+	RecurInterval        int64              `protobuf:"varint,18,opt,name=recurInterval,proto3" json:"recurInterval,omitempty"`
+	RecurCount           int64              `protobuf:"varint,19,opt,name=recurCount,proto3" json:"recurCount,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
 	XXX_sizecache        int32              `json:"-"`
@@ -204,6 +211,55 @@ func (m *Tx) GetAmountLimit() []*contract.Amount {
 	return nil
 }
 
+func (m *Tx) GetSequence() int64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *Tx) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *Tx) GetSponsorGasLimit() int64 {
+	if m != nil {
+		return m.SponsorGasLimit
+	}
+	return 0
+}
+
+func (m *Tx) GetSponsorRAMLimit() int64 {
+	if m != nil {
+		return m.SponsorRAMLimit
+	}
+	return 0
+}
+
+func (m *Tx) GetSponsorSigns() []*pb.Signature {
+	if m != nil {
+		return m.SponsorSigns
+	}
+	return nil
+}
+
+func (m *Tx) GetRecurInterval() int64 {
+	if m != nil {
+		return m.RecurInterval
+	}
+	return 0
+}
+
+func (m *Tx) GetRecurCount() int64 {
+	if m != nil {
+		return m.RecurCount
+	}
+	return 0
+}
+
 type Receipt struct {
 	FuncName             string   `protobuf:"bytes,1,opt,name=funcName,proto3" json:"funcName,omitempty"`
 	Content              string   `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
@@ -298,6 +354,61 @@ func (m *Status) GetMessage() string {
 	return ""
 }
 
+type ActionReceipt struct {
+	Status               *Status  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ReturnValue          string   `protobuf:"bytes,2,opt,name=returnValue,proto3" json:"returnValue,omitempty"`
+	GasUsage             int64    `protobuf:"varint,3,opt,name=gasUsage,proto3" json:"gasUsage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ActionReceipt) Reset()         { *m = ActionReceipt{} }
+func (m *ActionReceipt) String() string { return proto.CompactTextString(m) }
+func (*ActionReceipt) ProtoMessage()    {}
+func (*ActionReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a5cd2a43d9b9fb36, []int{4}
+}
+
+func (m *ActionReceipt) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActionReceipt.Unmarshal(m, b)
+}
+func (m *ActionReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActionReceipt.Marshal(b, m, deterministic)
+}
+func (m *ActionReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionReceipt.Merge(m, src)
+}
+func (m *ActionReceipt) XXX_Size() int {
+	return xxx_messageInfo_ActionReceipt.Size(m)
+}
+func (m *ActionReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionReceipt proto.InternalMessageInfo
+
+func (m *ActionReceipt) GetStatus() *Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ActionReceipt) GetReturnValue() string {
+	if m != nil {
+		return m.ReturnValue
+	}
+	return ""
+}
+
+func (m *ActionReceipt) GetGasUsage() int64 {
+	if m != nil {
+		return m.GasUsage
+	}
+	return 0
+}
+
 type TxReceipt struct {
 	TxHash               []byte           `protobuf:"bytes,1,opt,name=txHash,proto3" json:"txHash,omitempty"`
 	GasUsage             int64            `protobuf:"varint,2,opt,name=gasUsage,proto3" json:"gasUsage,omitempty"`
@@ -305,6 +416,7 @@ type TxReceipt struct {
 	Status               *Status          `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
 	Returns              []string         `protobuf:"bytes,5,rep,name=returns,proto3" json:"returns,omitempty"`
 	Receipts             []*Receipt       `protobuf:"bytes,6,rep,name=receipts,proto3" json:"receipts,omitempty"`
+	ActionReceipts       []*ActionReceipt `protobuf:"bytes,7,rep,name=actionReceipts,proto3" json:"actionReceipts,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -314,7 +426,7 @@ func (m *TxReceipt) Reset()         { *m = TxReceipt{} }
 func (m *TxReceipt) String() string { return proto.CompactTextString(m) }
 func (*TxReceipt) ProtoMessage()    {}
 func (*TxReceipt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a5cd2a43d9b9fb36, []int{4}
+	return fileDescriptor_a5cd2a43d9b9fb36, []int{5}
 }
 
 func (m *TxReceipt) XXX_Unmarshal(b []byte) error {
@@ -377,11 +489,19 @@ func (m *TxReceipt) GetReceipts() []*Receipt {
 	return nil
 }
 
+func (m *TxReceipt) GetActionReceipts() []*ActionReceipt {
+	if m != nil {
+		return m.ActionReceipts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Action)(nil), "txpb.Action")
 	proto.RegisterType((*Tx)(nil), "txpb.Tx")
 	proto.RegisterType((*Receipt)(nil), "txpb.Receipt")
 	proto.RegisterType((*Status)(nil), "txpb.Status")
+	proto.RegisterType((*ActionReceipt)(nil), "txpb.ActionReceipt")
 	proto.RegisterType((*TxReceipt)(nil), "txpb.TxReceipt")
 	proto.RegisterMapType((map[string]int64)(nil), "txpb.TxReceipt.RamUsageEntry")
 }
@@ -389,40 +509,48 @@ func init() {
 func init() { proto.RegisterFile("core/tx/pb/tx.proto", fileDescriptor_a5cd2a43d9b9fb36) }
 
 var fileDescriptor_a5cd2a43d9b9fb36 = []byte{
-	// 546 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xcf, 0x8b, 0xd3, 0x40,
-	0x14, 0xa6, 0x4d, 0x9b, 0x36, 0xaf, 0x5d, 0x58, 0x46, 0x91, 0x50, 0x54, 0x4a, 0x91, 0xa5, 0x1e,
-	0x36, 0x81, 0x2a, 0xa2, 0x2b, 0x22, 0x7b, 0x10, 0x3c, 0x88, 0x87, 0x69, 0x05, 0xaf, 0x93, 0x74,
-	0x9a, 0x0e, 0x36, 0x99, 0x30, 0x33, 0x91, 0xf4, 0x3f, 0xf1, 0xec, 0x5f, 0x2a, 0xf3, 0x66, 0x12,
-	0xbb, 0x07, 0xf1, 0xf6, 0xbe, 0xf9, 0xde, 0xfb, 0xde, 0xcf, 0x81, 0x47, 0xb9, 0x54, 0x3c, 0x35,
-	0x6d, 0x5a, 0x67, 0xa9, 0x69, 0x93, 0x5a, 0x49, 0x23, 0xc9, 0xc8, 0xb4, 0x75, 0xb6, 0xb8, 0x2b,
-	0x84, 0x39, 0x36, 0x59, 0x92, 0xcb, 0x32, 0x15, 0x52, 0x9b, 0x5b, 0x79, 0x38, 0x88, 0x5c, 0xb0,
-	0x53, 0x5a, 0xc8, 0x5b, 0xfb, 0x90, 0xe6, 0xea, 0x5c, 0x1b, 0x69, 0x43, 0xb5, 0x28, 0x2a, 0x66,
-	0x1a, 0xc5, 0x9d, 0xc2, 0xe2, 0xc3, 0xff, 0x63, 0x6d, 0xde, 0x5c, 0x56, 0x46, 0xb1, 0xdc, 0xf4,
-	0x86, 0x0b, 0x5f, 0x7d, 0x87, 0xf0, 0x3e, 0x37, 0x42, 0x56, 0x64, 0x01, 0xd3, 0x8e, 0x8b, 0x07,
-	0xcb, 0xc1, 0x3a, 0xa2, 0x3d, 0x26, 0xcf, 0x01, 0x18, 0x7a, 0x7d, 0x65, 0x25, 0x8f, 0x87, 0xc8,
-	0x5e, 0xbc, 0x10, 0x02, 0xa3, 0x3d, 0x33, 0x2c, 0x0e, 0x90, 0x41, 0x7b, 0xf5, 0x2b, 0x80, 0xe1,
-	0xae, 0xb5, 0x94, 0x11, 0x25, 0x47, 0xc9, 0x80, 0xa2, 0x6d, 0xe5, 0x78, 0x5b, 0x0b, 0xc5, 0xac,
-	0x00, 0xca, 0x05, 0xf4, 0xe2, 0xc5, 0x96, 0x52, 0x30, 0xfd, 0x45, 0x94, 0xc2, 0xa0, 0x64, 0x40,
-	0x7b, 0xec, 0x39, 0x6a, 0x1d, 0xe3, 0x51, 0xcf, 0x21, 0x26, 0x37, 0x30, 0x71, 0x45, 0xe9, 0x78,
-	0xbc, 0x0c, 0xd6, 0xb3, 0xcd, 0x3c, 0xb1, 0xf3, 0x4d, 0x5c, 0x87, 0xb4, 0x23, 0x49, 0x0c, 0x13,
-	0x3b, 0x46, 0xae, 0x74, 0x1c, 0x2e, 0x83, 0x75, 0x44, 0x3b, 0x48, 0x6e, 0x60, 0x6c, 0x4d, 0x1d,
-	0x4f, 0x30, 0xfe, 0x3a, 0xd1, 0xa2, 0xa8, 0xb3, 0x64, 0xdb, 0x0d, 0x9d, 0x3a, 0x9a, 0x3c, 0x85,
-	0xa8, 0x6e, 0xb2, 0x93, 0xd0, 0x47, 0xae, 0xe2, 0x29, 0x76, 0xfd, 0xf7, 0x81, 0xbc, 0x86, 0xb9,
-	0x07, 0x5b, 0x14, 0x8b, 0xfe, 0x21, 0xf6, 0xc0, 0x8b, 0x3c, 0x86, 0xf1, 0x9e, 0x9f, 0xd8, 0x39,
-	0x06, 0x6c, 0xcb, 0x01, 0x3b, 0x2b, 0xc5, 0x0f, 0x5c, 0x29, 0xbe, 0xdf, 0xb5, 0xf1, 0x6c, 0x39,
-	0x58, 0xcf, 0xe9, 0xc5, 0x0b, 0xd9, 0xc0, 0x8c, 0x95, 0xb2, 0xa9, 0x8c, 0x1b, 0xd7, 0xdc, 0xa7,
-	0xea, 0xd7, 0x7c, 0x8f, 0x24, 0xbd, 0x74, 0x5a, 0x7d, 0x84, 0x09, 0xe5, 0x39, 0x17, 0x35, 0x8e,
-	0xf3, 0xd0, 0x54, 0x39, 0xee, 0xd5, 0x6f, 0xbd, 0xc3, 0x76, 0x4c, 0x56, 0x86, 0x57, 0xc6, 0xaf,
-	0xbc, 0x83, 0xab, 0x37, 0x10, 0x6e, 0x0d, 0x33, 0x8d, 0xb6, 0xeb, 0xcd, 0xe5, 0xde, 0xc5, 0x8e,
-	0x29, 0xda, 0x36, 0xae, 0xe4, 0x5a, 0xb3, 0xa2, 0x3b, 0x95, 0x0e, 0xae, 0x7e, 0x0f, 0x21, 0xda,
-	0xb5, 0x5d, 0xee, 0x27, 0x10, 0x9a, 0xf6, 0x33, 0xd3, 0x47, 0x8c, 0x9e, 0x53, 0x8f, 0xfc, 0x8a,
-	0xbf, 0xf5, 0x02, 0x6e, 0xc5, 0x88, 0xc9, 0x3b, 0x98, 0x2a, 0x56, 0x3a, 0x2e, 0xc0, 0x5e, 0x9f,
-	0xb9, 0x1d, 0xf7, 0xb2, 0x09, 0xf5, 0xfc, 0xa7, 0xca, 0xa8, 0x33, 0xed, 0xdd, 0xc9, 0x0b, 0x08,
-	0x35, 0x16, 0x8d, 0x77, 0xd3, 0x1f, 0x87, 0x6b, 0x84, 0x7a, 0xce, 0x16, 0xaf, 0xb8, 0x69, 0x94,
-	0xbf, 0xa1, 0x88, 0x76, 0x90, 0xbc, 0x84, 0xa9, 0x72, 0x29, 0xdc, 0xd9, 0xcc, 0x36, 0x57, 0x4e,
-	0xc1, 0x27, 0xa6, 0x3d, 0xbd, 0x78, 0x0f, 0x57, 0x0f, 0xaa, 0x20, 0xd7, 0x10, 0xfc, 0xe0, 0x67,
-	0x3f, 0x61, 0x6b, 0xda, 0x6d, 0xff, 0x64, 0xa7, 0xa6, 0xeb, 0xd0, 0x81, 0xbb, 0xe1, 0xdb, 0x41,
-	0x16, 0xe2, 0xcf, 0x7c, 0xf5, 0x27, 0x00, 0x00, 0xff, 0xff, 0xe2, 0x9f, 0xc0, 0x99, 0x31, 0x04,
-	0x00, 0x00,
+	// 687 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x5d, 0x6b, 0x13, 0x4d,
+	0x14, 0x26, 0xd9, 0x7c, 0xed, 0x49, 0xd2, 0xf6, 0x9d, 0xbe, 0xc8, 0x10, 0x54, 0x42, 0x28, 0x25,
+	0x5e, 0x74, 0x03, 0x55, 0x44, 0x5b, 0x44, 0x8a, 0x88, 0x0a, 0xda, 0x8b, 0x69, 0x15, 0x6f, 0x27,
+	0x9b, 0x49, 0xba, 0x98, 0xdd, 0x59, 0x67, 0x66, 0xcb, 0xe6, 0xcf, 0xf8, 0xd7, 0xfc, 0x2b, 0x32,
+	0x5f, 0xdb, 0xdd, 0x42, 0xf5, 0xee, 0x3c, 0xe7, 0xe3, 0x39, 0x7b, 0x9e, 0x39, 0x67, 0xe1, 0x30,
+	0xe6, 0x82, 0x2d, 0x54, 0xb9, 0xc8, 0x97, 0x0b, 0x55, 0x46, 0xb9, 0xe0, 0x8a, 0xa3, 0x8e, 0x2a,
+	0xf3, 0xe5, 0xe4, 0x6c, 0x93, 0xa8, 0x9b, 0x62, 0x19, 0xc5, 0x3c, 0x5d, 0x24, 0x5c, 0xaa, 0x13,
+	0xbe, 0x5e, 0x27, 0x71, 0x42, 0xb7, 0x8b, 0x0d, 0x3f, 0xd1, 0x8e, 0x45, 0x2c, 0x76, 0xb9, 0xe2,
+	0xba, 0x54, 0x26, 0x9b, 0x8c, 0xaa, 0x42, 0x30, 0xcb, 0x30, 0x79, 0xf3, 0xef, 0x5a, 0xdd, 0x37,
+	0xe6, 0x99, 0x12, 0x34, 0x56, 0x95, 0x61, 0xcb, 0x67, 0xdf, 0xa1, 0x77, 0x11, 0xab, 0x84, 0x67,
+	0x68, 0x02, 0x03, 0x1f, 0xc3, 0xad, 0x69, 0x6b, 0x1e, 0x92, 0x0a, 0xa3, 0xa7, 0x00, 0xd4, 0x64,
+	0x5d, 0xd2, 0x94, 0xe1, 0xb6, 0x89, 0xd6, 0x3c, 0x08, 0x41, 0x67, 0x45, 0x15, 0xc5, 0x81, 0x89,
+	0x18, 0x7b, 0xf6, 0xab, 0x0b, 0xed, 0xeb, 0x52, 0x87, 0x54, 0x92, 0x32, 0x43, 0x19, 0x10, 0x63,
+	0x6b, 0x3a, 0x56, 0xe6, 0x89, 0xa0, 0x9a, 0xc0, 0xd0, 0x05, 0xa4, 0xe6, 0xd1, 0x9f, 0xb2, 0xa1,
+	0xf2, 0x73, 0x92, 0x26, 0xca, 0x50, 0x06, 0xa4, 0xc2, 0x2e, 0x46, 0x74, 0x22, 0xee, 0x54, 0x31,
+	0x83, 0xd1, 0x31, 0xf4, 0xed, 0x47, 0x49, 0xdc, 0x9d, 0x06, 0xf3, 0xe1, 0xe9, 0x28, 0xd2, 0xfa,
+	0x46, 0x76, 0x42, 0xe2, 0x83, 0x08, 0x43, 0x5f, 0xcb, 0xc8, 0x84, 0xc4, 0xbd, 0x69, 0x30, 0x0f,
+	0x89, 0x87, 0xe8, 0x18, 0xba, 0xda, 0x94, 0xb8, 0x6f, 0xea, 0x0f, 0x22, 0x99, 0x6c, 0xf2, 0x65,
+	0x74, 0xe5, 0x45, 0x27, 0x36, 0x8c, 0x1e, 0x43, 0x98, 0x17, 0xcb, 0x6d, 0x22, 0x6f, 0x98, 0xc0,
+	0x03, 0x33, 0xf5, 0x9d, 0x03, 0xbd, 0x80, 0x91, 0x03, 0x57, 0x86, 0x2c, 0x7c, 0x80, 0xac, 0x91,
+	0x85, 0xfe, 0x87, 0xee, 0x8a, 0x6d, 0xe9, 0x0e, 0x83, 0x19, 0xcb, 0x02, 0xad, 0x95, 0x60, 0x6b,
+	0x26, 0x04, 0x5b, 0x5d, 0x97, 0x78, 0x38, 0x6d, 0xcd, 0x47, 0xa4, 0xe6, 0x41, 0xa7, 0x30, 0xa4,
+	0x29, 0x2f, 0x32, 0x65, 0xe5, 0x1a, 0xb9, 0x56, 0xd5, 0x33, 0x5f, 0x98, 0x20, 0xa9, 0x27, 0x69,
+	0x0d, 0x25, 0xfb, 0x59, 0xb0, 0x2c, 0x66, 0x78, 0x6c, 0x35, 0xf4, 0xd8, 0x68, 0x93, 0xf3, 0x4c,
+	0x72, 0x81, 0xf7, 0xcc, 0x5c, 0x1e, 0xa2, 0x39, 0xec, 0x3b, 0xf3, 0x83, 0x7f, 0x9c, 0x7d, 0x53,
+	0x7c, 0xdf, 0x5d, 0xcb, 0x24, 0x17, 0x5f, 0x6c, 0xe6, 0x41, 0x23, 0xd3, 0xbb, 0xb5, 0x52, 0xce,
+	0x65, 0x95, 0xfa, 0xef, 0x21, 0xa5, 0xea, 0x59, 0xe8, 0x08, 0xc6, 0x82, 0xc5, 0x85, 0xf8, 0x94,
+	0x29, 0x26, 0x6e, 0xe9, 0x16, 0x23, 0xc3, 0xde, 0x74, 0x5a, 0xe5, 0xe2, 0x42, 0xbc, 0xd3, 0x83,
+	0xe3, 0x43, 0xbb, 0x65, 0x77, 0x9e, 0xd9, 0x5b, 0xe8, 0x13, 0x16, 0xb3, 0x24, 0x37, 0x82, 0xac,
+	0x8b, 0x2c, 0xbe, 0xa4, 0x6e, 0x51, 0x43, 0x52, 0x61, 0x2d, 0x88, 0x16, 0x93, 0x65, 0xca, 0x2d,
+	0xbe, 0x87, 0xb3, 0x97, 0xd0, 0xbb, 0x52, 0x54, 0x15, 0x52, 0x2f, 0x79, 0xcc, 0x57, 0xb6, 0xb6,
+	0x4b, 0x8c, 0xad, 0xeb, 0x52, 0x26, 0x25, 0xdd, 0xf8, 0x83, 0xf1, 0x70, 0x26, 0x61, 0xec, 0x36,
+	0xd2, 0xb5, 0x3f, 0x82, 0x9e, 0x34, 0x44, 0x86, 0xa0, 0x5a, 0x5b, 0x4b, 0x4e, 0x5c, 0x0c, 0x4d,
+	0x61, 0x28, 0x98, 0x2a, 0x44, 0xf6, 0x8d, 0x6e, 0x0b, 0x4f, 0x5a, 0x77, 0xb9, 0xdb, 0xf8, 0x6a,
+	0x7a, 0xde, 0xdd, 0x8d, 0xc1, 0xb3, 0xdf, 0x6d, 0x08, 0xaf, 0x4b, 0xdf, 0xf1, 0x11, 0xf4, 0x54,
+	0xf9, 0x91, 0xca, 0x1b, 0xd3, 0x71, 0x44, 0x1c, 0x6a, 0x30, 0xb4, 0x9b, 0x0c, 0xe8, 0x35, 0x0c,
+	0x04, 0x4d, 0x3d, 0xbb, 0x7e, 0xa7, 0x27, 0xf6, 0x3b, 0x2b, 0xda, 0x88, 0xb8, 0xf8, 0xfb, 0x4c,
+	0x89, 0x1d, 0xa9, 0xd2, 0x6b, 0x03, 0x76, 0xfe, 0x32, 0x20, 0x86, 0xbe, 0x9d, 0xc6, 0x9e, 0x6f,
+	0x48, 0x3c, 0x44, 0xcf, 0x60, 0x20, 0x6c, 0x0b, 0x7b, 0xb1, 0xc3, 0xd3, 0xb1, 0x65, 0x70, 0x8d,
+	0x49, 0x15, 0x46, 0xe7, 0xb0, 0x47, 0xeb, 0xe2, 0xfa, 0x53, 0x3e, 0x6c, 0xfc, 0x0a, 0x5c, 0xd9,
+	0xbd, 0xd4, 0xc9, 0x39, 0x8c, 0x1b, 0x23, 0xa0, 0x03, 0x08, 0x7e, 0xb0, 0x9d, 0xdb, 0x09, 0x6d,
+	0xea, 0x2b, 0xbd, 0xad, 0xf4, 0x0f, 0x88, 0x05, 0x67, 0xed, 0x57, 0xad, 0x65, 0xcf, 0xfc, 0x51,
+	0x9f, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0xd8, 0xe1, 0x85, 0x78, 0xe9, 0x05, 0x00, 0x00,
 }
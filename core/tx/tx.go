@@ -16,15 +16,20 @@ import (
 	"github.com/iost-official/go-iost/crypto"
 )
 
+// the default limits of tx
 const (
 	minGasRatio = 100
 	maxGasRatio = 10000
+	// the max gas ratio set on chain can't go above it, so the gas of a tx can't overflow
+	gasRatioBound = 100 * maxGasRatio
 	minGasLimit = 50000
 	txSizeLimit = 65536
 )
 
 // values
 var (
+	// MaxExpiration is the default max expiration, the current one is in the Limits set on chain.
+	// It's the window that the txpool dedups the txs in as well, so the one set on chain can't go above it.
 	MaxExpiration = int64(90 * time.Second)
 )
 
@@ -55,6 +60,15 @@ type Tx struct {
 	PublishSigns []*crypto.Signature `json:"-"`
 	ReferredTx   []byte              `json:"referred_tx"`
 	AmountLimit  []*contract.Amount  `json:"amountLimit"`
+	Sequence     int64               `json:"sequence"`
+	// the sponsor pays the gas and ram of the tx instead of the publisher
+	Sponsor         string              `json:"sponsor"`
+	SponsorGasLimit int64               `json:"sponsor_gas_limit"`
+	SponsorRAMLimit int64               `json:"sponsor_ram_limit"`
+	SponsorSigns    []*crypto.Signature `json:"-"`
+	// a delay tx with recur count above 1 is executed the count of times, once every recur interval
+	RecurInterval int64 `json:"recur_interval"`
+	RecurCount    int64 `json:"recur_count"`
 }
 
 // NewTx return a new Tx
@@ -107,11 +121,43 @@ func SignTx(tx *Tx, id string, kps []*account.KeyPair, signs ...*crypto.Signatur
 	return tx, nil
 }
 
+// SignTxSponsor signs the tx as its sponsor, only sponsor should do this
+func SignTxSponsor(tx *Tx, kps []*account.KeyPair) (*Tx, error) {
+	if tx.Sponsor == "" {
+		return nil, errors.New("transaction has no sponsor")
+	}
+	if tx.Publisher == "" {
+		return nil, errors.New("transaction has no publisher")
+	}
+	tx.SponsorSigns = []*crypto.Signature{}
+	for _, kp := range kps {
+		tx.SponsorSigns = append(tx.SponsorSigns, kp.Sign(tx.sponsorHash()))
+	}
+	tx.hash = nil
+	return tx, nil
+}
+
+// Payer returns the account paying the gas and ram of the tx.
+func (t *Tx) Payer() string {
+	if t.Sponsor != "" {
+		return t.Sponsor
+	}
+	return t.Publisher
+}
+
 // publishHash
 func (t *Tx) publishHash() []byte {
 	return common.Sha3(t.ToBytes(Publish))
 }
 
+// sponsorHash binds the publisher as well, so the sponsor signature can't be reused by another publisher
+func (t *Tx) sponsorHash() []byte {
+	sn := common.NewSimpleNotation()
+	sn.WriteBytes(t.ToBytes(Publish), false)
+	sn.WriteString(t.Publisher, true)
+	return common.Sha3(sn.Bytes())
+}
+
 // ToPb convert tx to txpb.Tx for transmission.
 func (t *Tx) ToPb() *txpb.Tx {
 	tr := &txpb.Tx{
@@ -123,6 +169,14 @@ func (t *Tx) ToPb() *txpb.Tx {
 		Delay:       t.Delay,
 		ReferredTx:  t.ReferredTx,
 		AmountLimit: t.AmountLimit,
+		Sequence:    t.Sequence,
+
+		Sponsor:         t.Sponsor,
+		SponsorGasLimit: t.SponsorGasLimit,
+		SponsorRAMLimit: t.SponsorRAMLimit,
+
+		RecurInterval: t.RecurInterval,
+		RecurCount:    t.RecurCount,
 	}
 	for _, a := range t.Actions {
 		tr.Actions = append(tr.Actions, a.ToPb())
@@ -135,6 +189,9 @@ func (t *Tx) ToPb() *txpb.Tx {
 	for _, sig := range t.PublishSigns {
 		tr.PublishSigns = append(tr.PublishSigns, sig.ToPb())
 	}
+	for _, sig := range t.SponsorSigns {
+		tr.SponsorSigns = append(tr.SponsorSigns, sig.ToPb())
+	}
 	return tr
 }
 
@@ -157,6 +214,12 @@ func (t *Tx) FromPb(tr *txpb.Tx) *Tx {
 	t.Delay = tr.Delay
 	t.ReferredTx = tr.ReferredTx
 	t.AmountLimit = tr.AmountLimit
+	t.Sequence = tr.Sequence
+	t.Sponsor = tr.Sponsor
+	t.SponsorGasLimit = tr.SponsorGasLimit
+	t.SponsorRAMLimit = tr.SponsorRAMLimit
+	t.RecurInterval = tr.RecurInterval
+	t.RecurCount = tr.RecurCount
 	for _, a := range tr.Actions {
 		ac := &Action{}
 		t.Actions = append(t.Actions, ac.FromPb(a))
@@ -173,6 +236,11 @@ func (t *Tx) FromPb(tr *txpb.Tx) *Tx {
 		sig := &crypto.Signature{}
 		t.PublishSigns = append(t.PublishSigns, sig.FromPb(sr))
 	}
+	t.SponsorSigns = nil
+	for _, sr := range tr.SponsorSigns {
+		sig := &crypto.Signature{}
+		t.SponsorSigns = append(t.SponsorSigns, sig.FromPb(sr))
+	}
 	t.hash = nil
 	return t
 }
@@ -221,6 +289,54 @@ func (t *Tx) IsDefer() bool {
 	return len(t.ReferredTx) > 0
 }
 
+// ReplaceKey returns the key of the transaction, the transactions with the same key are replacements of each other.
+//
+// A sequenced transaction is keyed by its publisher and sequence, others by their publisher, time and actions.
+func (t *Tx) ReplaceKey() string {
+	var b strings.Builder
+	b.Write(common.Int64ToBytes(int64(len(t.Publisher))))
+	b.WriteString(t.Publisher)
+	if t.Sequence != 0 {
+		b.WriteByte(1)
+		b.Write(common.Int64ToBytes(t.Sequence))
+		return b.String()
+	}
+	b.WriteByte(0)
+	b.Write(common.Int64ToBytes(t.Time))
+	for _, a := range t.Actions {
+		ab := a.ToBytes()
+		b.Write(common.Int64ToBytes(int64(len(ab))))
+		b.Write(ab)
+	}
+	return b.String()
+}
+
+// Occurrences returns the count of times the delay tx is executed.
+func (t *Tx) Occurrences() int64 {
+	if t.RecurCount > 1 {
+		return t.RecurCount
+	}
+	return 1
+}
+
+// DeferTime returns the time of the k-th defer tx generated by the delay tx, starting from 0.
+func (t *Tx) DeferTime(k int64) int64 {
+	return t.Time + t.Delay + k*t.RecurInterval
+}
+
+// Occurrence returns the index of the defer tx of the delay tx at deferTime, and whether there is such one.
+func (t *Tx) Occurrence(deferTime int64) (int64, bool) {
+	offset := deferTime - t.DeferTime(0)
+	if offset == 0 {
+		return 0, true
+	}
+	if offset < 0 || t.Occurrences() == 1 || offset%t.RecurInterval != 0 {
+		return 0, false
+	}
+	k := offset / t.RecurInterval
+	return k, k < t.Occurrences()
+}
+
 // CanceledDelaytxHash returns the delay transaction hash that is canceled.
 func (t *Tx) CanceledDelaytxHash() ([]byte, bool) {
 	for _, action := range t.Actions {
@@ -236,10 +352,11 @@ func (t *Tx) CanceledDelaytxHash() ([]byte, bool) {
 }
 
 func (t *Tx) verifyDeferBaseFields(referredTx *Tx) error {
-	if referredTx.Time+referredTx.Delay != t.Time {
+	k, ok := referredTx.Occurrence(t.Time)
+	if !ok {
 		return errors.New("unmatched referred tx delay time")
 	}
-	if referredTx.Expiration+referredTx.Delay != t.Expiration {
+	if referredTx.Expiration+referredTx.Delay+k*referredTx.RecurInterval != t.Expiration {
 		return errors.New("unmatched referred tx expiration time")
 	}
 	if referredTx.GasRatio != t.GasRatio {
@@ -248,6 +365,9 @@ func (t *Tx) verifyDeferBaseFields(referredTx *Tx) error {
 	if referredTx.GasLimit != t.GasLimit {
 		return errors.New("unmatched referred tx gas limit")
 	}
+	if referredTx.Sponsor != t.Sponsor || referredTx.SponsorGasLimit != t.SponsorGasLimit || referredTx.SponsorRAMLimit != t.SponsorRAMLimit {
+		return errors.New("unmatched referred tx sponsor")
+	}
 	if len(referredTx.Actions) != len(t.Actions) {
 		return errors.New("unmatched referred tx action length")
 	}
@@ -295,6 +415,14 @@ func (t *Tx) verifyDeferSigFields(referredTx *Tx) error {
 			return errors.New("unmatched referred tx sign")
 		}
 	}
+	if len(referredTx.SponsorSigns) != len(t.SponsorSigns) {
+		return errors.New("unmatched referred tx sponsorsigns length")
+	}
+	for i := 0; i < len(referredTx.SponsorSigns); i++ {
+		if !referredTx.SponsorSigns[i].Equal(t.SponsorSigns[i]) {
+			return errors.New("unmatched referred tx sponsorsign")
+		}
+	}
 	return nil
 }
 
@@ -311,6 +439,15 @@ func (t *Tx) VerifySelf() error { // only check whether sigs are legal
 	if t.Delay > 0 && t.IsDefer() {
 		return errors.New("invalid tx. including both delay and referredtx field")
 	}
+	if t.RecurCount < 0 || t.RecurInterval < 0 {
+		return errors.New("invalid tx. negative recur count or interval")
+	}
+	if t.RecurCount > 1 && (t.Delay <= 0 || t.RecurInterval <= 0) {
+		return errors.New("invalid tx. recurring tx should have positive delay and recur interval")
+	}
+	if t.RecurCount <= 1 && t.RecurInterval != 0 {
+		return errors.New("invalid tx. recur interval without recur count")
+	}
 	// Defer tx does not need to verify signature.
 	if t.IsDefer() {
 		return nil
@@ -338,6 +475,21 @@ func (t *Tx) VerifySelf() error { // only check whether sigs are legal
 			return fmt.Errorf("publisher error")
 		}
 	}
+	if t.Sponsor == "" {
+		if len(t.SponsorSigns) > 0 || t.SponsorGasLimit != 0 || t.SponsorRAMLimit != 0 {
+			return fmt.Errorf("sponsor empty error")
+		}
+		return nil
+	}
+	if len(t.SponsorSigns) == 0 {
+		return fmt.Errorf("sponsor signature empty error")
+	}
+	for _, sign := range t.SponsorSigns {
+		ok := sign != nil && sign.Verify(t.sponsorHash())
+		if !ok {
+			return fmt.Errorf("sponsor error")
+		}
+	}
 	return nil
 }
 
@@ -346,12 +498,12 @@ func (t *Tx) VerifySigner(sig *crypto.Signature) bool {
 	return sig.Verify(t.baseHash())
 }
 
-// IsExpired checks whether the transaction is expired compared to the given time ct.
-func (t *Tx) IsExpired(ct int64) bool {
+// IsExpired checks whether the transaction is expired compared to the given time ct under the limits l.
+func (t *Tx) IsExpired(ct int64, l *Limits) bool {
 	if t.Expiration <= ct {
 		return true
 	}
-	if ct-t.Time > MaxExpiration {
+	if ct-t.Time > l.MaxExpiration {
 		return true
 	}
 	return false
@@ -363,25 +515,64 @@ func (t *Tx) IsCreatedBefore(ct int64) bool {
 	return t.Time <= ct
 }
 
-// CheckSize checks whether tx size is valid.
-func (t *Tx) CheckSize() error {
-	if len(t.ToBytes(Full)) > txSizeLimit {
-		return fmt.Errorf("tx size illegal, should <= %v", txSizeLimit)
+// CheckSize checks whether tx size is valid under the limits l.
+func (t *Tx) CheckSize(l *Limits) error {
+	if int64(len(t.ToBytes(Full))) > l.MaxSize {
+		return fmt.Errorf("tx size illegal, should <= %v", l.MaxSize)
 	}
 	return nil
 }
 
-// CheckGas checks whether the transaction's gas is valid.
-func (t *Tx) CheckGas() error {
-	if t.GasRatio < minGasRatio || t.GasRatio > maxGasRatio {
-		return fmt.Errorf("gas ratio illegal, should in [%v, %v]", minGasRatio, maxGasRatio)
+// CheckGas checks whether the transaction's gas is valid under the limits l.
+func (t *Tx) CheckGas(l *Limits) error {
+	if t.GasRatio < l.MinGasRatio || t.GasRatio > l.MaxGasRatio {
+		return fmt.Errorf("gas ratio illegal, should in [%v, %v]", l.MinGasRatio, l.MaxGasRatio)
+	}
+	if t.GasLimit < l.MinGasLimit {
+		return fmt.Errorf("gas limit illegal, should >= %v", l.MinGasLimit)
 	}
-	if t.GasLimit < minGasLimit {
-		return fmt.Errorf("gas limit illegal, should >= %v", minGasLimit)
+	if t.Sponsor != "" && t.GasLimit > t.SponsorGasLimit {
+		return fmt.Errorf("gas limit illegal, should <= sponsor gas limit %v", t.SponsorGasLimit)
+	}
+	if t.SponsorRAMLimit < 0 {
+		return fmt.Errorf("sponsor ram limit illegal, should >= 0")
 	}
 	return nil
 }
 
+// flags of the optional fields present in the bytes of tx
+const (
+	sequenceFlag int64 = 1 << iota
+	sponsorFlag
+	sponsorGasLimitFlag
+	sponsorRAMLimitFlag
+	recurIntervalFlag
+	recurCountFlag
+)
+
+func (t *Tx) optionalFlags() int64 {
+	var flags int64
+	if t.Sequence != 0 {
+		flags |= sequenceFlag
+	}
+	if t.Sponsor != "" {
+		flags |= sponsorFlag
+	}
+	if t.SponsorGasLimit != 0 {
+		flags |= sponsorGasLimitFlag
+	}
+	if t.SponsorRAMLimit != 0 {
+		flags |= sponsorRAMLimitFlag
+	}
+	if t.RecurInterval != 0 {
+		flags |= recurIntervalFlag
+	}
+	if t.RecurCount != 0 {
+		flags |= recurCountFlag
+	}
+	return flags
+}
+
 // ToBytes converts tx to bytes.
 func (t *Tx) ToBytes(l ToBytesLevel) []byte {
 	sn := common.NewSimpleNotation()
@@ -404,6 +595,31 @@ func (t *Tx) ToBytes(l ToBytesLevel) []byte {
 	}
 	sn.WriteBytesSlice(amountBytes, false)
 
+	// the optional fields are written behind the flags of the present ones, so the bytes of the txs with
+	// different optional fields never collide, and a tx without any of them keeps its legacy bytes and hash
+	if flags := t.optionalFlags(); flags != 0 {
+		sn.WriteInt64(flags, true)
+		if flags&sequenceFlag != 0 {
+			sn.WriteInt64(t.Sequence, true)
+		}
+		// the sponsor fields are signed by the signers and the publisher as well
+		if flags&sponsorFlag != 0 {
+			sn.WriteString(t.Sponsor, true)
+		}
+		if flags&sponsorGasLimitFlag != 0 {
+			sn.WriteInt64(t.SponsorGasLimit, true)
+		}
+		if flags&sponsorRAMLimitFlag != 0 {
+			sn.WriteInt64(t.SponsorRAMLimit, true)
+		}
+		if flags&recurIntervalFlag != 0 {
+			sn.WriteInt64(t.RecurInterval, true)
+		}
+		if flags&recurCountFlag != 0 {
+			sn.WriteInt64(t.RecurCount, true)
+		}
+	}
+
 	if l > Base {
 		signBytes := make([][]byte, 0, len(t.Signs))
 		for _, sig := range t.Signs {
@@ -421,6 +637,14 @@ func (t *Tx) ToBytes(l ToBytesLevel) []byte {
 			signBytes = append(signBytes, sig.ToBytes())
 		}
 		sn.WriteBytesSlice(signBytes, false)
+
+		if t.Sponsor != "" {
+			sponsorBytes := make([][]byte, 0, len(t.SponsorSigns))
+			for _, sig := range t.SponsorSigns {
+				sponsorBytes = append(sponsorBytes, sig.ToBytes())
+			}
+			sn.WriteBytesSlice(sponsorBytes, false)
+		}
 	}
 
 	return sn.Bytes()
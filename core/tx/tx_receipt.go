@@ -93,14 +93,49 @@ func (r *Receipt) ToBytes() []byte {
 	return sn.Bytes()
 }
 
+// ActionReceipt is the result of an action executed in transaction
+type ActionReceipt struct {
+	Status   *Status
+	Return   string
+	GasUsage int64
+}
+
+// ToPb convert ActionReceipt to proto buf data structure.
+func (r *ActionReceipt) ToPb() *txpb.ActionReceipt {
+	return &txpb.ActionReceipt{
+		Status:      r.Status.ToPb(),
+		ReturnValue: r.Return,
+		GasUsage:    r.GasUsage,
+	}
+}
+
+// FromPb convert ActionReceipt from proto buf data structure.
+func (r *ActionReceipt) FromPb(ar *txpb.ActionReceipt) *ActionReceipt {
+	s := &Status{}
+	r.Status = s.FromPb(ar.Status)
+	r.Return = ar.ReturnValue
+	r.GasUsage = ar.GasUsage
+	return r
+}
+
+// ToBytes converts ActionReceipt to a specific byte slice.
+func (r *ActionReceipt) ToBytes() []byte {
+	sn := common.NewSimpleNotation()
+	sn.WriteBytes(r.Status.ToBytes(), false)
+	sn.WriteString(r.Return, true)
+	sn.WriteInt64(r.GasUsage, true)
+	return sn.Bytes()
+}
+
 // TxReceipt Transaction Receipt
 type TxReceipt struct { //nolint:golint
-	TxHash   []byte
-	GasUsage int64
-	RAMUsage map[string]int64
-	Status   *Status
-	Returns  []string
-	Receipts []*Receipt
+	TxHash         []byte
+	GasUsage       int64
+	RAMUsage       map[string]int64
+	Status         *Status
+	Returns        []string
+	Receipts       []*Receipt
+	ActionReceipts []*ActionReceipt
 }
 
 // NewTxReceipt generate tx receipt for a tx hash
@@ -110,12 +145,13 @@ func NewTxReceipt(txHash []byte) *TxReceipt {
 		Message: "",
 	}
 	return &TxReceipt{
-		TxHash:   txHash,
-		GasUsage: 0,
-		RAMUsage: make(map[string]int64),
-		Status:   status,
-		Returns:  []string{},
-		Receipts: []*Receipt{},
+		TxHash:         txHash,
+		GasUsage:       0,
+		RAMUsage:       make(map[string]int64),
+		Status:         status,
+		Returns:        []string{},
+		Receipts:       []*Receipt{},
+		ActionReceipts: []*ActionReceipt{},
 	}
 }
 
@@ -137,6 +173,9 @@ func (r *TxReceipt) ToPb() *txpb.TxReceipt {
 	for _, re := range r.Receipts {
 		tr.Receipts = append(tr.Receipts, re.ToPb())
 	}
+	for _, ar := range r.ActionReceipts {
+		tr.ActionReceipts = append(tr.ActionReceipts, ar.ToPb())
+	}
 	return tr
 }
 
@@ -163,6 +202,10 @@ func (r *TxReceipt) FromPb(tr *txpb.TxReceipt) *TxReceipt {
 		rc := &Receipt{}
 		r.Receipts = append(r.Receipts, rc.FromPb(re))
 	}
+	for _, ar := range tr.ActionReceipts {
+		rc := &ActionReceipt{}
+		r.ActionReceipts = append(r.ActionReceipts, rc.FromPb(ar))
+	}
 	return r
 }
 
@@ -197,6 +240,16 @@ func (r *TxReceipt) ToBytes() []byte {
 	}
 	sn.WriteBytesSlice(receiptBytes, false)
 
+	// the action receipts only exist since the action receipt fork, they are only written when they exist
+	// so that the hash of the receipts in the blocks before the fork is not changed
+	if len(r.ActionReceipts) > 0 {
+		actionBytes := make([][]byte, 0, len(r.ActionReceipts))
+		for _, ar := range r.ActionReceipts {
+			actionBytes = append(actionBytes, ar.ToBytes())
+		}
+		sn.WriteBytesSlice(actionBytes, false)
+	}
+
 	return sn.Bytes()
 }
 
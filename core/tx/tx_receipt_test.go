@@ -6,6 +6,7 @@ import (
 	"bytes"
 
 	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/assert"
 )
 
 /*
@@ -66,3 +67,27 @@ func TestTxReceipt(t *testing.T) {
 
 	})
 }
+
+func TestActionReceipts(t *testing.T) {
+	r := NewTxReceipt([]byte{0, 1, 2})
+	r.Returns = []string{"[]"}
+	hash := r.Hash()
+
+	r.ActionReceipts = []*ActionReceipt{
+		{Status: &Status{Code: Success}, Return: "[]", GasUsage: 300},
+		{Status: &Status{Code: ErrorRuntime, Message: "abort"}, GasUsage: 100},
+	}
+	assert.NotEqual(t, hash, r.Hash())
+
+	var r1 TxReceipt
+	assert.Nil(t, r1.Decode(r.Encode()))
+	assert.Equal(t, r.Hash(), r1.Hash())
+	assert.Equal(t, 2, len(r1.ActionReceipts))
+	assert.Equal(t, ErrorRuntime, r1.ActionReceipts[1].Status.Code)
+	assert.Equal(t, "abort", r1.ActionReceipts[1].Status.Message)
+	assert.Equal(t, "[]", r1.ActionReceipts[0].Return)
+	assert.Equal(t, int64(300), r1.ActionReceipts[0].GasUsage)
+
+	r1.ActionReceipts = nil
+	assert.Equal(t, hash, r1.Hash())
+}
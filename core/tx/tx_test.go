@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"testing"
+	"time"
 
 	"encoding/base64"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/iost-official/go-iost/core/tx/pb"
 	"github.com/iost-official/go-iost/crypto"
 	. "github.com/smartystreets/goconvey/convey"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAction(t *testing.T) {
@@ -271,3 +273,232 @@ func BenchmarkHash(b *testing.B) {
 		tx.Hash()
 	}
 }
+
+func TestTxSequence(t *testing.T) {
+	a := NewAction("contract1", "actionname1", "[]")
+	t1 := NewTx([]*Action{a}, []string{}, 100000, 100, 11, 0)
+	hash := append([]byte{}, t1.Hash()...)
+
+	var t2 Tx
+	assert.Nil(t, t2.Decode(t1.Encode()))
+	assert.Equal(t, int64(0), t2.Sequence)
+	assert.Equal(t, hash, t2.Hash())
+
+	t1.Sequence = 5
+	t1.hash = nil
+	assert.NotEqual(t, hash, t1.Hash())
+	assert.NotEqual(t, t2.baseHash(), t1.baseHash())
+
+	var t3 Tx
+	assert.Nil(t, t3.Decode(t1.Encode()))
+	assert.Equal(t, int64(5), t3.Sequence)
+	assert.Equal(t, t1.Hash(), t3.Hash())
+}
+
+func TestTxOptionalBytes(t *testing.T) {
+	a := NewAction("contract1", "actionname1", "[]")
+	t1 := NewTx([]*Action{a}, []string{"alice"}, 100000, 100, 11, 1000)
+
+	// a tx without optional fields keeps the legacy bytes
+	sn := common.NewSimpleNotation()
+	sn.WriteInt64(t1.Time, true)
+	sn.WriteInt64(t1.Expiration, true)
+	sn.WriteInt64(t1.GasRatio, true)
+	sn.WriteInt64(t1.GasLimit, true)
+	sn.WriteInt64(t1.Delay, true)
+	sn.WriteStringSlice(t1.Signers, true)
+	sn.WriteBytesSlice([][]byte{a.ToBytes()}, false)
+	sn.WriteBytesSlice([][]byte{}, false)
+	assert.Equal(t, sn.Bytes(), t1.ToBytes(Base))
+
+	// a sequence followed by the recur fields can't be taken as the sponsor fields
+	t2 := *t1
+	t2.Sequence = 5
+	t2.RecurInterval = 500
+	t2.RecurCount = 3
+	t3 := *t1
+	t3.Sponsor = string(common.Int64ToBytes(5))
+	t3.SponsorGasLimit = 500
+	t3.SponsorRAMLimit = 3
+	assert.NotEqual(t, t2.ToBytes(Base), t3.ToBytes(Base))
+	assert.NotEqual(t, t1.ToBytes(Base), t2.ToBytes(Base))
+}
+
+func TestTxSponsor(t *testing.T) {
+	a := NewAction("contract1", "actionname1", "[]")
+	publisher, err := account.NewKeyPair(nil, crypto.Ed25519)
+	assert.Nil(t, err)
+	sponsor, err := account.NewKeyPair(nil, crypto.Ed25519)
+	assert.Nil(t, err)
+
+	t1 := NewTx([]*Action{a}, []string{}, 100000, 100, 11, 0)
+	assert.Equal(t, "", t1.Payer())
+	_, err = SignTxSponsor(t1, []*account.KeyPair{sponsor})
+	assert.NotNil(t, err)
+
+	t1.Sponsor = "bob"
+	t1.SponsorGasLimit = 100000
+	t1.SponsorRAMLimit = 1000
+	t1, err = SignTx(t1, "alice", []*account.KeyPair{publisher})
+	assert.Nil(t, err)
+	assert.Equal(t, "bob", t1.Payer())
+	assert.Nil(t, t1.CheckGas(DefaultLimits()))
+	assert.NotNil(t, t1.VerifySelf())
+
+	t1, err = SignTxSponsor(t1, []*account.KeyPair{sponsor})
+	assert.Nil(t, err)
+	assert.Nil(t, t1.VerifySelf())
+
+	var t2 Tx
+	assert.Nil(t, t2.Decode(t1.Encode()))
+	assert.Equal(t, "bob", t2.Sponsor)
+	assert.Equal(t, int64(1000), t2.SponsorRAMLimit)
+	assert.Equal(t, t1.Hash(), t2.Hash())
+	assert.Nil(t, t2.VerifySelf())
+
+	t2.SponsorRAMLimit = 2000
+	assert.NotNil(t, t2.VerifySelf())
+
+	t2.SponsorRAMLimit = 1000
+	t2.SponsorGasLimit = 50000
+	assert.NotNil(t, t2.CheckGas(DefaultLimits()))
+
+	// the sponsor limits are tagged on their own, and not allowed without sponsor
+	t3, err := SignTx(NewTx([]*Action{a}, []string{}, 100000, 100, 11, 0), "alice", []*account.KeyPair{publisher})
+	assert.Nil(t, err)
+	assert.Nil(t, t3.VerifySelf())
+	t4 := *t3
+	t4.SponsorRAMLimit = 1000
+	assert.NotEqual(t, t3.ToBytes(Base), t4.ToBytes(Base))
+	assert.NotNil(t, t4.VerifySelf())
+}
+
+func TestTxSponsorRepublish(t *testing.T) {
+	a := NewAction("contract1", "actionname1", "[]")
+	publisher, err := account.NewKeyPair(nil, crypto.Ed25519)
+	assert.Nil(t, err)
+	other, err := account.NewKeyPair(nil, crypto.Ed25519)
+	assert.Nil(t, err)
+	sponsor, err := account.NewKeyPair(nil, crypto.Ed25519)
+	assert.Nil(t, err)
+
+	t1 := NewTx([]*Action{a}, []string{}, 100000, 100, 11, 0)
+	t1.Sponsor = "bob"
+	t1.SponsorGasLimit = 100000
+	t1.Publisher = "alice"
+	t1, err = SignTxSponsor(t1, []*account.KeyPair{sponsor})
+	assert.Nil(t, err)
+	t1, err = SignTx(t1, "alice", []*account.KeyPair{publisher})
+	assert.Nil(t, err)
+	assert.Nil(t, t1.VerifySelf())
+
+	// another publisher takes the sponsor signature and publishes the tx under its own name
+	var t2 Tx
+	assert.Nil(t, t2.Decode(t1.Encode()))
+	_, err = SignTx(&t2, "mallory", []*account.KeyPair{other})
+	assert.Nil(t, err)
+	assert.NotNil(t, t2.VerifySelf())
+
+	t3 := NewTx([]*Action{a}, []string{}, 100000, 100, 11, 0)
+	t3.Sponsor = "bob"
+	t3.SponsorGasLimit = 100000
+	_, err = SignTxSponsor(t3, []*account.KeyPair{sponsor})
+	assert.NotNil(t, err)
+}
+
+func TestTxRecurrence(t *testing.T) {
+	a := NewAction("contract1", "actionname1", "[]")
+	kp, err := account.NewKeyPair(nil, crypto.Ed25519)
+	assert.Nil(t, err)
+
+	delayTx := NewTx([]*Action{a}, []string{}, 100000, 100, 11, 1000)
+	hash := append([]byte{}, delayTx.Hash()...)
+	assert.Equal(t, int64(1), delayTx.Occurrences())
+	delayTx.RecurInterval = 500
+	delayTx.RecurCount = 3
+	delayTx, err = SignTx(delayTx, "alice", []*account.KeyPair{kp})
+	assert.Nil(t, err)
+	assert.NotEqual(t, hash, delayTx.Hash())
+	assert.Nil(t, delayTx.VerifySelf())
+	assert.Equal(t, int64(3), delayTx.Occurrences())
+
+	for k := int64(0); k < 3; k++ {
+		deferTime := delayTx.DeferTime(k)
+		assert.Equal(t, delayTx.Time+1000+k*500, deferTime)
+		occurrence, ok := delayTx.Occurrence(deferTime)
+		assert.True(t, ok)
+		assert.Equal(t, k, occurrence)
+
+		deferTx := &Tx{
+			Actions:      delayTx.Actions,
+			Time:         deferTime,
+			Expiration:   delayTx.Expiration + deferTime - delayTx.Time,
+			GasLimit:     delayTx.GasLimit,
+			GasRatio:     delayTx.GasRatio,
+			Publisher:    delayTx.Publisher,
+			ReferredTx:   delayTx.Hash(),
+			AmountLimit:  delayTx.AmountLimit,
+			PublishSigns: delayTx.PublishSigns,
+			Signs:        delayTx.Signs,
+			Signers:      delayTx.Signers,
+		}
+		assert.Nil(t, deferTx.VerifyDefer(delayTx))
+		deferTx.Expiration++
+		assert.NotNil(t, deferTx.VerifyDefer(delayTx))
+	}
+	_, ok := delayTx.Occurrence(delayTx.DeferTime(3))
+	assert.False(t, ok)
+	_, ok = delayTx.Occurrence(delayTx.DeferTime(1) + 1)
+	assert.False(t, ok)
+
+	delayTx.Delay = 0
+	assert.NotNil(t, delayTx.VerifySelf())
+	delayTx.Delay = 1000
+	delayTx.RecurCount = 0
+	assert.NotNil(t, delayTx.VerifySelf())
+
+	// the recur interval is written without recur count as well
+	onceTx := *delayTx
+	onceTx.RecurInterval = 0
+	assert.NotEqual(t, onceTx.ToBytes(Base), delayTx.ToBytes(Base))
+}
+
+func TestTxLimits(t *testing.T) {
+	d := DefaultLimits()
+	assert.Nil(t, d.Validate())
+
+	now := time.Now().UnixNano()
+	trx := NewTx([]*Action{NewAction("contract1", "actionname1", "[]")}, nil, 100000, 100, now+int64(time.Minute), 0)
+	trx.Time = now
+	assert.Nil(t, trx.CheckGas(d))
+	assert.Nil(t, trx.CheckSize(d))
+	assert.False(t, trx.IsExpired(now+int64(time.Minute)-1, d))
+	assert.True(t, trx.IsExpired(now+int64(time.Minute), d))
+
+	l := DefaultLimits()
+	l.MinGasRatio = 200
+	l.MinGasLimit = 200000
+	l.MaxSize = 10
+	l.MaxExpiration = int64(time.Second)
+	assert.Nil(t, l.Validate())
+	assert.NotNil(t, trx.CheckGas(l))
+	trx.GasRatio = 200
+	assert.NotNil(t, trx.CheckGas(l))
+	trx.GasLimit = 200000
+	assert.Nil(t, trx.CheckGas(l))
+	assert.NotNil(t, trx.CheckSize(l))
+	assert.False(t, trx.IsExpired(now+int64(time.Second), l))
+	assert.True(t, trx.IsExpired(now+int64(2*time.Second), l))
+	assert.False(t, trx.IsExpired(now+int64(2*time.Second), d))
+
+	l.MaxGasRatio = 100
+	assert.NotNil(t, l.Validate())
+	l.MaxGasRatio = gasRatioBound + 1
+	assert.NotNil(t, l.Validate())
+	l.MaxGasRatio = 10000
+	l.MaxSize = 0
+	assert.NotNil(t, l.Validate())
+	l.MaxSize = 10
+	l.MaxExpiration = MaxExpiration + 1
+	assert.NotNil(t, l.Validate())
+}
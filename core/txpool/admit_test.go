@@ -0,0 +1,253 @@
+package txpool
+
+import (
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/golang-lru"
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/mocks"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/iost-official/go-iost/db"
+	"github.com/iost-official/go-iost/vm/database"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdmitTx(t *testing.T) {
+	oldCache, oldPublisher := maxCacheTxs, maxPublisherTxs
+	maxCacheTxs, maxPublisherTxs = 4, 2
+	defer func() {
+		maxCacheTxs, maxPublisherTxs = oldCache, oldPublisher
+	}()
+
+	dropped, err := lru.New(maxDroppedTxs)
+	assert.Nil(t, err)
+	journal, cleanup := newTestTxJournal(t)
+	defer cleanup()
+	pool := &TxPImpl{
+		pendingTx: NewSortedTxMap(),
+		droppedTx: dropped,
+		journal:   journal,
+	}
+	actions := []*tx.Action{tx.NewAction("contract1", "actionname1", "[]")}
+	newTx := func(publisher string, gasRatio int64) *tx.Tx {
+		t := tx.NewTx(actions, nil, 100000, gasRatio, time.Now().Add(time.Minute).UnixNano(), 0)
+		t.Publisher = publisher
+		return t
+	}
+
+	cheapest := newTx("alice", 100)
+	for _, tt := range []*tx.Tx{cheapest, newTx("alice", 200)} {
+		assert.Nil(t, pool.admitTx(tt))
+		pool.pendingTx.Add(tt)
+	}
+	assert.Equal(t, ErrPublisherQuota, pool.admitTx(newTx("alice", 300)))
+	assert.Equal(t, 2, pool.pendingTx.PublisherSize("alice"))
+
+	for _, tt := range []*tx.Tx{newTx("bob", 200), newTx("carol", 200)} {
+		assert.Nil(t, pool.admitTx(tt))
+		pool.pendingTx.Add(tt)
+	}
+	assert.Equal(t, ErrCacheFull, pool.admitTx(newTx("dave", 100)))
+
+	assert.Nil(t, pool.admitTx(newTx("dave", 300)))
+	assert.Equal(t, 3, pool.pendingTx.Size())
+	assert.Nil(t, pool.pendingTx.Get(cheapest.Hash()))
+	assert.Equal(t, 1, pool.pendingTx.PublisherSize("alice"))
+	v, ok := pool.droppedTx.Get(string(cheapest.Hash()))
+	assert.True(t, ok)
+	assert.Equal(t, TxStatusDropped, v.(*droppedTx).status)
+	assert.False(t, v.(*droppedTx).status.IsFinal())
+}
+
+func TestEvictSequencedTx(t *testing.T) {
+	oldCache := maxCacheTxs
+	maxCacheTxs = 4
+	defer func() {
+		maxCacheTxs = oldCache
+	}()
+
+	dropped, err := lru.New(maxDroppedTxs)
+	assert.Nil(t, err)
+	journal, cleanup := newTestTxJournal(t)
+	defer cleanup()
+	pool := &TxPImpl{
+		pendingTx: NewSortedTxMap(),
+		droppedTx: dropped,
+		journal:   journal,
+	}
+	newTx := func(publisher string, gasRatio int64, sequence int64) *tx.Tx {
+		actions := []*tx.Action{tx.NewAction("contract1", "actionname1", "[]")}
+		t := tx.NewTx(actions, nil, 100000, gasRatio, time.Now().Add(time.Minute).UnixNano(), 0)
+		t.Publisher = publisher
+		t.Sequence = sequence
+		return t
+	}
+	// the first sequence is the cheapest, the later ones can't be packed without it
+	seqs := []*tx.Tx{newTx("alice", 100, 1), newTx("alice", 300, 2), newTx("alice", 300, 3)}
+	for _, tt := range append(seqs, newTx("bob", 200, 0)) {
+		assert.Nil(t, pool.admitTx(tt))
+		pool.pendingTx.Add(tt)
+	}
+	assert.Equal(t, seqs[2], pool.pendingTx.HighestSequence("alice"))
+
+	assert.Nil(t, pool.admitTx(newTx("carol", 200, 0)))
+	assert.Nil(t, pool.pendingTx.Get(seqs[2].Hash()))
+	assert.Equal(t, seqs[0], pool.pendingTx.Get(seqs[0].Hash()))
+	assert.Equal(t, seqs[1], pool.pendingTx.HighestSequence("alice"))
+
+	pool.pendingTx.Del(seqs[0].Hash())
+	pool.pendingTx.Del(seqs[1].Hash())
+	assert.Nil(t, pool.pendingTx.HighestSequence("alice"))
+}
+
+func TestReplaceTx(t *testing.T) {
+	dropped, err := lru.New(maxDroppedTxs)
+	assert.Nil(t, err)
+	journal, cleanup := newTestTxJournal(t)
+	defer cleanup()
+	pool := &TxPImpl{
+		pendingTx: NewSortedTxMap(),
+		droppedTx: dropped,
+		journal:   journal,
+	}
+	now := time.Now()
+	newTx := func(gasRatio int64) *tx.Tx {
+		actions := []*tx.Action{tx.NewAction("contract1", "actionname1", "[]")}
+		t := tx.NewTx(actions, nil, 100000, gasRatio, now.Add(time.Minute).UnixNano(), 0)
+		t.Time = now.UnixNano()
+		t.Publisher = "alice"
+		return t
+	}
+	old := newTx(100)
+	assert.Nil(t, pool.admitTx(old))
+	pool.pendingTx.Add(old)
+
+	assert.Nil(t, pool.pendingTx.GetReplaced(old))
+
+	// a tx without sequence is replaced by the one with the same publisher, time and actions
+	assert.Equal(t, ErrUnderpriced, pool.admitTx(newTx(50)))
+	replacement := newTx(200)
+	assert.Equal(t, old, pool.pendingTx.GetReplaced(replacement))
+	assert.Nil(t, pool.admitTx(replacement))
+	pool.pendingTx.Add(replacement)
+	assert.Nil(t, pool.pendingTx.Get(old.Hash()))
+	assert.Equal(t, 1, pool.pendingTx.Size())
+	_, ok := pool.droppedTx.Get(string(old.Hash()))
+	assert.True(t, ok)
+
+	other := newTx(300)
+	other.Time++
+	assert.Nil(t, pool.pendingTx.GetReplaced(other))
+}
+
+func TestReplacedInChain(t *testing.T) {
+	pool := &TxPImpl{
+		blockList: new(sync.Map),
+	}
+	now := time.Now().UnixNano()
+	newTx := func(gasRatio int64) *tx.Tx {
+		actions := []*tx.Action{tx.NewAction("contract1", "actionname1", "[]")}
+		t := tx.NewTx(actions, nil, 100000, gasRatio, now+int64(time.Minute), 0)
+		t.Time = now
+		t.Publisher = "alice"
+		return t
+	}
+	parent := &block.Block{Head: &block.BlockHead{Number: 1, Time: now}}
+	blk := &block.Block{
+		Head: &block.BlockHead{Number: 2, Time: now},
+		Txs:  []*tx.Tx{newTx(200)},
+	}
+	child := &block.Block{Head: &block.BlockHead{Number: 3, Time: now}}
+	parent.CalculateHeadHash()
+	blk.Head.ParentHash = parent.HeadHash()
+	blk.CalculateHeadHash()
+	child.Head.ParentHash = blk.HeadHash()
+	child.CalculateHeadHash()
+	for _, b := range []*block.Block{parent, blk, child} {
+		pool.addBlock(b)
+	}
+
+	assert.True(t, pool.existReplacedInChain(newTx(100), child))
+	assert.False(t, pool.existReplacedInChain(blk.Txs[0], child))
+	assert.False(t, pool.existReplacedInChain(newTx(100), parent))
+}
+
+func TestReplaceSequencedTx(t *testing.T) {
+	dropped, err := lru.New(maxDroppedTxs)
+	assert.Nil(t, err)
+	journal, cleanup := newTestTxJournal(t)
+	defer cleanup()
+	pool := &TxPImpl{
+		pendingTx: NewSortedTxMap(),
+		droppedTx: dropped,
+		journal:   journal,
+	}
+	newTx := func(gasRatio int64, sequence int64) *tx.Tx {
+		actions := []*tx.Action{tx.NewAction("contract1", "actionname1", "[]")}
+		t := tx.NewTx(actions, nil, 100000, gasRatio, time.Now().Add(time.Minute).UnixNano(), 0)
+		t.Publisher = "alice"
+		t.Sequence = sequence
+		return t
+	}
+	old := newTx(100, 1)
+	assert.Nil(t, pool.admitTx(old))
+	pool.pendingTx.Add(old)
+	assert.Nil(t, pool.pendingTx.GetReplaced(newTx(100, 2)))
+
+	assert.Equal(t, ErrUnderpriced, pool.admitTx(newTx(100, 1)))
+	replacement := newTx(200, 1)
+	assert.Nil(t, pool.admitTx(replacement))
+	pool.pendingTx.Add(replacement)
+	assert.Nil(t, pool.pendingTx.Get(old.Hash()))
+	assert.Equal(t, 1, pool.pendingTx.Size())
+	assert.Equal(t, 1, pool.pendingTx.PublisherSize("alice"))
+	_, ok := pool.droppedTx.Get(string(old.Hash()))
+	assert.True(t, ok)
+
+	pool.pendingTx.Del(replacement.Hash())
+	assert.Nil(t, pool.pendingTx.GetReplaced(newTx(300, 1)))
+}
+
+func TestVerifySequence(t *testing.T) {
+	dir, err := ioutil.TempDir("", "txpool")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	stateDB, err := db.NewMVCCDB(dir)
+	assert.Nil(t, err)
+	defer stateDB.Close()
+	v := database.NewVisitor(0, stateDB)
+	v.SetSequence("alice", 5)
+	v.Commit()
+	stateDB.Commit()
+
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	bv := core_mock.NewMockBaseVariable(ctl)
+	bv.EXPECT().StateDB().Return(stateDB).AnyTimes()
+	pool := &TxPImpl{
+		global:    bv,
+		forkChain: new(forkChain),
+	}
+
+	kp, err := account.NewKeyPair(nil, crypto.Ed25519)
+	assert.Nil(t, err)
+	newTx := func(sequence int64) *tx.Tx {
+		actions := []*tx.Action{tx.NewAction("contract1", "actionname1", "[]")}
+		t := tx.NewTx(actions, nil, 100000, 100, time.Now().Add(time.Minute).UnixNano(), 0)
+		t.Sequence = sequence
+		t, _ = tx.SignTx(t, "alice", []*account.KeyPair{kp})
+		return t
+	}
+	assert.Equal(t, ErrSequenceUsed, pool.verifyTx(newTx(4)))
+	assert.Equal(t, ErrSequenceUsed, pool.verifyTx(newTx(5)))
+	assert.Nil(t, pool.verifyTx(newTx(6)))
+	// the tx ahead of the next sequence is kept in pending until the gap is filled
+	assert.Nil(t, pool.verifyTx(newTx(8)))
+}
@@ -7,8 +7,10 @@ import (
 	"sync"
 	"time"
 
+	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/core/tx"
 	"github.com/iost-official/go-iost/ilog"
+	"github.com/iost-official/go-iost/vm/database"
 
 	"github.com/emirpasic/gods/trees/redblacktree"
 	"github.com/uber-go/atomic"
@@ -31,6 +33,7 @@ func compareDeferTx(a, b interface{}) int {
 type DeferServer struct {
 	pool             *redblacktree.Tree
 	idxMap           map[string]*tx.Tx
+	recurring        map[string]*tx.Tx
 	rw               *sync.RWMutex
 	nextScheduleTime atomic.Int64
 
@@ -42,11 +45,12 @@ type DeferServer struct {
 // NewDeferServer returns a new DeferServer instance.
 func NewDeferServer(txpool *TxPImpl) (*DeferServer, error) {
 	deferServer := &DeferServer{
-		pool:   redblacktree.NewWith(compareDeferTx),
-		idxMap: make(map[string]*tx.Tx),
-		rw:     new(sync.RWMutex),
-		txpool: txpool,
-		quitCh: make(chan struct{}),
+		pool:      redblacktree.NewWith(compareDeferTx),
+		idxMap:    make(map[string]*tx.Tx),
+		recurring: make(map[string]*tx.Tx),
+		rw:        new(sync.RWMutex),
+		txpool:    txpool,
+		quitCh:    make(chan struct{}),
 	}
 	err := deferServer.buildIndex()
 	if err != nil {
@@ -62,8 +66,19 @@ func (d *DeferServer) buildIndex() error {
 	if err != nil {
 		return err
 	}
+	var vi *database.Visitor
 	for _, t := range txs {
 		idx := d.toIndex(t)
+		if t.Occurrences() > 1 {
+			// the recurring delay tx is scheduled at its next occurrence in the state
+			if vi == nil {
+				vi = database.NewVisitor(0, d.txpool.global.StateDB().Fork())
+			}
+			if r := vi.GetDelaytxRecurrence(string(idx.ReferredTx)); r != nil {
+				idx.Time = r.Next
+			}
+			d.recurring[string(idx.ReferredTx)] = t
+		}
 		d.pool.Put(idx, true)
 		d.idxMap[string(idx.ReferredTx)] = idx
 	}
@@ -73,11 +88,22 @@ func (d *DeferServer) buildIndex() error {
 func (d *DeferServer) toIndex(delayTx *tx.Tx) *tx.Tx {
 	return &tx.Tx{
 		ReferredTx: delayTx.Hash(),
-		Time:       delayTx.Time + delayTx.Delay,
+		Time:       delayTx.DeferTime(0),
 	}
 }
 
-// DelDeferTx deletes a tx in defer server.
+func (d *DeferServer) storeIndex(idx *tx.Tx) {
+	d.rw.Lock()
+	d.pool.Put(idx, true)
+	d.idxMap[string(idx.ReferredTx)] = idx
+	d.rw.Unlock()
+	if idx.Time < d.nextScheduleTime.Load() {
+		d.nextScheduleTime.Store(idx.Time)
+		d.restartDeferTicker()
+	}
+}
+
+// DelDeferTx deletes a tx in defer server, and schedules the next occurrence of a recurring delay tx.
 func (d *DeferServer) DelDeferTx(deferTx *tx.Tx) error {
 	idx := &tx.Tx{
 		ReferredTx: deferTx.ReferredTx,
@@ -86,7 +112,22 @@ func (d *DeferServer) DelDeferTx(deferTx *tx.Tx) error {
 	d.rw.Lock()
 	d.pool.Remove(idx)
 	delete(d.idxMap, string(idx.ReferredTx))
+	delayTx := d.recurring[string(idx.ReferredTx)]
+	if delayTx == nil {
+		d.rw.Unlock()
+		return nil
+	}
+	next := deferTx.Time + delayTx.RecurInterval
+	if _, ok := delayTx.Occurrence(next); !ok {
+		delete(d.recurring, string(idx.ReferredTx))
+		d.rw.Unlock()
+		return nil
+	}
 	d.rw.Unlock()
+	d.storeIndex(&tx.Tx{
+		ReferredTx: deferTx.ReferredTx,
+		Time:       next,
+	})
 	return nil
 }
 
@@ -101,19 +142,17 @@ func (d *DeferServer) DelDeferTxByHash(txHash []byte) {
 		d.pool.Remove(idx)
 		delete(d.idxMap, hashString)
 	}
+	delete(d.recurring, hashString)
 }
 
 // StoreDeferTx stores a tx in defer server.
 func (d *DeferServer) StoreDeferTx(delayTx *tx.Tx) {
-	idx := d.toIndex(delayTx)
-	d.rw.Lock()
-	d.pool.Put(idx, true)
-	d.idxMap[string(idx.ReferredTx)] = idx
-	d.rw.Unlock()
-	if idx.Time < d.nextScheduleTime.Load() {
-		d.nextScheduleTime.Store(idx.Time)
-		d.restartDeferTicker()
+	if delayTx.Occurrences() > 1 {
+		d.rw.Lock()
+		d.recurring[string(delayTx.Hash())] = delayTx
+		d.rw.Unlock()
 	}
+	d.storeIndex(d.toIndex(delayTx))
 }
 
 // DumpDeferTx dumps all defer transactions for debug.
@@ -154,7 +193,8 @@ func (d *DeferServer) restartDeferTicker() {
 
 func (d *DeferServer) deferTicker() {
 	for {
-		scheduled := time.Duration(d.nextScheduleTime.Load() - time.Now().UnixNano())
+		warped := common.ClockWarped()
+		scheduled := time.Duration(d.nextScheduleTime.Load() - common.Now())
 		if scheduled < minTickerTime {
 			scheduled = minTickerTime
 		}
@@ -163,6 +203,8 @@ func (d *DeferServer) deferTicker() {
 		case <-d.quitCh:
 			d.quitCh <- struct{}{}
 			return
+		case <-warped:
+			continue
 		case <-time.After(scheduled):
 			iter := d.pool.Iterator()
 			d.rw.RLock()
@@ -170,11 +212,11 @@ func (d *DeferServer) deferTicker() {
 			d.rw.RUnlock()
 			for ok {
 				idx := iter.Key().(*tx.Tx)
-				if idx.Time > time.Now().UnixNano() {
+				if idx.Time > common.Now() {
 					d.nextScheduleTime.Store(idx.Time)
 					break
 				}
-				err := d.txpool.AddDefertx(idx.ReferredTx)
+				err := d.txpool.AddDefertx(idx.ReferredTx, idx.Time)
 				if err == ErrCacheFull {
 					d.nextScheduleTime.Store(idx.Time)
 					break
@@ -16,10 +16,14 @@ type TxPool interface {
 	AddTx(tx *tx.Tx) error
 	DelTx(hash []byte) error
 	DelTxList(delList []*tx.Tx)
+	RemoveTx(hash []byte, reason string) error
 	ExistTxs(hash []byte, chainBlock *block.Block) FRet
 	GetFromPending(hash []byte) (*tx.Tx, error)
 	GetFromChain(hash []byte) (*tx.Tx, *tx.TxReceipt, error)
+	GetTxStatus(hash []byte) (TxStatus, string)
+	DropTx(t *tx.Tx, reason string)
 	Lock()
 	Release()
 	PendingTx() (*SortedTxMap, *blockcache.BlockCacheNode)
+	PendingNotify() <-chan struct{}
 }
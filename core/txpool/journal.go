@@ -0,0 +1,188 @@
+package txpool
+
+import (
+	"bytes"
+	"os"
+	"sync"
+
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/db/wal"
+)
+
+// The journal saves the txs accepted by the pending pool to disk, so they survive the restart of iserver.
+// The additions are journaled with the tx, and the removals which aren't caused by blocks are journaled
+// with the tx hash as tombstones. The journal is rewritten with the pending txs when it grows too large,
+// and the txs loaded from it are verified again like the newly received ones.
+
+const txJournalDir = "TxPoolWAL"
+
+var (
+	txJournalMeta    = []byte("tx_pool_wal")
+	txJournalRemoval = []byte("removal")
+)
+
+type txJournal struct {
+	path string
+	wal  *wal.WAL
+	size int
+	mu   sync.Mutex
+}
+
+// openTxJournal opens the journal at path and returns the txs saved in it.
+func openTxJournal(path string) (*txJournal, []*tx.Tx, error) {
+	if err := recoverTxJournal(path); err != nil {
+		return nil, nil, err
+	}
+	w, txs, size, err := openTxWAL(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &txJournal{
+		path: path,
+		wal:  w,
+		size: size,
+	}, txs, nil
+}
+
+// recoverTxJournal finishes or discards the rotation interrupted by a crash.
+// The new journal is complete once the old one is moved away, see rotate.
+func recoverTxJournal(path string) error {
+	tmpPath, oldPath := path+".tmp", path+".old"
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		if _, err := os.Stat(tmpPath); err == nil {
+			if err := os.Rename(tmpPath, path); err != nil {
+				return err
+			}
+		}
+	} else if err != nil {
+		return err
+	}
+	if err := os.RemoveAll(tmpPath); err != nil {
+		return err
+	}
+	return os.RemoveAll(oldPath)
+}
+
+// openTxWAL opens the wal at path and returns the txs which aren't removed and the number of entries in it.
+func openTxWAL(path string) (*wal.WAL, []*tx.Tx, int, error) {
+	w, err := wal.Create(path, txJournalMeta)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	txs := make([]*tx.Tx, 0)
+	if !w.HasDecoder() {
+		return w, txs, 0, nil
+	}
+	_, entries, err := w.ReadAll()
+	if err != nil {
+		w.Close()
+		return nil, nil, 0, err
+	}
+	index := make(map[string]int)
+	for _, entry := range entries {
+		if bytes.Equal(entry.ExtraMeta, txJournalRemoval) {
+			if i, ok := index[string(entry.Data)]; ok {
+				txs[i] = nil
+				delete(index, string(entry.Data))
+			}
+			continue
+		}
+		var t tx.Tx
+		if err := t.Decode(entry.Data); err != nil {
+			continue
+		}
+		index[string(t.Hash())] = len(txs)
+		txs = append(txs, &t)
+	}
+	ret := make([]*tx.Tx, 0, len(index))
+	for _, t := range txs {
+		if t != nil {
+			ret = append(ret, t)
+		}
+	}
+	return w, ret, len(entries), nil
+}
+
+// insert saves the tx to the journal.
+func (j *txJournal) insert(t *tx.Tx) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if _, err := j.wal.SaveSingle(wal.Entry{Data: t.Encode()}); err != nil {
+		return err
+	}
+	j.size++
+	return nil
+}
+
+// remove saves the tombstone of the tx to the journal.
+func (j *txJournal) remove(t *tx.Tx) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if _, err := j.wal.SaveSingle(wal.Entry{Data: t.Hash(), ExtraMeta: txJournalRemoval}); err != nil {
+		return err
+	}
+	j.size++
+	return nil
+}
+
+// rotate replaces the journal with the given txs. The new journal is written aside, then the old one
+// is moved away and the new one is moved in, so a crash during rotation leaves a journal which
+// recoverTxJournal can restore. The old journal is kept open until the new one is in place.
+func (j *txJournal) rotate(txs []*tx.Tx) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	tmpPath, oldPath := j.path+".tmp", j.path+".old"
+	if err := os.RemoveAll(tmpPath); err != nil {
+		return err
+	}
+	w, err := wal.Create(tmpPath, txJournalMeta)
+	if err != nil {
+		return err
+	}
+	entries := make([]wal.Entry, 0, len(txs))
+	for _, t := range txs {
+		entries = append(entries, wal.Entry{Data: t.Encode()})
+	}
+	if _, err := w.Save(entries); err != nil {
+		w.Close()
+		os.RemoveAll(tmpPath)
+		return err
+	}
+	if err := w.Close(); err != nil {
+		os.RemoveAll(tmpPath)
+		return err
+	}
+	if err := os.RemoveAll(oldPath); err != nil {
+		return err
+	}
+	if err := os.Rename(j.path, oldPath); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, j.path); err != nil {
+		os.Rename(oldPath, j.path)
+		return err
+	}
+	nw, _, _, err := openTxWAL(j.path)
+	if err != nil {
+		os.Rename(j.path, tmpPath)
+		os.Rename(oldPath, j.path)
+		return err
+	}
+	j.wal.Close()
+	j.wal = nw
+	j.size = len(txs)
+	return os.RemoveAll(oldPath)
+}
+
+// needRotate returns whether the journal holds too many txs which are no longer pending.
+func (j *txJournal) needRotate(pending int) bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.size > pending+maxCacheTxs
+}
+
+func (j *txJournal) close() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.wal.Close()
+}
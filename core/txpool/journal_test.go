@@ -0,0 +1,213 @@
+package txpool
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/golang-lru"
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/event"
+	"github.com/iost-official/go-iost/core/mocks"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTxJournal(t *testing.T) {
+	dir, err := ioutil.TempDir("", "txjournal")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := dir + "/" + txJournalDir
+
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	chain := core_mock.NewMockChain(ctl)
+	bv := core_mock.NewMockBaseVariable(ctl)
+	bv.EXPECT().BlockChain().Return(chain).AnyTimes()
+
+	kp, err := account.NewKeyPair(nil, crypto.Ed25519)
+	assert.Nil(t, err)
+	actions := []*tx.Action{tx.NewAction("contract1", "actionname1", "[]")}
+	newTx := func(expiration int64) *tx.Tx {
+		t := tx.NewTx(actions, nil, 100000, 100, expiration, 0)
+		t, _ = tx.SignTx(t, "alice", []*account.KeyPair{kp})
+		return t
+	}
+	valid := newTx(time.Now().Add(time.Minute).UnixNano())
+	expired := newTx(time.Now().Add(-time.Minute).UnixNano())
+	packed := newTx(time.Now().Add(time.Minute).UnixNano() + 1)
+
+	journal, txs, err := openTxJournal(path)
+	assert.Nil(t, err)
+	assert.Empty(t, txs)
+	for _, tt := range []*tx.Tx{valid, expired, packed} {
+		assert.Nil(t, journal.insert(tt))
+	}
+	assert.Nil(t, journal.close())
+
+	journal, txs, err = openTxJournal(path)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(txs))
+
+	chain.EXPECT().HasTx(packed.Hash()).Return(true, nil)
+	chain.EXPECT().HasTx(gomock.Any()).Return(false, nil).AnyTimes()
+	droppedTx, err := lru.New(maxDroppedTxs)
+	assert.Nil(t, err)
+	pool := &TxPImpl{
+		global:    bv,
+		pendingTx: NewSortedTxMap(),
+		droppedTx: droppedTx,
+		journal:   journal,
+	}
+	pool.loadJournal(txs)
+	assert.Equal(t, 1, pool.pendingTx.Size())
+	assert.NotNil(t, pool.pendingTx.Get(valid.Hash()))
+	assert.Equal(t, 1, journal.size)
+	assert.False(t, journal.needRotate(pool.pendingTx.Size()))
+	assert.Nil(t, journal.close())
+
+	journal, txs, err = openTxJournal(path)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(txs))
+	assert.Equal(t, valid.Hash(), txs[0].Hash())
+	assert.Nil(t, journal.close())
+}
+
+func newTestTxJournal(t *testing.T) (*txJournal, func()) {
+	dir, err := ioutil.TempDir("", "txjournal")
+	assert.Nil(t, err)
+	journal, _, err := openTxJournal(dir + "/" + txJournalDir)
+	assert.Nil(t, err)
+	return journal, func() {
+		journal.close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestTxJournalRemoval(t *testing.T) {
+	dir, err := ioutil.TempDir("", "txjournal")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := dir + "/" + txJournalDir
+
+	actions := []*tx.Action{tx.NewAction("contract1", "actionname1", "[]")}
+	txs := make([]*tx.Tx, 0)
+	for i := 0; i < 3; i++ {
+		txs = append(txs, tx.NewTx(actions, nil, 100000, 100, time.Now().Add(time.Minute).UnixNano()+int64(i), 0))
+	}
+	journal, _, err := openTxJournal(path)
+	assert.Nil(t, err)
+	for _, tt := range txs {
+		assert.Nil(t, journal.insert(tt))
+	}
+	assert.Nil(t, journal.remove(txs[0]))
+	assert.Nil(t, journal.remove(txs[2]))
+	assert.Nil(t, journal.insert(txs[2]))
+	assert.Nil(t, journal.close())
+
+	journal, loaded, err := openTxJournal(path)
+	assert.Nil(t, err)
+	assert.Equal(t, 6, journal.size)
+	assert.Equal(t, 2, len(loaded))
+	assert.Equal(t, txs[1].Hash(), loaded[0].Hash())
+	assert.Equal(t, txs[2].Hash(), loaded[1].Hash())
+	assert.Nil(t, journal.close())
+}
+
+func TestTxJournalRecover(t *testing.T) {
+	dir, err := ioutil.TempDir("", "txjournal")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := dir + "/" + txJournalDir
+
+	actions := []*tx.Action{tx.NewAction("contract1", "actionname1", "[]")}
+	oldTx := tx.NewTx(actions, nil, 100000, 100, time.Now().Add(time.Minute).UnixNano(), 0)
+	newTx := tx.NewTx(actions, nil, 100000, 100, time.Now().Add(time.Minute).UnixNano()+1, 0)
+	journal, _, err := openTxJournal(path)
+	assert.Nil(t, err)
+	assert.Nil(t, journal.insert(oldTx))
+	assert.Nil(t, journal.rotate([]*tx.Tx{newTx}))
+	assert.Nil(t, journal.insert(oldTx))
+	assert.Nil(t, journal.close())
+	_, err = os.Stat(path + ".old")
+	assert.True(t, os.IsNotExist(err))
+
+	// crashed before the old journal is moved away, the new one is discarded
+	assert.Nil(t, os.Mkdir(path+".tmp", 0777))
+	journal, loaded, err := openTxJournal(path)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(loaded))
+	_, err = os.Stat(path + ".tmp")
+	assert.True(t, os.IsNotExist(err))
+
+	// crashed after the old journal is moved away, the new one is moved in
+	tmp, _, err := openTxJournal(path + ".tmp")
+	assert.Nil(t, err)
+	assert.Nil(t, tmp.insert(newTx))
+	assert.Nil(t, tmp.close())
+	assert.Nil(t, journal.close())
+	assert.Nil(t, os.Rename(path, path+".old"))
+	journal, loaded, err = openTxJournal(path)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(loaded))
+	assert.Equal(t, newTx.Hash(), loaded[0].Hash())
+	_, err = os.Stat(path + ".old")
+	assert.True(t, os.IsNotExist(err))
+	assert.Nil(t, journal.close())
+}
+
+func TestRemoveTx(t *testing.T) {
+	dir, err := ioutil.TempDir("", "txjournal")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := dir + "/" + txJournalDir
+
+	journal, _, err := openTxJournal(path)
+	assert.Nil(t, err)
+	dropped, err := lru.New(maxDroppedTxs)
+	assert.Nil(t, err)
+	pool := &TxPImpl{
+		pendingTx: NewSortedTxMap(),
+		droppedTx: dropped,
+		journal:   journal,
+	}
+	actions := []*tx.Action{tx.NewAction("contract1", "actionname1", "[]")}
+	removed := tx.NewTx(actions, nil, 100000, 100, time.Now().Add(time.Minute).UnixNano(), 0)
+	kept := tx.NewTx(actions, nil, 100000, 100, time.Now().Add(time.Minute).UnixNano()+1, 0)
+	for _, tt := range []*tx.Tx{removed, kept} {
+		pool.pendingTx.Add(tt)
+		pool.journalTx(tt)
+	}
+	ec := event.GetCollector()
+	ch := ec.Subscribe(1, []event.Topic{event.TxDropped}, nil)
+	defer ec.Unsubscribe(1, []event.Topic{event.TxDropped})
+	assert.Nil(t, pool.RemoveTx(removed.Hash(), "removed by admin"))
+	assert.Equal(t, ErrTxNotFound, pool.RemoveTx(removed.Hash(), "removed by admin"))
+	assert.Nil(t, pool.pendingTx.Get(removed.Hash()))
+	// the event is only posted by the removal which evicts the tx
+	select {
+	case e := <-ch:
+		assert.Contains(t, e.Data, common.Base58Encode(removed.Hash()))
+	case <-time.After(time.Second):
+		t.Fatal("TxDropped event is not posted")
+	}
+	select {
+	case <-ch:
+		t.Fatal("TxDropped event is posted twice")
+	case <-time.After(100 * time.Millisecond):
+	}
+	v, ok := pool.droppedTx.Get(string(removed.Hash()))
+	assert.True(t, ok)
+	assert.Equal(t, "removed by admin", v.(*droppedTx).reason)
+	assert.Nil(t, journal.close())
+
+	journal, txs, err := openTxJournal(path)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(txs))
+	assert.Equal(t, kept.Hash(), txs[0].Hash())
+	assert.Nil(t, journal.close())
+}
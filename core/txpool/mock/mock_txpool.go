@@ -82,6 +82,16 @@ func (mr *MockTxPoolMockRecorder) DelTxList(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DelTxList", reflect.TypeOf((*MockTxPool)(nil).DelTxList), arg0)
 }
 
+// DropTx mocks base method
+func (m *MockTxPool) DropTx(arg0 *tx.Tx, arg1 string) {
+	m.ctrl.Call(m, "DropTx", arg0, arg1)
+}
+
+// DropTx indicates an expected call of DropTx
+func (mr *MockTxPoolMockRecorder) DropTx(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DropTx", reflect.TypeOf((*MockTxPool)(nil).DropTx), arg0, arg1)
+}
+
 // ExistTxs mocks base method
 func (m *MockTxPool) ExistTxs(arg0 []byte, arg1 *block.Block) txpool.FRet {
 	ret := m.ctrl.Call(m, "ExistTxs", arg0, arg1)
@@ -121,6 +131,19 @@ func (mr *MockTxPoolMockRecorder) GetFromPending(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFromPending", reflect.TypeOf((*MockTxPool)(nil).GetFromPending), arg0)
 }
 
+// GetTxStatus mocks base method
+func (m *MockTxPool) GetTxStatus(arg0 []byte) (txpool.TxStatus, string) {
+	ret := m.ctrl.Call(m, "GetTxStatus", arg0)
+	ret0, _ := ret[0].(txpool.TxStatus)
+	ret1, _ := ret[1].(string)
+	return ret0, ret1
+}
+
+// GetTxStatus indicates an expected call of GetTxStatus
+func (mr *MockTxPoolMockRecorder) GetTxStatus(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxStatus", reflect.TypeOf((*MockTxPool)(nil).GetTxStatus), arg0)
+}
+
 // Lock mocks base method
 func (m *MockTxPool) Lock() {
 	m.ctrl.Call(m, "Lock")
@@ -131,6 +154,18 @@ func (mr *MockTxPoolMockRecorder) Lock() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockTxPool)(nil).Lock))
 }
 
+// PendingNotify mocks base method
+func (m *MockTxPool) PendingNotify() <-chan struct{} {
+	ret := m.ctrl.Call(m, "PendingNotify")
+	ret0, _ := ret[0].(<-chan struct{})
+	return ret0
+}
+
+// PendingNotify indicates an expected call of PendingNotify
+func (mr *MockTxPoolMockRecorder) PendingNotify() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingNotify", reflect.TypeOf((*MockTxPool)(nil).PendingNotify))
+}
+
 // PendingTx mocks base method
 func (m *MockTxPool) PendingTx() (*txpool.SortedTxMap, *blockcache.BlockCacheNode) {
 	ret := m.ctrl.Call(m, "PendingTx")
@@ -154,6 +189,18 @@ func (mr *MockTxPoolMockRecorder) Release() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockTxPool)(nil).Release))
 }
 
+// RemoveTx mocks base method
+func (m *MockTxPool) RemoveTx(arg0 []byte, arg1 string) error {
+	ret := m.ctrl.Call(m, "RemoveTx", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveTx indicates an expected call of RemoveTx
+func (mr *MockTxPoolMockRecorder) RemoveTx(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTx", reflect.TypeOf((*MockTxPool)(nil).RemoveTx), arg0, arg1)
+}
+
 // Start mocks base method
 func (m *MockTxPool) Start() error {
 	ret := m.ctrl.Call(m, "Start")
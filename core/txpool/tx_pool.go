@@ -5,15 +5,19 @@ import (
 	"fmt"
 	"runtime"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/hashicorp/golang-lru"
 	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/core/block"
 	"github.com/iost-official/go-iost/core/blockcache"
+	"github.com/iost-official/go-iost/core/event"
 	"github.com/iost-official/go-iost/core/global"
 	"github.com/iost-official/go-iost/core/tx"
 	"github.com/iost-official/go-iost/ilog"
 	"github.com/iost-official/go-iost/p2p"
+	"github.com/iost-official/go-iost/vm/database"
 )
 
 // TxPImpl defines all the API of txpool package.
@@ -24,15 +28,23 @@ type TxPImpl struct {
 	forkChain        *forkChain
 	blockList        *sync.Map // map[string]*blockTx
 	pendingTx        *SortedTxMap
+	droppedTx        *lru.Cache // map[string]*droppedTx
+	journal          *txJournal
+	limits           atomic.Value // *tx.Limits of the state of the new head
 	mu               sync.RWMutex
 	chP2PTx          chan p2p.IncomingMessage
 	deferServer      *DeferServer
+	pendingCh        chan struct{}
 	quitGenerateMode chan struct{}
 	quitCh           chan struct{}
 }
 
 // NewTxPoolImpl returns a default TxPImpl instance.
 func NewTxPoolImpl(global global.BaseVariable, blockCache blockcache.BlockCache, p2pService p2p.Service) (*TxPImpl, error) {
+	droppedTx, err := lru.New(maxDroppedTxs)
+	if err != nil {
+		return nil, err
+	}
 	p := &TxPImpl{
 		global:           global,
 		blockCache:       blockCache,
@@ -40,20 +52,83 @@ func NewTxPoolImpl(global global.BaseVariable, blockCache blockcache.BlockCache,
 		forkChain:        new(forkChain),
 		blockList:        new(sync.Map),
 		pendingTx:        NewSortedTxMap(),
+		droppedTx:        droppedTx,
 		chP2PTx:          p2pService.Register("txpool message", p2p.PublishTx),
+		pendingCh:        make(chan struct{}, 1),
 		quitGenerateMode: make(chan struct{}),
 		quitCh:           make(chan struct{}),
 	}
 	p.forkChain.SetNewHead(blockCache.Head())
+	p.loadTxLimits()
 	deferServer, err := NewDeferServer(p)
 	if err != nil {
 		return nil, err
 	}
 	p.deferServer = deferServer
+	journal, txs, err := openTxJournal(global.Config().DB.LdbPath + txJournalDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open tx journal: %v", err)
+	}
+	p.journal = journal
+	p.loadJournal(txs)
 	close(p.quitGenerateMode)
 	return p, nil
 }
 
+// loadJournal verifies the journaled txs again and adds them to pending, then rewrites the journal.
+func (pool *TxPImpl) loadJournal(txs []*tx.Tx) {
+	now := common.Now()
+	limits := pool.txLimits()
+	for _, t := range txs {
+		if t.IsExpired(now, limits) || pool.existTxInPending(t.Hash()) {
+			continue
+		}
+		if ok, _ := pool.global.BlockChain().HasTx(t.Hash()); ok {
+			continue
+		}
+		err := pool.verifyTx(t)
+		if err == nil {
+			err = pool.admitTx(t)
+		}
+		if err != nil {
+			ilog.Debugf("Drop journaled tx %v: %v", common.Base58Encode(t.Hash()), err)
+			continue
+		}
+		pool.pendingTx.Add(t)
+	}
+	if len(txs) > 0 {
+		ilog.Infof("Loaded %v txs from journal, %v of them are pending.", len(txs), pool.pendingTx.Size())
+		pool.rotateJournal()
+	}
+}
+
+func (pool *TxPImpl) journalTx(t *tx.Tx) {
+	if err := pool.journal.insert(t); err != nil {
+		ilog.Errorf("Failed to journal tx %v: %v", common.Base58Encode(t.Hash()), err)
+	}
+}
+
+func (pool *TxPImpl) journalRemoval(t *tx.Tx) {
+	if err := pool.journal.remove(t); err != nil {
+		ilog.Errorf("Failed to journal removal of tx %v: %v", common.Base58Encode(t.Hash()), err)
+	}
+}
+
+func (pool *TxPImpl) rotateJournal() {
+	txs := make([]*tx.Tx, 0, pool.pendingTx.Size())
+	iter := pool.pendingTx.Iter()
+	t, ok := iter.Next()
+	for ok {
+		if !t.IsDefer() {
+			txs = append(txs, t)
+		}
+		t, ok = iter.Next()
+	}
+	if err := pool.journal.rotate(txs); err != nil {
+		ilog.Errorf("Failed to rotate tx journal: %v", err)
+	}
+}
+
 // Start starts the jobs.
 func (pool *TxPImpl) Start() error {
 	go pool.deferServer.Start()
@@ -65,10 +140,11 @@ func (pool *TxPImpl) Start() error {
 func (pool *TxPImpl) Stop() {
 	pool.deferServer.Stop()
 	close(pool.quitCh)
+	pool.journal.close()
 }
 
-// AddDefertx adds defer transaction.
-func (pool *TxPImpl) AddDefertx(txHash []byte) error {
+// AddDefertx adds the defer transaction of the delay tx at deferTime.
+func (pool *TxPImpl) AddDefertx(txHash []byte, deferTime int64) error {
 	if pool.pendingTx.Size() > maxCacheTxs {
 		return ErrCacheFull
 	}
@@ -78,8 +154,8 @@ func (pool *TxPImpl) AddDefertx(txHash []byte) error {
 	}
 	t := &tx.Tx{
 		Actions:      referredTx.Actions,
-		Time:         referredTx.Time + referredTx.Delay,
-		Expiration:   referredTx.Expiration + referredTx.Delay,
+		Time:         deferTime,
+		Expiration:   referredTx.Expiration + deferTime - referredTx.Time,
 		GasLimit:     referredTx.GasLimit,
 		GasRatio:     referredTx.GasRatio,
 		Publisher:    referredTx.Publisher,
@@ -88,12 +164,18 @@ func (pool *TxPImpl) AddDefertx(txHash []byte) error {
 		PublishSigns: referredTx.PublishSigns,
 		Signs:        referredTx.Signs,
 		Signers:      referredTx.Signers,
+
+		Sponsor:         referredTx.Sponsor,
+		SponsorGasLimit: referredTx.SponsorGasLimit,
+		SponsorRAMLimit: referredTx.SponsorRAMLimit,
+		SponsorSigns:    referredTx.SponsorSigns,
 	}
 	err = pool.verifyDuplicate(t)
 	if err != nil {
 		return err
 	}
 	pool.pendingTx.Add(t)
+	pool.notifyPending()
 	return nil
 }
 
@@ -120,6 +202,9 @@ func (pool *TxPImpl) loop() {
 			pool.mu.Lock()
 			pool.clearBlock()
 			pool.clearTimeoutTx()
+			if pool.journal.needRotate(pool.pendingTx.Size()) {
+				pool.rotateJournal()
+			}
 			pool.mu.Unlock()
 			metricsTxPoolSize.Set(float64(pool.pendingTx.Size()), nil)
 		case <-pool.quitCh:
@@ -139,6 +224,18 @@ func (pool *TxPImpl) PendingTx() (*SortedTxMap, *blockcache.BlockCacheNode) {
 	return pool.pendingTx, pool.forkChain.NewHead
 }
 
+// PendingNotify returns the channel notified when txs are added to pending.
+func (pool *TxPImpl) PendingNotify() <-chan struct{} {
+	return pool.pendingCh
+}
+
+func (pool *TxPImpl) notifyPending() {
+	select {
+	case pool.pendingCh <- struct{}{}:
+	default:
+	}
+}
+
 // Release release the txpool
 func (pool *TxPImpl) Release() {
 	close(pool.quitGenerateMode)
@@ -163,12 +260,18 @@ func (pool *TxPImpl) verifyWorkers() {
 			continue
 		}
 		ret = pool.verifyTx(&t)
+		if ret == nil {
+			ret = pool.admitTx(&t)
+		}
 		if ret != nil {
 			pool.mu.Unlock()
+			pool.recordDropped(&t, droppedStatus(ret), ret.Error())
 			continue
 		}
 		pool.pendingTx.Add(&t)
+		pool.journalTx(&t)
 		pool.mu.Unlock()
+		pool.notifyPending()
 		metricsReceivedTxCount.Add(1, map[string]string{"from": "p2p"})
 		pool.p2pService.Broadcast(v.Data(), p2p.PublishTx, p2p.NormalMessage)
 	}
@@ -226,10 +329,16 @@ func (pool *TxPImpl) AddTx(t *tx.Tx) error {
 		return err
 	}
 	err = pool.verifyTx(t)
+	if err == nil {
+		err = pool.admitTx(t)
+	}
 	if err != nil {
+		pool.recordDropped(t, droppedStatus(err), err.Error())
 		return err
 	}
 	pool.pendingTx.Add(t)
+	pool.journalTx(t)
+	pool.notifyPending()
 	ilog.Debugf(
 		"Added %v to pendingTx, now size is %v.",
 		common.Base58Encode(t.Hash()),
@@ -250,10 +359,28 @@ func (pool *TxPImpl) DelTx(hash []byte) error {
 // DelTxList deletes the tx list in txpool.
 func (pool *TxPImpl) DelTxList(delList []*tx.Tx) {
 	for _, t := range delList {
-		pool.pendingTx.Del(t.Hash())
+		if pool.existTxInPending(t.Hash()) {
+			pool.pendingTx.Del(t.Hash())
+			pool.journalRemoval(t)
+		}
 	}
 }
 
+// RemoveTx removes the tx from pending and records it as dropped for the reason.
+// Unlike DelTx, the removal is journaled, so the tx won't be loaded again after restart.
+func (pool *TxPImpl) RemoveTx(hash []byte, reason string) error {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	t := pool.pendingTx.Get(hash)
+	if t == nil {
+		return ErrTxNotFound
+	}
+	pool.pendingTx.Del(hash)
+	pool.journalRemoval(t)
+	pool.DropTx(t, reason)
+	return nil
+}
+
 // ExistTxs determine if the transaction exists
 func (pool *TxPImpl) ExistTxs(hash []byte, chainBlock *block.Block) FRet {
 	var r FRet
@@ -269,7 +396,7 @@ func (pool *TxPImpl) ExistTxs(hash []byte, chainBlock *block.Block) FRet {
 }
 
 func (pool *TxPImpl) initBlockTx() {
-	filterLimit := time.Now().UnixNano() - filterTime
+	filterLimit := common.Now() - filterTime
 	for i := pool.global.BlockChain().Length() - 1; i > 0; i-- {
 		blk, err := pool.global.BlockChain().GetBlockByNumber(i)
 		if err != nil {
@@ -283,22 +410,23 @@ func (pool *TxPImpl) initBlockTx() {
 }
 
 func (pool *TxPImpl) verifyTx(t *tx.Tx) error {
-	if pool.pendingTx.Size() > maxCacheTxs {
-		return ErrCacheFull
-	}
-	if err := t.CheckSize(); err != nil {
+	limits := pool.txLimits()
+	if err := t.CheckSize(limits); err != nil {
 		return err
 	}
-	if err := t.CheckGas(); err != nil {
+	if err := t.CheckGas(limits); err != nil {
 		return err
 	}
 	// Add one second delay for tx created time check
-	if !t.IsCreatedBefore(time.Now().UnixNano()+(time.Second).Nanoseconds()) || t.IsExpired(time.Now().UnixNano()) {
+	if !t.IsCreatedBefore(common.Now()+(time.Second).Nanoseconds()) || t.IsExpired(common.Now(), limits) {
 		return fmt.Errorf("TimeError")
 	}
 	if err := t.VerifySelf(); err != nil {
 		return fmt.Errorf("VerifyError %v", err)
 	}
+	if t.Sequence != 0 && t.Sequence <= pool.headVisitor().Sequence(t.Publisher) {
+		return ErrSequenceUsed
+	}
 
 	if t.IsDefer() {
 		referredTx, err := pool.global.BlockChain().GetTx(t.ReferredTx)
@@ -314,6 +442,67 @@ func (pool *TxPImpl) verifyTx(t *tx.Tx) error {
 	return nil
 }
 
+// headVisitor returns the visitor of the state at the head of the longest chain.
+func (pool *TxPImpl) headVisitor() *database.Visitor {
+	stateDB := pool.global.StateDB().Fork()
+	if head := pool.forkChain.GetNewHead(); head != nil && head.Block != nil {
+		stateDB.Checkout(string(head.HeadHash()))
+	}
+	return database.NewVisitor(0, stateDB)
+}
+
+// loadTxLimits loads the tx limits from the state of the new head, which is validated.
+func (pool *TxPImpl) loadTxLimits() {
+	pool.limits.Store(pool.headVisitor().TxLimits())
+}
+
+// txLimits returns the tx limits of the state of the new head.
+func (pool *TxPImpl) txLimits() *tx.Limits {
+	if l, ok := pool.limits.Load().(*tx.Limits); ok {
+		return l
+	}
+	return tx.DefaultLimits()
+}
+
+// admitTx replaces the pending tx with the same replace key if t has a higher gas ratio,
+// checks the pending quota of the publisher, and evicts the txs with lower gas ratio if the pool is full.
+// If the lowest one is sequenced, the highest sequence of its publisher is evicted instead, since the later
+// sequences can't be packed without the earlier ones, evicting from the top leaves no tx stuck behind a gap.
+func (pool *TxPImpl) admitTx(t *tx.Tx) error {
+	if old := pool.pendingTx.GetReplaced(t); old != nil {
+		if t.GasRatio <= old.GasRatio {
+			metricsRejectedTxCount.Add(1, map[string]string{"reason": "underpriced"})
+			return ErrUnderpriced
+		}
+		pool.pendingTx.Del(old.Hash())
+		pool.journalRemoval(old)
+		pool.DropTx(old, "replaced by tx "+common.Base58Encode(t.Hash()))
+		metricsReplacedTxCount.Add(1, nil)
+	}
+	if pool.pendingTx.PublisherSize(t.Publisher) >= maxPublisherTxs {
+		metricsRejectedTxCount.Add(1, map[string]string{"reason": "publisher_quota"})
+		return ErrPublisherQuota
+	}
+	for pool.pendingTx.Size() >= maxCacheTxs {
+		lowest := pool.pendingTx.Lowest()
+		if lowest == nil || t.GasRatio <= lowest.GasRatio {
+			metricsRejectedTxCount.Add(1, map[string]string{"reason": "pool_full"})
+			return ErrCacheFull
+		}
+		evicted := lowest
+		if lowest.Sequence != 0 {
+			if highest := pool.pendingTx.HighestSequence(lowest.Publisher); highest != nil {
+				evicted = highest
+			}
+		}
+		pool.pendingTx.Del(evicted.Hash())
+		pool.journalRemoval(evicted)
+		pool.dropTx(evicted, TxStatusDropped, "evicted by tx with higher gas ratio")
+		metricsEvictedTxCount.Add(1, nil)
+	}
+	return nil
+}
+
 func (pool *TxPImpl) addBlock(blk *block.Block) error {
 	if blk == nil {
 		return errors.New("failed to linkedBlock")
@@ -366,6 +555,26 @@ func (pool *TxPImpl) existTxInChain(txHash []byte, block *block.Block) bool {
 	return t != nil
 }
 
+// existReplacedInChain returns whether a replacement of t with a different hash has been packed in the chain,
+// in which case t must not be packed, or both of them would be executed.
+func (pool *TxPImpl) existReplacedInChain(t *tx.Tx, block *block.Block) bool {
+	if block == nil {
+		return false
+	}
+	blkHash := block.HeadHash()
+	filterLimit := block.Head.Time - filterTime
+	for {
+		b, ok := pool.findBlock(blkHash)
+		if !ok || b.time < filterLimit {
+			return false
+		}
+		if b.getReplaced(t) != nil {
+			return true
+		}
+		blkHash = b.ParentHash
+	}
+}
+
 func (pool *TxPImpl) getTxAndReceiptInBlock(txHash []byte, blockHash []byte) (*tx.Tx, *tx.TxReceipt) {
 	b, ok := pool.blockList.Load(string(blockHash))
 	if !ok {
@@ -391,6 +600,9 @@ func (pool *TxPImpl) verifyDuplicate(t *tx.Tx) error {
 	if pool.existTxInChain(t.Hash(), pool.forkChain.GetNewHead().Block) {
 		return ErrDupChainTx
 	}
+	if pool.existReplacedInChain(t, pool.forkChain.GetNewHead().Block) {
+		return ErrReplacedTx
+	}
 	return nil
 }
 
@@ -399,11 +611,13 @@ func (pool *TxPImpl) existTxInPending(hash []byte) bool {
 }
 
 func (pool *TxPImpl) clearTimeoutTx() {
+	limits := pool.txLimits()
 	iter := pool.pendingTx.Iter()
 	t, ok := iter.Next()
 	for ok {
-		if t.IsExpired(time.Now().UnixNano()) && !t.IsDefer() {
+		if t.IsExpired(common.Now(), limits) && !t.IsDefer() {
 			pool.pendingTx.Del(t.Hash())
+			pool.DropTx(t, "expired")
 		}
 		t, ok = iter.Next()
 	}
@@ -415,15 +629,56 @@ func (pool *TxPImpl) updateForkChain(newHead *blockcache.BlockCacheNode) tFork {
 	}
 	pool.forkChain.SetOldHead(pool.forkChain.GetNewHead())
 	pool.forkChain.SetNewHead(newHead)
+	pool.loadTxLimits()
 	bcn, ok := pool.findForkBCN(pool.forkChain.GetNewHead(), pool.forkChain.GetOldHead())
 	if ok {
 		pool.forkChain.SetForkBCN(bcn)
+		if bcn != pool.forkChain.GetOldHead() {
+			postChainReorg(pool.forkChain.GetOldHead(), newHead, bcn)
+		}
 		return forkBCN
 	}
 	pool.forkChain.SetForkBCN(nil)
+	postChainReorg(pool.forkChain.GetOldHead(), newHead, nil)
 	return noForkBCN
 }
 
+// postChainReorg posts the ChainReorg event when the new head is not a descendant of the old head.
+func postChainReorg(oldHead *blockcache.BlockCacheNode, newHead *blockcache.BlockCacheNode, fork *blockcache.BlockCacheNode) {
+	data := &event.ReorgData{
+		NewHead: blockcache.BlockEventData(newHead.Block),
+	}
+	if oldHead != nil && oldHead.Block != nil {
+		data.OldHead = blockcache.BlockEventData(oldHead.Block)
+	}
+	if fork != nil && fork.Block != nil {
+		data.Fork = blockcache.BlockEventData(fork.Block)
+	}
+	event.GetCollector().Post(event.NewDataEvent(event.ChainReorg, data), nil)
+}
+
+// DropTx records the tx removed from pending pool without being packed and posts the TxDropped event.
+func (pool *TxPImpl) DropTx(t *tx.Tx, reason string) {
+	pool.dropTx(t, TxStatusRejected, reason)
+}
+
+func (pool *TxPImpl) dropTx(t *tx.Tx, status TxStatus, reason string) {
+	if t.IsExpired(common.Now(), pool.txLimits()) {
+		status = TxStatusExpired
+	}
+	pool.recordDropped(t, status, reason)
+	data := &event.TxDroppedData{
+		Hash:   common.Base58Encode(t.Hash()),
+		Reason: reason,
+	}
+	event.GetCollector().Post(event.NewDataEvent(event.TxDropped, data),
+		&event.Meta{Publisher: t.Publisher, TxHash: data.Hash})
+}
+
+func (pool *TxPImpl) recordDropped(t *tx.Tx, status TxStatus, reason string) {
+	pool.droppedTx.Add(string(t.Hash()), &droppedTx{status: status, reason: reason})
+}
+
 func (pool *TxPImpl) findForkBCN(newHead *blockcache.BlockCacheNode, oldHead *blockcache.BlockCacheNode) (*blockcache.BlockCacheNode, bool) {
 	for {
 		for oldHead != nil && oldHead.Head.Number > newHead.Head.Number {
@@ -447,7 +702,7 @@ func (pool *TxPImpl) doChainChangeByForkBCN() {
 	oldHead := pool.forkChain.GetOldHead()
 	forkBCN := pool.forkChain.GetForkBCN()
 	//add txs
-	filterLimit := time.Now().UnixNano() - filterTime
+	filterLimit := common.Now() - filterTime
 	for {
 		if oldHead == nil || oldHead == forkBCN || oldHead.Block.Head.Time < filterLimit {
 			break
@@ -473,7 +728,7 @@ func (pool *TxPImpl) doChainChangeByForkBCN() {
 func (pool *TxPImpl) doChainChangeByTimeout() {
 	newHead := pool.forkChain.GetNewHead()
 	oldHead := pool.forkChain.GetOldHead()
-	filterLimit := time.Now().UnixNano() - filterTime
+	filterLimit := common.Now() - filterTime
 	ob, ok := pool.findBlock(oldHead.Block.HeadHash())
 	if ok {
 		for {
@@ -517,6 +772,24 @@ func (pool *TxPImpl) GetFromPending(hash []byte) (*tx.Tx, error) {
 	return tx, nil
 }
 
+// GetTxStatus returns the status of tx and the reason if it's dropped.
+func (pool *TxPImpl) GetTxStatus(hash []byte) (TxStatus, string) {
+	if pool.existTxInPending(hash) {
+		return TxStatusPending, ""
+	}
+	irreversible, _ := pool.global.BlockChain().HasTx(hash)
+	if irreversible {
+		return TxStatusIrreversible, ""
+	}
+	if pool.existTxInChain(hash, pool.forkChain.GetNewHead().Block) {
+		return TxStatusPacked, ""
+	}
+	if v, ok := pool.droppedTx.Get(string(hash)); ok {
+		return v.(*droppedTx).status, v.(*droppedTx).reason
+	}
+	return TxStatusUnknown, ""
+}
+
 // GetFromChain gets transaction from longest chain.
 func (pool *TxPImpl) GetFromChain(hash []byte) (*tx.Tx, *tx.TxReceipt, error) {
 	t, tr := pool.getTxAndReceiptInChain(hash, pool.forkChain.GetNewHead().Block)
@@ -138,17 +138,17 @@ func TestNewTxPImpl(t *testing.T) {
 
 			t := genTx(accountList[0], tx.MaxExpiration)
 
-			b := t.IsExpired(time.Now().UnixNano())
+			b := t.IsExpired(time.Now().UnixNano(), tx.DefaultLimits())
 			So(b, ShouldBeFalse)
 
 			t.Time -= int64(tx.MaxExpiration)
-			b = t.IsExpired(time.Now().UnixNano())
+			b = t.IsExpired(time.Now().UnixNano(), tx.DefaultLimits())
 			So(b, ShouldBeTrue)
 
 			t = genTx(accountList[0], tx.MaxExpiration)
 
 			t.Expiration -= int64(tx.MaxExpiration * 3)
-			b = t.IsExpired(time.Now().UnixNano())
+			b = t.IsExpired(time.Now().UnixNano(), tx.DefaultLimits())
 			So(b, ShouldBeTrue)
 		})
 		Convey("delTimeOutTx", func() {
@@ -0,0 +1,64 @@
+package txpool
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/golang-lru"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/blockcache"
+	"github.com/iost-official/go-iost/core/mocks"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTxStatus(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	chain := core_mock.NewMockChain(ctl)
+	bv := core_mock.NewMockBaseVariable(ctl)
+	bv.EXPECT().BlockChain().Return(chain).AnyTimes()
+
+	droppedTx, err := lru.New(maxDroppedTxs)
+	assert.Nil(t, err)
+	head := &blockcache.BlockCacheNode{Block: &block.Block{Head: &block.BlockHead{Time: time.Now().UnixNano()}}}
+	pool := &TxPImpl{
+		global:    bv,
+		forkChain: new(forkChain),
+		blockList: new(sync.Map),
+		pendingTx: NewSortedTxMap(),
+		droppedTx: droppedTx,
+	}
+	pool.forkChain.SetNewHead(head)
+
+	actions := []*tx.Action{tx.NewAction("contract1", "actionname1", "[]")}
+	pending := tx.NewTx(actions, nil, 100000, 100, time.Now().Add(time.Minute).UnixNano(), 0)
+	pool.pendingTx.Add(pending)
+	status, _ := pool.GetTxStatus(pending.Hash())
+	assert.Equal(t, TxStatusPending, status)
+
+	irreversible := tx.NewTx(actions, nil, 100000, 100, time.Now().Add(time.Minute).UnixNano()+1, 0)
+	chain.EXPECT().HasTx(irreversible.Hash()).Return(true, nil)
+	status, _ = pool.GetTxStatus(irreversible.Hash())
+	assert.Equal(t, TxStatusIrreversible, status)
+
+	chain.EXPECT().HasTx(gomock.Any()).Return(false, nil).AnyTimes()
+	expired := tx.NewTx(actions, nil, 100000, 100, 0, 0)
+	pool.DropTx(expired, "expired")
+	status, reason := pool.GetTxStatus(expired.Hash())
+	assert.Equal(t, TxStatusExpired, status)
+	assert.Equal(t, "expired", reason)
+
+	rejected := tx.NewTx(actions, nil, 100000, 100, time.Now().Add(time.Minute).UnixNano()+2, 0)
+	pool.DropTx(rejected, "gas run out")
+	status, reason = pool.GetTxStatus(rejected.Hash())
+	assert.Equal(t, TxStatusRejected, status)
+	assert.Equal(t, "gas run out", reason)
+	assert.True(t, status.IsFinal())
+
+	status, _ = pool.GetTxStatus([]byte("unknown"))
+	assert.Equal(t, TxStatusUnknown, status)
+	assert.False(t, status.IsFinal())
+}
@@ -15,17 +15,26 @@ import (
 
 // Values.
 var (
-	clearInterval = 10 * time.Second
-	filterTime    = int64(90 * time.Second)
-	maxCacheTxs   = 10000
+	clearInterval   = 10 * time.Second
+	filterTime      = tx.MaxExpiration
+	maxCacheTxs     = 10000
+	maxPublisherTxs = 1000
+	maxDroppedTxs   = 10000
 
 	metricsReceivedTxCount = metrics.NewCounter("iost_tx_received_count", []string{"from"})
 	metricsTxPoolSize      = metrics.NewGauge("iost_txpool_size", nil)
-
-	ErrDupPendingTx = errors.New("tx exists in pending")
-	ErrDupChainTx   = errors.New("tx exists in chain")
-	ErrCacheFull    = errors.New("txpool is full")
-	ErrTxNotFound   = errors.New("tx not found")
+	metricsEvictedTxCount  = metrics.NewCounter("iost_txpool_evicted_tx_count", nil)
+	metricsReplacedTxCount = metrics.NewCounter("iost_txpool_replaced_tx_count", nil)
+	metricsRejectedTxCount = metrics.NewCounter("iost_txpool_rejected_tx_count", []string{"reason"})
+
+	ErrDupPendingTx   = errors.New("tx exists in pending")
+	ErrDupChainTx     = errors.New("tx exists in chain")
+	ErrReplacedTx     = errors.New("tx has been replaced by a tx in chain")
+	ErrCacheFull      = errors.New("txpool is full")
+	ErrTxNotFound     = errors.New("tx not found")
+	ErrPublisherQuota = errors.New("publisher has too many pending txs")
+	ErrUnderpriced    = errors.New("replacement tx should have a higher gas ratio")
+	ErrSequenceUsed   = errors.New("sequence of publisher is used")
 )
 
 // FRet find the return value of the tx
@@ -40,6 +49,58 @@ const (
 	FoundChain
 )
 
+// TxStatus is the status of tx in its lifecycle.
+type TxStatus uint
+
+// tx status
+const (
+	TxStatusUnknown TxStatus = iota
+	TxStatusPending
+	TxStatusPacked
+	TxStatusIrreversible
+	TxStatusExpired
+	TxStatusRejected
+	TxStatusDropped
+)
+
+func (s TxStatus) String() string {
+	switch s {
+	case TxStatusPending:
+		return "pending"
+	case TxStatusPacked:
+		return "packed"
+	case TxStatusIrreversible:
+		return "irreversible"
+	case TxStatusExpired:
+		return "expired"
+	case TxStatusRejected:
+		return "rejected"
+	case TxStatusDropped:
+		return "dropped"
+	default:
+		return "unknown"
+	}
+}
+
+// IsFinal returns whether the status won't change any more.
+// A tx dropped for lack of room isn't final, since it may be accepted if it's received again.
+func (s TxStatus) IsFinal() bool {
+	return s == TxStatusIrreversible || s == TxStatusExpired || s == TxStatusRejected
+}
+
+// droppedStatus returns the status of the tx which fails to be added to the pool with err.
+func droppedStatus(err error) TxStatus {
+	if err == ErrCacheFull || err == ErrPublisherQuota {
+		return TxStatusDropped
+	}
+	return TxStatusRejected
+}
+
+type droppedTx struct {
+	status TxStatus
+	reason string
+}
+
 // tFork ...
 type tFork uint
 
@@ -102,6 +163,7 @@ func (f *forkChain) SetForkBCN(n *blockcache.BlockCacheNode) {
 type blockTx struct {
 	txMap        *sync.Map // map[string]*tx.Tx
 	txReceiptMap *sync.Map // map[string]*tx.TxReceipt
+	replaceMap   *sync.Map // map[string]*tx.Tx
 	ParentHash   []byte
 	time         int64
 }
@@ -110,11 +172,15 @@ func newBlockTx(blk *block.Block) *blockTx {
 	b := &blockTx{
 		txMap:        new(sync.Map),
 		txReceiptMap: new(sync.Map),
+		replaceMap:   new(sync.Map),
 		ParentHash:   blk.Head.ParentHash,
 		time:         blk.Head.Time,
 	}
 	for _, v := range blk.Txs {
 		b.txMap.Store(string(v.Hash()), v)
+		if isReplaceable(v) {
+			b.replaceMap.Store(replaceKey(v), v)
+		}
 	}
 	for _, v := range blk.Receipts {
 		b.txReceiptMap.Store(string(v.TxHash), v)
@@ -135,11 +201,28 @@ func (b *blockTx) getTxAndReceipt(hash []byte) (*tx.Tx, *tx.TxReceipt) {
 	return retTx, nil
 }
 
+func (b *blockTx) getReplaced(t *tx.Tx) *tx.Tx {
+	if !isReplaceable(t) {
+		return nil
+	}
+	old, exist := b.replaceMap.Load(replaceKey(t))
+	if !exist || bytes.Equal(old.(*tx.Tx).Hash(), t.Hash()) {
+		return nil
+	}
+	return old.(*tx.Tx)
+}
+
+// sequenceMap maps the sequences of a publisher to its pending txs.
+type sequenceMap map[int64]*tx.Tx
+
 // SortedTxMap is a red black tree of tx.
 type SortedTxMap struct {
-	tree  *redblacktree.Tree
-	txMap map[string]*tx.Tx
-	rw    *sync.RWMutex
+	tree           *redblacktree.Tree
+	txMap          map[string]*tx.Tx
+	publisherCount map[string]int
+	replaceMap     map[string]*tx.Tx
+	sequences      map[string]sequenceMap
+	rw             *sync.RWMutex
 }
 
 func compareTx(a, b interface{}) int {
@@ -157,9 +240,12 @@ func compareTx(a, b interface{}) int {
 // NewSortedTxMap returns a new SortedTxMap instance.
 func NewSortedTxMap() *SortedTxMap {
 	return &SortedTxMap{
-		tree:  redblacktree.NewWith(compareTx),
-		txMap: make(map[string]*tx.Tx),
-		rw:    new(sync.RWMutex),
+		tree:           redblacktree.NewWith(compareTx),
+		txMap:          make(map[string]*tx.Tx),
+		publisherCount: make(map[string]int),
+		replaceMap:     make(map[string]*tx.Tx),
+		sequences:      make(map[string]sequenceMap),
+		rw:             new(sync.RWMutex),
 	}
 }
 
@@ -174,7 +260,21 @@ func (st *SortedTxMap) Get(hash []byte) *tx.Tx {
 func (st *SortedTxMap) Add(tx *tx.Tx) {
 	st.rw.Lock()
 	st.tree.Put(tx, true)
+	if _, ok := st.txMap[string(tx.Hash())]; !ok {
+		st.publisherCount[tx.Publisher]++
+	}
 	st.txMap[string(tx.Hash())] = tx
+	if isReplaceable(tx) {
+		st.replaceMap[replaceKey(tx)] = tx
+	}
+	if tx.Sequence != 0 && !tx.IsDefer() {
+		seqs := st.sequences[tx.Publisher]
+		if seqs == nil {
+			seqs = make(sequenceMap)
+			st.sequences[tx.Publisher] = seqs
+		}
+		seqs[tx.Sequence] = tx
+	}
 	st.rw.Unlock()
 }
 
@@ -189,6 +289,47 @@ func (st *SortedTxMap) Del(hash []byte) {
 	}
 	st.tree.Remove(tx)
 	delete(st.txMap, string(hash))
+	st.publisherCount[tx.Publisher]--
+	if st.publisherCount[tx.Publisher] <= 0 {
+		delete(st.publisherCount, tx.Publisher)
+	}
+	if seqs := st.sequences[tx.Publisher]; seqs != nil && seqs[tx.Sequence] == tx {
+		delete(seqs, tx.Sequence)
+		if len(seqs) == 0 {
+			delete(st.sequences, tx.Publisher)
+		}
+	}
+	if !isReplaceable(tx) {
+		return
+	}
+	if key := replaceKey(tx); st.replaceMap[key] == tx {
+		delete(st.replaceMap, key)
+	}
+}
+
+// isReplaceable returns whether tx can be replaced. A defer tx can't be, since it's generated by the chain.
+func isReplaceable(t *tx.Tx) bool {
+	return !t.IsDefer()
+}
+
+// replaceKey returns the key of tx, the txs with the same key are replacements of each other.
+func replaceKey(t *tx.Tx) string {
+	return t.ReplaceKey()
+}
+
+// GetReplaced returns the tx which has the same replace key with t but a different hash.
+func (st *SortedTxMap) GetReplaced(t *tx.Tx) *tx.Tx {
+	if !isReplaceable(t) {
+		return nil
+	}
+	st.rw.RLock()
+	defer st.rw.RUnlock()
+
+	old := st.replaceMap[replaceKey(t)]
+	if old == nil || bytes.Equal(old.Hash(), t.Hash()) {
+		return nil
+	}
+	return old
 }
 
 // Size returns the size of SortedTxMap.
@@ -199,6 +340,55 @@ func (st *SortedTxMap) Size() int {
 	return len(st.txMap)
 }
 
+// PublisherSize returns the number of txs of the publisher in SortedTxMap.
+func (st *SortedTxMap) PublisherSize(publisher string) int {
+	st.rw.RLock()
+	defer st.rw.RUnlock()
+
+	return st.publisherCount[publisher]
+}
+
+// PublisherCounts returns the number of txs of each publisher in SortedTxMap.
+func (st *SortedTxMap) PublisherCounts() map[string]int {
+	st.rw.RLock()
+	defer st.rw.RUnlock()
+
+	counts := make(map[string]int, len(st.publisherCount))
+	for publisher, count := range st.publisherCount {
+		counts[publisher] = count
+	}
+	return counts
+}
+
+// Lowest returns the non-defer tx with the lowest gas ratio, and the newest one among the same gas ratio.
+func (st *SortedTxMap) Lowest() *tx.Tx {
+	st.rw.RLock()
+	defer st.rw.RUnlock()
+
+	iter := st.tree.Iterator()
+	for iter.Next() {
+		t := iter.Key().(*tx.Tx)
+		if !t.IsDefer() {
+			return t
+		}
+	}
+	return nil
+}
+
+// HighestSequence returns the sequenced tx of the publisher with the highest sequence.
+func (st *SortedTxMap) HighestSequence(publisher string) *tx.Tx {
+	st.rw.RLock()
+	defer st.rw.RUnlock()
+
+	var highest *tx.Tx
+	for _, t := range st.sequences[publisher] {
+		if highest == nil || t.Sequence > highest.Sequence {
+			highest = t
+		}
+	}
+	return highest
+}
+
 // Iter returns the iterator of SortedTxMap.
 func (st *SortedTxMap) Iter() *Iterator {
 	iter := st.tree.Iterator()
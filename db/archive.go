@@ -0,0 +1,335 @@
+package db
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/db/kv"
+)
+
+// In archive mode, every flush of the mvccdb is numbered and all values it writes are also saved
+// under a versioned key, so the state of any flushed tag can be read afterwards.
+// The trie nodes are not versioned since they are addressed by their hash, and the replaced ones aren't deleted.
+//
+// Archiving may be enabled on an existing storage, or be disabled and enabled again. The flushes since
+// archiving was (re-)enabled last time make up the archive period, only tags flushed in the period can be read.
+// When a key is written the first time in the period, its value before is saved under the sequence
+// before the period, and a key never written in the period reads its current value.
+
+// constant of archive
+const (
+	archivePrefix     = "archive" + string(SEPARATOR)
+	archiveTagPrefix  = "archivetag" + string(SEPARATOR)
+	archiveBasePrefix = "archivebase" + string(SEPARATOR)
+	archiveSeqKey     = "archiveseq"
+	archiveFirstKey   = "archivefirst"
+	archiveLastKey    = "archivelast"
+
+	archiveDeleted byte = 0
+	archiveValue   byte = 1
+)
+
+// error of archive
+var (
+	ErrArchiveDisabled = fmt.Errorf("archive mode is disabled")
+	ErrTagNotArchived  = fmt.Errorf("tag is not archived")
+	ErrReadOnly        = fmt.Errorf("mvccdb is read only")
+)
+
+// NewArchiveMVCCDB return new mvccdb which retains the state of every flushed tag
+func NewArchiveMVCCDB(path string) (MVCCDB, error) {
+	mvccdb, err := NewMVCCDB(path)
+	if err != nil {
+		return nil, err
+	}
+	mvccdb.(*CacheMVCCDB).archive = true
+	return mvccdb, nil
+}
+
+// archiveKey returns the key of the value of k saved at the sequence. The sign bit of the sequence is flipped,
+// so the versions of a key sort by sequence, including the one saved before the period starting at 0.
+func archiveKey(k string, seq int64) []byte {
+	return append([]byte(string(SEPARATOR)+archivePrefix+k+string(SEPARATOR)), common.Int64ToBytes(seq^math.MinInt64)...)
+}
+
+// archiveSeq returns the sequence of the next flush and the first sequence of the archive period.
+// A new period starts if the last flush isn't archived, and one sequence is skipped,
+// so the values saved before the new period don't collide with the values of the old one.
+func (m *CacheMVCCDB) archiveSeq() (int64, int64, error) {
+	seqBytes, err := m.storage.Get([]byte(string(SEPARATOR) + archiveSeqKey))
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(seqBytes) == 0 {
+		return 0, 0, nil
+	}
+	seq := common.BytesToInt64(seqBytes) + 1
+	lastTag, err := m.storage.Get([]byte(string(SEPARATOR) + "tag"))
+	if err != nil {
+		return 0, 0, err
+	}
+	archivedTag, err := m.storage.Get([]byte(string(SEPARATOR) + archiveLastKey))
+	if err != nil {
+		return 0, 0, err
+	}
+	first, err := m.storage.Get([]byte(string(SEPARATOR) + archiveFirstKey))
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(first) == 0 || string(lastTag) != string(archivedTag) {
+		return seq + 1, seq + 1, nil
+	}
+	return seq, common.BytesToInt64(first), nil
+}
+
+// archiveBase saves the value of the key before the archive period, if it's not saved yet.
+// It reads the storage before the batch of flush is committed.
+func (m *CacheMVCCDB) archiveBase(k string, first int64) error {
+	baseKey := []byte(string(SEPARATOR) + archiveBasePrefix + k)
+	based, err := m.storage.Get(baseKey)
+	if err != nil {
+		return err
+	}
+	if len(based) != 0 && common.BytesToInt64(based) == first {
+		return nil
+	}
+	v, err := m.storage.Get([]byte(k))
+	if err != nil {
+		return err
+	}
+	value := []byte{archiveDeleted}
+	if len(v) != 0 {
+		value = append([]byte{archiveValue}, v...)
+	}
+	if err := m.storage.Put(archiveKey(k, first-1), value); err != nil {
+		return err
+	}
+	return m.storage.Put(baseKey, common.Int64ToBytes(first))
+}
+
+// archiveItems saves the items under the next flush sequence of the tag, it must be called in the batch of flush.
+func (m *CacheMVCCDB) archiveItems(t string, items []interface{}) error {
+	seq, first, err := m.archiveSeq()
+	if err != nil {
+		return err
+	}
+	for _, v := range items {
+		item, ok := v.(*Item)
+		if !ok {
+			return fmt.Errorf("can't assert Item type")
+		}
+		if isStateNodeItem(item) {
+			continue
+		}
+		k := item.table + string(SEPARATOR) + item.key
+		if err := m.archiveBase(k, first); err != nil {
+			return err
+		}
+		value := []byte{archiveValue}
+		if item.deleted {
+			value = []byte{archiveDeleted}
+		} else {
+			value = append(value, item.value...)
+		}
+		if err := m.storage.Put(archiveKey(k, seq), value); err != nil {
+			return err
+		}
+	}
+	if err := m.storage.Put([]byte(string(SEPARATOR)+archiveTagPrefix+t), common.Int64ToBytes(seq)); err != nil {
+		return err
+	}
+	if err := m.storage.Put([]byte(string(SEPARATOR)+archiveFirstKey), common.Int64ToBytes(first)); err != nil {
+		return err
+	}
+	if err := m.storage.Put([]byte(string(SEPARATOR)+archiveLastKey), []byte(t)); err != nil {
+		return err
+	}
+	return m.storage.Put([]byte(string(SEPARATOR)+archiveSeqKey), common.Int64ToBytes(seq))
+}
+
+// Historical returns a read only mvccdb of the state at the flushed tag
+func (m *CacheMVCCDB) Historical(t string) (MVCCDB, error) {
+	if !m.archive {
+		return nil, ErrArchiveDisabled
+	}
+	return newHistoricalMVCCDB(m.storage, t)
+}
+
+// HistoricalMVCCDB is the read only mvccdb of an archived tag
+type HistoricalMVCCDB struct {
+	tag     string
+	seq     int64
+	first   int64
+	storage *kv.Storage
+}
+
+func newHistoricalMVCCDB(storage *kv.Storage, t string) (*HistoricalMVCCDB, error) {
+	seq, err := storage.Get([]byte(string(SEPARATOR) + archiveTagPrefix + t))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get from storage: %v", err)
+	}
+	if len(seq) == 0 {
+		return nil, ErrTagNotArchived
+	}
+	first, err := storage.Get([]byte(string(SEPARATOR) + archiveFirstKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get from storage: %v", err)
+	}
+	if len(first) == 0 || common.BytesToInt64(seq) < common.BytesToInt64(first) {
+		return nil, ErrTagNotArchived
+	}
+	return &HistoricalMVCCDB{
+		tag:     t,
+		seq:     common.BytesToInt64(seq),
+		first:   common.BytesToInt64(first),
+		storage: storage,
+	}, nil
+}
+
+// get returns the newest value of the key saved in the archive period at or before the sequence of the tag,
+// or the current value if the key isn't written in the archive period.
+// A key written in the period always has its value before the period saved, so it's found by one step back
+// from the sequence of the tag, skipping only the versions of the longer keys sharing the prefix.
+func (m *HistoricalMVCCDB) get(k string) ([]byte, bool, error) {
+	prefix := []byte(string(SEPARATOR) + archivePrefix + k + string(SEPARATOR))
+	iter := m.storage.NewIteratorByRange(archiveKey(k, m.first-1), archiveKey(k, m.seq+1))
+	defer iter.Release()
+	for ok := iter.Last(); ok; ok = iter.Prev() {
+		if len(iter.Key()) != len(prefix)+8 {
+			continue
+		}
+		v := iter.Value()
+		if len(v) == 0 {
+			return nil, false, fmt.Errorf("archived value is not valid")
+		}
+		return append([]byte{}, v[1:]...), v[0] == archiveValue, nil
+	}
+	if err := iter.Error(); err != nil {
+		return nil, false, fmt.Errorf("failed to iterate storage: %v", err)
+	}
+	v, err := m.storage.Get([]byte(k))
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get from storage: %v", err)
+	}
+	return v, len(v) != 0, nil
+}
+
+// Get returns the value of specify key and table
+func (m *HistoricalMVCCDB) Get(table string, key string) (string, error) {
+	if !isValidTable(table) {
+		return "", ErrTableNotValid
+	}
+	v, _, err := m.get(table + string(SEPARATOR) + key)
+	if err != nil {
+		return "", err
+	}
+	return string(v), nil
+}
+
+// Put returns ErrReadOnly
+func (m *HistoricalMVCCDB) Put(table string, key string, value string) error {
+	return ErrReadOnly
+}
+
+// Del returns ErrReadOnly
+func (m *HistoricalMVCCDB) Del(table string, key string) error {
+	return ErrReadOnly
+}
+
+// Has returns whether the specified key exists in the table
+func (m *HistoricalMVCCDB) Has(table string, key string) (bool, error) {
+	if !isValidTable(table) {
+		return false, ErrTableNotValid
+	}
+	_, found, err := m.get(table + string(SEPARATOR) + key)
+	return found, err
+}
+
+// Keys returns the list of key prefixed with prefix in the table
+func (m *HistoricalMVCCDB) Keys(table string, prefix string) ([]string, error) {
+	return nil, nil
+}
+
+// Commit does nothing
+func (m *HistoricalMVCCDB) Commit() error {
+	return nil
+}
+
+// Rollback does nothing
+func (m *HistoricalMVCCDB) Rollback() {}
+
+// Checkout returns whether the tag is the tag of this mvccdb
+func (m *HistoricalMVCCDB) Checkout(t string) bool {
+	return t == m.tag
+}
+
+// Tag does nothing
+func (m *HistoricalMVCCDB) Tag(t string) error {
+	return nil
+}
+
+// CurrentTag returns the archived tag
+func (m *HistoricalMVCCDB) CurrentTag() string {
+	return m.tag
+}
+
+// Fork returns itself since it's read only
+func (m *HistoricalMVCCDB) Fork() MVCCDB {
+	return m
+}
+
+// Flush returns ErrReadOnly
+func (m *HistoricalMVCCDB) Flush(t string) error {
+	return ErrReadOnly
+}
+
+// Close does nothing, the storage is closed by the mvccdb it comes from
+func (m *HistoricalMVCCDB) Close() error {
+	return nil
+}
+
+// StateRoot returns the state root at the archived tag
+func (m *HistoricalMVCCDB) StateRoot() ([]byte, error) {
+	root, _, err := m.get(string(SEPARATOR) + stateRootKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(root) == 0 {
+		return nil, ErrStateRootNotFound
+	}
+	return root, nil
+}
+
+// Prove returns the merkle proof of the key in the state trie at the archived tag
+func (m *HistoricalMVCCDB) Prove(table string, key string) (*StateProof, error) {
+	if !isValidTable(table) {
+		return nil, ErrTableNotValid
+	}
+	root, err := m.StateRoot()
+	if err != nil {
+		return nil, err
+	}
+	t := &stateTrie{store: m}
+	return t.Prove(root, stateKeyHash(table, key))
+}
+
+func (m *HistoricalMVCCDB) getStateNode(hash []byte) ([]byte, error) {
+	data, err := m.storage.Get([]byte(string(SEPARATOR) + stateNodePrefix + string(hash)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get from storage: %v", err)
+	}
+	if len(data) == 0 {
+		return nil, ErrStateNodeNotFound
+	}
+	return data, nil
+}
+
+func (m *HistoricalMVCCDB) putStateNode(hash []byte, data []byte) {}
+
+func (m *HistoricalMVCCDB) delStateNode(hash []byte) {}
+
+// Historical returns the mvccdb of another archived tag
+func (m *HistoricalMVCCDB) Historical(t string) (MVCCDB, error) {
+	return newHistoricalMVCCDB(m.storage, t)
+}
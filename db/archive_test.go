@@ -0,0 +1,208 @@
+package db
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistoricalMVCCDB(t *testing.T) {
+	os.RemoveAll("archive_test")
+	defer os.RemoveAll("archive_test")
+	mvccdb, err := NewArchiveMVCCDB("archive_test")
+	assert.Nil(t, err)
+	defer mvccdb.Close()
+
+	mvccdb.Put("table01", "key01", "value01")
+	mvccdb.Put("table01", "key02", "value02")
+	mvccdb.Put("table01", "key0", "value0")
+	rootA, err := mvccdb.StateRoot()
+	assert.Nil(t, err)
+	mvccdb.Tag("a")
+	assert.Nil(t, mvccdb.Flush("a"))
+
+	mvccdb.Put("table01", "key01", "value11")
+	mvccdb.Del("table01", "key02")
+	mvccdb.Tag("b")
+	mvccdb.Put("table01", "key03", "value03")
+	rootC, err := mvccdb.StateRoot()
+	assert.Nil(t, err)
+	mvccdb.Tag("c")
+	assert.Nil(t, mvccdb.Flush("c"))
+
+	_, err = mvccdb.Historical("b")
+	assert.Equal(t, ErrTagNotArchived, err)
+
+	ha, err := mvccdb.Historical("a")
+	assert.Nil(t, err)
+	v, err := ha.Get("table01", "key01")
+	assert.Nil(t, err)
+	assert.Equal(t, "value01", v)
+	v, err = ha.Get("table01", "key0")
+	assert.Nil(t, err)
+	assert.Equal(t, "value0", v)
+	ok, err := ha.Has("table01", "key02")
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	ok, err = ha.Has("table01", "key03")
+	assert.Nil(t, err)
+	assert.False(t, ok)
+	root, err := ha.StateRoot()
+	assert.Nil(t, err)
+	assert.Equal(t, rootA, root)
+	assert.Equal(t, ErrReadOnly, ha.Put("table01", "key01", "value"))
+
+	hc, err := ha.Historical("c")
+	assert.Nil(t, err)
+	v, err = hc.Get("table01", "key01")
+	assert.Nil(t, err)
+	assert.Equal(t, "value11", v)
+	ok, err = hc.Has("table01", "key02")
+	assert.Nil(t, err)
+	assert.False(t, ok)
+	v, err = hc.Get("table01", "key03")
+	assert.Nil(t, err)
+	assert.Equal(t, "value03", v)
+	root, err = hc.StateRoot()
+	assert.Nil(t, err)
+	assert.Equal(t, rootC, root)
+
+	os.RemoveAll("archive_test2")
+	defer os.RemoveAll("archive_test2")
+	plain, err := NewMVCCDB("archive_test2")
+	assert.Nil(t, err)
+	defer plain.Close()
+	plain.Tag("a")
+	assert.Nil(t, plain.Flush("a"))
+	_, err = plain.Historical("a")
+	assert.Equal(t, ErrArchiveDisabled, err)
+}
+
+func TestHistoricalStateProof(t *testing.T) {
+	os.RemoveAll("archive_test3")
+	defer os.RemoveAll("archive_test3")
+	mvccdb, err := NewArchiveMVCCDB("archive_test3")
+	assert.Nil(t, err)
+	defer mvccdb.Close()
+
+	mvccdb.Put("table01", "key01", "value01")
+	mvccdb.Tag("a")
+	assert.Nil(t, mvccdb.Flush("a"))
+	mvccdb.Put("table01", "key01", "value11")
+	rootB, err := mvccdb.StateRoot()
+	assert.Nil(t, err)
+	mvccdb.Tag("b")
+	assert.Nil(t, mvccdb.Flush("b"))
+
+	ha, err := mvccdb.Historical("a")
+	assert.Nil(t, err)
+	_, err = ha.StateRoot()
+	assert.Equal(t, ErrStateRootNotFound, err)
+
+	hb, err := mvccdb.Historical("b")
+	assert.Nil(t, err)
+	proof, err := hb.Prove("table01", "key01")
+	assert.Nil(t, err)
+	assert.True(t, VerifyStateProof(rootB, "table01", "key01", "value11", true, proof))
+}
+
+func TestArchivePeriod(t *testing.T) {
+	os.RemoveAll("archive_test4")
+	defer os.RemoveAll("archive_test4")
+	plain, err := NewMVCCDB("archive_test4")
+	assert.Nil(t, err)
+	plain.Put("table01", "key01", "value01")
+	plain.Put("table01", "key02", "value02")
+	plain.Tag("a")
+	assert.Nil(t, plain.Flush("a"))
+	assert.Nil(t, plain.Close())
+
+	mvccdb, err := NewArchiveMVCCDB("archive_test4")
+	assert.Nil(t, err)
+	mvccdb.Put("table01", "key03", "value03")
+	mvccdb.Tag("b")
+	assert.Nil(t, mvccdb.Flush("b"))
+	mvccdb.Put("table01", "key01", "value11")
+	mvccdb.Tag("c")
+	assert.Nil(t, mvccdb.Flush("c"))
+
+	_, err = mvccdb.Historical("a")
+	assert.Equal(t, ErrTagNotArchived, err)
+	hb, err := mvccdb.Historical("b")
+	assert.Nil(t, err)
+	for k, v := range map[string]string{"key01": "value01", "key02": "value02", "key03": "value03"} {
+		value, err := hb.Get("table01", k)
+		assert.Nil(t, err)
+		assert.Equal(t, v, value)
+	}
+	hc, err := mvccdb.Historical("c")
+	assert.Nil(t, err)
+	value, err := hc.Get("table01", "key01")
+	assert.Nil(t, err)
+	assert.Equal(t, "value11", value)
+	assert.Nil(t, mvccdb.Close())
+
+	// archiving is disabled for a while, the tags archived before can't be read any more
+	plain, err = NewMVCCDB("archive_test4")
+	assert.Nil(t, err)
+	plain.Put("table01", "key01", "value21")
+	plain.Tag("d")
+	assert.Nil(t, plain.Flush("d"))
+	assert.Nil(t, plain.Close())
+
+	mvccdb, err = NewArchiveMVCCDB("archive_test4")
+	assert.Nil(t, err)
+	defer mvccdb.Close()
+	mvccdb.Put("table01", "key02", "value32")
+	mvccdb.Tag("e")
+	assert.Nil(t, mvccdb.Flush("e"))
+	mvccdb.Put("table01", "key02", "value42")
+	mvccdb.Tag("f")
+	assert.Nil(t, mvccdb.Flush("f"))
+
+	_, err = mvccdb.Historical("c")
+	assert.Equal(t, ErrTagNotArchived, err)
+	he, err := mvccdb.Historical("e")
+	assert.Nil(t, err)
+	value, err = he.Get("table01", "key01")
+	assert.Nil(t, err)
+	assert.Equal(t, "value21", value)
+	value, err = he.Get("table01", "key02")
+	assert.Nil(t, err)
+	assert.Equal(t, "value32", value)
+}
+
+func TestHistoricalVersions(t *testing.T) {
+	os.RemoveAll("archive_test5")
+	defer os.RemoveAll("archive_test5")
+	mvccdb, err := NewArchiveMVCCDB("archive_test5")
+	assert.Nil(t, err)
+	defer mvccdb.Close()
+
+	for i := 0; i < 30; i++ {
+		mvccdb.Put("table01", "key01", fmt.Sprintf("value%v", i))
+		// the versions of the longer key share the prefix of the versions of key01
+		mvccdb.Put("table01", "key01/x", fmt.Sprintf("other%v", i))
+		if i == 10 {
+			mvccdb.Put("table01", "key02", "value")
+		}
+		tag := fmt.Sprintf("%v", i)
+		mvccdb.Tag(tag)
+		assert.Nil(t, mvccdb.Flush(tag))
+	}
+	for i := 0; i < 30; i++ {
+		h, err := mvccdb.Historical(fmt.Sprintf("%v", i))
+		assert.Nil(t, err)
+		v, err := h.Get("table01", "key01")
+		assert.Nil(t, err)
+		assert.Equal(t, fmt.Sprintf("value%v", i), v)
+		v, err = h.Get("table01", "key01/x")
+		assert.Nil(t, err)
+		assert.Equal(t, fmt.Sprintf("other%v", i), v)
+		ok, err := h.Has("table01", "key02")
+		assert.Nil(t, err)
+		assert.Equal(t, i >= 10, ok)
+	}
+}
@@ -99,6 +99,15 @@ func (d *DB) CommitBatch() error {
 	return nil
 }
 
+// RollbackBatch will discard the batch transaction
+func (d *DB) RollbackBatch() error {
+	if d.batch == nil {
+		return fmt.Errorf("no batch write to rollback")
+	}
+	d.batch = nil
+	return nil
+}
+
 // Close will close the database
 func (d *DB) Close() error {
 	return d.db.Close()
@@ -112,6 +121,14 @@ func (d *DB) NewIteratorByPrefix(prefix []byte) interface{} {
 	}
 }
 
+// NewIteratorByRange returns a new iterator of the keys in [start, limit)
+func (d *DB) NewIteratorByRange(start []byte, limit []byte) interface{} {
+	iter := d.db.NewIterator(&util.Range{Start: start, Limit: limit}, nil)
+	return &Iter{
+		iter: iter,
+	}
+}
+
 // Iter is the iterator for leveldb
 type Iter struct {
 	iter iterator.Iterator
@@ -122,6 +139,16 @@ func (i *Iter) Next() bool {
 	return i.iter.Next()
 }
 
+// Prev do previous item of iterator
+func (i *Iter) Prev() bool {
+	return i.iter.Prev()
+}
+
+// Last moves the iterator to the last item
+func (i *Iter) Last() bool {
+	return i.iter.Last()
+}
+
 // Key returns the key of current item
 func (i *Iter) Key() []byte {
 	return i.iter.Key()
@@ -22,8 +22,10 @@ type StorageBackend interface {
 	Keys(prefix []byte) ([][]byte, error)
 	BeginBatch() error
 	CommitBatch() error
+	RollbackBatch() error
 	Close() error
 	NewIteratorByPrefix(prefix []byte) interface{}
+	NewIteratorByRange(start []byte, limit []byte) interface{}
 }
 
 // Storage is a kv database
@@ -57,9 +59,19 @@ func (s *Storage) NewIteratorByPrefix(prefix []byte) *Iterator {
 	}
 }
 
+// NewIteratorByRange returns a new iterator of the keys in [start, limit)
+func (s *Storage) NewIteratorByRange(start []byte, limit []byte) *Iterator {
+	ib := s.StorageBackend.NewIteratorByRange(start, limit).(IteratorBackend)
+	return &Iterator{
+		IteratorBackend: ib,
+	}
+}
+
 // IteratorBackend is the storage iterator backend
 type IteratorBackend interface {
 	Next() bool
+	Prev() bool
+	Last() bool
 	Key() []byte
 	Value() []byte
 	Error() error
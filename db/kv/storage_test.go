@@ -122,6 +122,21 @@ func (suite *StorageTestSuite) TestKeys() {
 	)
 }
 
+func (suite *StorageTestSuite) TestIteratorByRange() {
+	iter := suite.storage.NewIteratorByRange([]byte("key02"), []byte("key05"))
+	keys := make([]string, 0)
+	for ok := iter.Last(); ok; ok = iter.Prev() {
+		keys = append(keys, string(iter.Key()))
+	}
+	iter.Release()
+	suite.Nil(iter.Error())
+	suite.Equal([]string{"key04", "key03", "key02"}, keys)
+
+	iter = suite.storage.NewIteratorByRange([]byte("key06"), []byte("key09"))
+	suite.False(iter.Last())
+	iter.Release()
+}
+
 func (suite *StorageTestSuite) TestBatch() {
 	var value []byte
 	var err error
@@ -58,8 +58,10 @@ func (mr *MockMVCCDBMockRecorder) Close() *gomock.Call {
 }
 
 // Commit mocks base method
-func (m *MockMVCCDB) Commit() {
-	m.ctrl.Call(m, "Commit")
+func (m *MockMVCCDB) Commit() error {
+	ret := m.ctrl.Call(m, "Commit")
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
 // Commit indicates an expected call of Commit
@@ -141,6 +143,19 @@ func (mr *MockMVCCDBMockRecorder) Has(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Has", reflect.TypeOf((*MockMVCCDB)(nil).Has), arg0, arg1)
 }
 
+// Historical mocks base method
+func (m *MockMVCCDB) Historical(arg0 string) (db.MVCCDB, error) {
+	ret := m.ctrl.Call(m, "Historical", arg0)
+	ret0, _ := ret[0].(db.MVCCDB)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Historical indicates an expected call of Historical
+func (mr *MockMVCCDBMockRecorder) Historical(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Historical", reflect.TypeOf((*MockMVCCDB)(nil).Historical), arg0)
+}
+
 // Keys mocks base method
 func (m *MockMVCCDB) Keys(arg0, arg1 string) ([]string, error) {
 	ret := m.ctrl.Call(m, "Keys", arg0, arg1)
@@ -154,6 +169,19 @@ func (mr *MockMVCCDBMockRecorder) Keys(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Keys", reflect.TypeOf((*MockMVCCDB)(nil).Keys), arg0, arg1)
 }
 
+// Prove mocks base method
+func (m *MockMVCCDB) Prove(arg0, arg1 string) (*db.StateProof, error) {
+	ret := m.ctrl.Call(m, "Prove", arg0, arg1)
+	ret0, _ := ret[0].(*db.StateProof)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Prove indicates an expected call of Prove
+func (mr *MockMVCCDBMockRecorder) Prove(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Prove", reflect.TypeOf((*MockMVCCDB)(nil).Prove), arg0, arg1)
+}
+
 // Put mocks base method
 func (m *MockMVCCDB) Put(arg0, arg1, arg2 string) error {
 	ret := m.ctrl.Call(m, "Put", arg0, arg1, arg2)
@@ -176,9 +204,24 @@ func (mr *MockMVCCDBMockRecorder) Rollback() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockMVCCDB)(nil).Rollback))
 }
 
+// StateRoot mocks base method
+func (m *MockMVCCDB) StateRoot() ([]byte, error) {
+	ret := m.ctrl.Call(m, "StateRoot")
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StateRoot indicates an expected call of StateRoot
+func (mr *MockMVCCDBMockRecorder) StateRoot() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateRoot", reflect.TypeOf((*MockMVCCDB)(nil).StateRoot))
+}
+
 // Tag mocks base method
-func (m *MockMVCCDB) Tag(arg0 string) {
-	m.ctrl.Call(m, "Tag", arg0)
+func (m *MockMVCCDB) Tag(arg0 string) error {
+	ret := m.ctrl.Call(m, "Tag", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
 // Tag indicates an expected call of Tag
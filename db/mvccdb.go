@@ -2,8 +2,10 @@ package db
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 
+	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/db/kv"
 	"github.com/iost-official/go-iost/db/mvcc"
 )
@@ -27,14 +29,17 @@ type MVCCDB interface {
 	Del(table string, key string) error
 	Has(table string, key string) (bool, error)
 	Keys(table string, prefix string) ([]string, error)
-	Commit()
+	Commit() error
 	Rollback()
 	Checkout(t string) bool
-	Tag(t string)
+	Tag(t string) error
 	CurrentTag() string
 	Fork() MVCCDB
 	Flush(t string) error
 	Close() error
+	StateRoot() ([]byte, error)
+	Prove(table string, key string) (*StateProof, error)
+	Historical(t string) (MVCCDB, error)
 }
 
 // NewMVCCDB return new mvccdb
@@ -150,6 +155,9 @@ type CacheMVCCDB struct {
 	stage   *Commit
 	storage *kv.Storage
 	cm      *CommitManager
+	dirty   map[string]*Item
+	dirtymu sync.Mutex
+	archive bool
 }
 
 // NewCacheMVCCDB returns new CacheMVCCDB
@@ -173,11 +181,12 @@ func NewCacheMVCCDB(path string, cacheType mvcc.CacheType) (*CacheMVCCDB, error)
 		stage:   stage,
 		storage: storage,
 		cm:      cm,
+		dirty:   make(map[string]*Item),
 	}
 	return mvccdb, nil
 }
 
-func (m *CacheMVCCDB) isValidTable(table string) bool {
+func isValidTable(table string) bool {
 	if table == "" {
 		return false
 	}
@@ -192,7 +201,7 @@ func (m *CacheMVCCDB) isValidTable(table string) bool {
 // Get returns the value of specify key and table
 func (m *CacheMVCCDB) Get(table string, key string) (string, error) {
 	//fmt.Printf("Get %v %v\n", table, key)
-	if !m.isValidTable(table) {
+	if !isValidTable(table) {
 		return "", ErrTableNotValid
 	}
 	k := []byte(table + string(SEPARATOR) + key)
@@ -217,7 +226,7 @@ func (m *CacheMVCCDB) Get(table string, key string) (string, error) {
 // Put will insert the key-value pair into the table
 func (m *CacheMVCCDB) Put(table string, key string, value string) error {
 	//fmt.Printf("Put %v %v %v\n", table, key, value)
-	if !m.isValidTable(table) {
+	if !isValidTable(table) {
 		return ErrTableNotValid
 	}
 	k := []byte(table + string(SEPARATOR) + key)
@@ -228,12 +237,13 @@ func (m *CacheMVCCDB) Put(table string, key string, value string) error {
 		deleted: false,
 	}
 	m.stage.Put(k, v)
+	m.markDirty(string(k), v)
 	return nil
 }
 
 // Del will remove the specify key in the table
 func (m *CacheMVCCDB) Del(table string, key string) error {
-	if !m.isValidTable(table) {
+	if !isValidTable(table) {
 		return ErrTableNotValid
 	}
 	k := []byte(table + string(SEPARATOR) + key)
@@ -244,12 +254,13 @@ func (m *CacheMVCCDB) Del(table string, key string) error {
 		deleted: true,
 	}
 	m.stage.Put(k, v)
+	m.markDirty(string(k), v)
 	return nil
 }
 
 // Has returns whether the specified key exists in the table
 func (m *CacheMVCCDB) Has(table string, key string) (bool, error) {
-	if !m.isValidTable(table) {
+	if !isValidTable(table) {
 		return false, ErrTableNotValid
 	}
 	k := []byte(table + string(SEPARATOR) + key)
@@ -269,7 +280,7 @@ func (m *CacheMVCCDB) Has(table string, key string) (bool, error) {
 
 // Keys returns the list of key prefixed with prefix in the table
 func (m *CacheMVCCDB) Keys(table string, prefix string) ([]string, error) {
-	//if !m.isValidTable(table) {
+	//if !isValidTable(table) {
 	//	return nil, ErrTableNotValid
 	//}
 	//p := []byte(table + string(SEPARATOR) + prefix)
@@ -291,13 +302,17 @@ func (m *CacheMVCCDB) Keys(table string, prefix string) ([]string, error) {
 }
 
 // Commit will commit current state of mvccdb
-func (m *CacheMVCCDB) Commit() {
+func (m *CacheMVCCDB) Commit() error {
 	m.rwmu.Lock()
 	defer m.rwmu.Unlock()
 
+	if _, err := m.updateStateRoot(false); err != nil {
+		return fmt.Errorf("failed to update state root: %v", err)
+	}
 	m.cm.Add(m.stage)
 	m.head = m.stage
 	m.stage = m.head.Fork()
+	return nil
 }
 
 // Rollback will rollback the state of mvccdb
@@ -306,6 +321,7 @@ func (m *CacheMVCCDB) Rollback() {
 	defer m.rwmu.Unlock()
 
 	m.stage = m.head.Fork()
+	m.clearDirty()
 }
 
 // Checkout will checkout the specify tag of mvccdb
@@ -319,17 +335,21 @@ func (m *CacheMVCCDB) Checkout(t string) bool {
 	}
 	m.head = head
 	m.stage = m.head.Fork()
+	m.clearDirty()
 	return true
 }
 
 // Tag will add tag to current state of mvccdb
-func (m *CacheMVCCDB) Tag(t string) {
-	m.Commit()
+func (m *CacheMVCCDB) Tag(t string) error {
+	if err := m.Commit(); err != nil {
+		return err
+	}
 
 	m.rwmu.RLock()
 	defer m.rwmu.RUnlock()
 
 	m.cm.AddTag(m.head, t)
+	return nil
 }
 
 // CurrentTag will returns current tag of mvccdb
@@ -353,6 +373,8 @@ func (m *CacheMVCCDB) Fork() MVCCDB {
 		stage:   m.head.Fork(),
 		storage: m.storage,
 		cm:      m.cm,
+		dirty:   make(map[string]*Item),
+		archive: m.archive,
 	}
 	return mvccdb
 }
@@ -370,12 +392,17 @@ func (m *CacheMVCCDB) Flush(t string) error {
 	if err != nil {
 		return err
 	}
-	for _, v := range commit.All([]byte("")) {
+	items := commit.All([]byte(""))
+	for _, v := range items {
 		item, ok := v.(*Item)
 		if !ok {
 			return fmt.Errorf("can't assert Item type")
 		}
 		if item.deleted {
+			// the archived tags are proved by the replaced trie nodes, so they are kept in archive mode
+			if m.archive && isStateNodeItem(item) {
+				continue
+			}
 			err := m.storage.Delete([]byte(item.table + string(SEPARATOR) + item.key))
 			if err != nil {
 				return err
@@ -387,6 +414,11 @@ func (m *CacheMVCCDB) Flush(t string) error {
 			}
 		}
 	}
+	if m.archive {
+		if err := m.archiveItems(t, items); err != nil {
+			return err
+		}
+	}
 	if err := m.storage.CommitBatch(); err != nil {
 		return err
 	}
@@ -394,6 +426,189 @@ func (m *CacheMVCCDB) Flush(t string) error {
 	return nil
 }
 
+// StateRoot returns the root hash of the state trie of current state of mvccdb
+func (m *CacheMVCCDB) StateRoot() ([]byte, error) {
+	m.rwmu.Lock()
+	defer m.rwmu.Unlock()
+
+	return m.updateStateRoot(true)
+}
+
+// Prove returns the merkle proof of the key in the state trie of current state of mvccdb
+func (m *CacheMVCCDB) Prove(table string, key string) (*StateProof, error) {
+	if !isValidTable(table) {
+		return nil, ErrTableNotValid
+	}
+	m.rwmu.Lock()
+	defer m.rwmu.Unlock()
+
+	root, err := m.updateStateRoot(true)
+	if err != nil {
+		return nil, err
+	}
+	t := &stateTrie{store: m}
+	return t.Prove(root, stateKeyHash(table, key))
+}
+
+func (m *CacheMVCCDB) markDirty(k string, v *Item) {
+	m.dirtymu.Lock()
+	defer m.dirtymu.Unlock()
+
+	m.dirty[k] = v
+}
+
+func (m *CacheMVCCDB) clearDirty() {
+	m.dirtymu.Lock()
+	defer m.dirtymu.Unlock()
+
+	m.dirty = make(map[string]*Item)
+}
+
+// updateStateRoot applies the dirty items to the state trie and returns the new root.
+// The trie isn't maintained until the state root is required the first time, so if it isn't built yet,
+// it's built from the whole state when build is true, otherwise the dirty items are dropped and nil is returned.
+func (m *CacheMVCCDB) updateStateRoot(build bool) ([]byte, error) {
+	m.dirtymu.Lock()
+	defer m.dirtymu.Unlock()
+
+	root, err := m.getReserved(stateRootKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(root) == 0 {
+		if !build {
+			m.dirty = make(map[string]*Item)
+			return nil, nil
+		}
+		root, err = m.buildStateTrie()
+		if err != nil {
+			return nil, err
+		}
+		m.putStateRoot(root)
+		return root, nil
+	}
+	if len(m.dirty) == 0 {
+		return root, nil
+	}
+	t := &stateTrie{store: m}
+	for _, item := range m.dirty {
+		keyHash := stateKeyHash(item.table, item.key)
+		if item.deleted {
+			root, err = t.Remove(root, keyHash)
+		} else {
+			root, err = t.Update(root, keyHash, stateValueHash(item.value))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	m.putStateRoot(root)
+	return root, nil
+}
+
+// buildStateTrie builds the state trie of all the key-value pairs in the storage and the stage.
+func (m *CacheMVCCDB) buildStateTrie() ([]byte, error) {
+	leaves := make([]stateLeaf, 0)
+	staged := make(map[string]bool)
+	for _, v := range m.stage.All([]byte("")) {
+		item, ok := v.(*Item)
+		if !ok {
+			return nil, fmt.Errorf("can't assert Item type")
+		}
+		if item.table == "" {
+			continue
+		}
+		staged[item.table+string(SEPARATOR)+item.key] = true
+	}
+	iter := m.storage.NewIteratorByPrefix([]byte{})
+	for iter.Next() {
+		k := iter.Key()
+		if len(k) == 0 || k[0] == SEPARATOR || staged[string(k)] {
+			continue
+		}
+		leaves = append(leaves, stateLeaf{
+			key:   common.Sha3(k),
+			value: stateValueHash(string(iter.Value())),
+		})
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		return nil, fmt.Errorf("failed to iterate storage: %v", err)
+	}
+	for k := range staged {
+		item, ok := m.stage.Get([]byte(k)).(*Item)
+		if !ok {
+			return nil, fmt.Errorf("can't assert Item type")
+		}
+		if item.deleted {
+			continue
+		}
+		leaves = append(leaves, stateLeaf{
+			key:   stateKeyHash(item.table, item.key),
+			value: stateValueHash(item.value),
+		})
+	}
+	t := &stateTrie{store: m}
+	return t.Build(leaves)
+}
+
+func (m *CacheMVCCDB) putStateRoot(root []byte) {
+	m.stage.Put([]byte(string(SEPARATOR)+stateRootKey), &Item{
+		key:   stateRootKey,
+		value: string(root),
+	})
+	m.dirty = make(map[string]*Item)
+}
+
+func (m *CacheMVCCDB) getStateNode(hash []byte) ([]byte, error) {
+	data, err := m.getReserved(stateNodePrefix + string(hash))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, ErrStateNodeNotFound
+	}
+	return data, nil
+}
+
+func (m *CacheMVCCDB) putStateNode(hash []byte, data []byte) {
+	key := stateNodePrefix + string(hash)
+	m.stage.Put([]byte(string(SEPARATOR)+key), &Item{
+		key:   key,
+		value: string(data),
+	})
+}
+
+func (m *CacheMVCCDB) delStateNode(hash []byte) {
+	key := stateNodePrefix + string(hash)
+	m.stage.Put([]byte(string(SEPARATOR)+key), &Item{
+		key:     key,
+		deleted: true,
+	})
+}
+
+func isStateNodeItem(item *Item) bool {
+	return item.table == "" && strings.HasPrefix(item.key, stateNodePrefix)
+}
+
+// getReserved returns the value of key in the reserved table, which name is empty
+func (m *CacheMVCCDB) getReserved(key string) ([]byte, error) {
+	k := []byte(string(SEPARATOR) + key)
+	v := m.stage.Get(k)
+	if v == nil {
+		v, err := m.storage.Get(k)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get from storage: %v", err)
+		}
+		return v, nil
+	}
+	i, ok := v.(*Item)
+	if !ok {
+		return nil, fmt.Errorf("can't assert Item type")
+	}
+	return []byte(i.value), nil
+}
+
 // Close will close the mvccdb
 func (m *CacheMVCCDB) Close() error {
 	return m.storage.Close()
@@ -0,0 +1,268 @@
+package db
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/db/kv"
+	"golang.org/x/crypto/sha3"
+)
+
+// A snapshot is the flushed state of the mvccdb, which consists of the magic, the key-value records,
+// an empty key as terminator and the sha3 checksum of all the records.
+// Only the tag and the state records are exported, the importer rebuilds the state trie from the records
+// and checks it against the trusted state root, so no trie node or archived value is exported.
+
+// constant of snapshot
+const (
+	snapshotMagic       = "iost-snapshot-v1"
+	snapshotBatchSize   = 10000
+	snapshotMaxKeyLen   = 1 << 16
+	snapshotMaxValueLen = 1 << 28
+)
+
+// error of snapshot
+var (
+	ErrSnapshotInvalid  = fmt.Errorf("snapshot is not valid")
+	ErrSnapshotChecksum = fmt.Errorf("snapshot checksum mismatch")
+	ErrStorageNotEmpty  = fmt.Errorf("storage is not empty")
+	ErrSnapshotMismatch = fmt.Errorf("snapshot doesn't match the trusted tag or state root")
+)
+
+type snapshotWriter struct {
+	w    *bufio.Writer
+	hash io.Writer
+	buf  []byte
+}
+
+func (sw *snapshotWriter) writeBytes(b []byte) error {
+	n := binary.PutUvarint(sw.buf, uint64(len(b)))
+	if _, err := sw.w.Write(sw.buf[:n]); err != nil {
+		return err
+	}
+	sw.hash.Write(sw.buf[:n])
+	if _, err := sw.w.Write(b); err != nil {
+		return err
+	}
+	sw.hash.Write(b)
+	return nil
+}
+
+func (sw *snapshotWriter) writeRecord(key []byte, value []byte) error {
+	if err := sw.writeBytes(key); err != nil {
+		return err
+	}
+	return sw.writeBytes(value)
+}
+
+type snapshotReader struct {
+	r    *bufio.Reader
+	hash io.Writer
+	buf  []byte
+}
+
+func (sr *snapshotReader) readBytes(max uint64) ([]byte, error) {
+	l, err := binary.ReadUvarint(sr.r)
+	if err != nil {
+		return nil, err
+	}
+	if l > max {
+		return nil, ErrSnapshotInvalid
+	}
+	n := binary.PutUvarint(sr.buf, l)
+	sr.hash.Write(sr.buf[:n])
+	b := make([]byte, l)
+	if _, err := io.ReadFull(sr.r, b); err != nil {
+		return nil, err
+	}
+	sr.hash.Write(b)
+	return b, nil
+}
+
+// snapshotNodeStore puts the state trie nodes into the storage in batches.
+type snapshotNodeStore struct {
+	storage *kv.Storage
+	count   int
+	err     error
+}
+
+func (s *snapshotNodeStore) getStateNode(hash []byte) ([]byte, error) {
+	return s.storage.Get([]byte(string(SEPARATOR) + stateNodePrefix + string(hash)))
+}
+
+func (s *snapshotNodeStore) putStateNode(hash []byte, data []byte) {
+	if s.err != nil {
+		return
+	}
+	s.err = s.storage.Put([]byte(string(SEPARATOR)+stateNodePrefix+string(hash)), data)
+	s.count++
+	if s.err == nil && s.count%snapshotBatchSize == 0 {
+		if s.err = s.storage.CommitBatch(); s.err == nil {
+			s.err = s.storage.BeginBatch()
+		}
+	}
+}
+
+func (s *snapshotNodeStore) delStateNode(hash []byte) {}
+
+func isArchiveKey(k []byte) bool {
+	s := string(k)
+	return strings.HasPrefix(s, string(SEPARATOR)+archivePrefix) ||
+		strings.HasPrefix(s, string(SEPARATOR)+archiveTagPrefix) ||
+		strings.HasPrefix(s, string(SEPARATOR)+archiveBasePrefix) ||
+		s == string(SEPARATOR)+archiveSeqKey ||
+		s == string(SEPARATOR)+archiveFirstKey ||
+		s == string(SEPARATOR)+archiveLastKey
+}
+
+// ExportSnapshot writes the flushed state of the mvccdb at path to w and returns the tag of it
+func ExportSnapshot(path string, w io.Writer) (string, error) {
+	storage, err := kv.NewStorage(path, kv.LevelDBStorage)
+	if err != nil {
+		return "", fmt.Errorf("failed to new storage: %v", err)
+	}
+	defer storage.Close()
+
+	hash := sha3.New256()
+	sw := &snapshotWriter{
+		w:    bufio.NewWriter(w),
+		hash: hash,
+		buf:  make([]byte, binary.MaxVarintLen64),
+	}
+	if _, err := sw.w.WriteString(snapshotMagic); err != nil {
+		return "", err
+	}
+	var tag string
+	iter := storage.NewIteratorByPrefix([]byte{})
+	for iter.Next() {
+		k, v := iter.Key(), iter.Value()
+		if len(k) == 0 {
+			continue
+		}
+		if k[0] == SEPARATOR {
+			if string(k) != string(SEPARATOR)+"tag" {
+				continue
+			}
+			tag = string(v)
+		}
+		if err := sw.writeRecord(k, v); err != nil {
+			iter.Release()
+			return "", err
+		}
+	}
+	iter.Release()
+	if err := iter.Error(); err != nil {
+		return "", fmt.Errorf("failed to iterate storage: %v", err)
+	}
+	if err := sw.writeBytes([]byte{}); err != nil {
+		return "", err
+	}
+	if _, err := sw.w.Write(hash.Sum(nil)); err != nil {
+		return "", err
+	}
+	return tag, sw.w.Flush()
+}
+
+// ImportSnapshot reads the snapshot from r into the empty storage at path.
+// The snapshot must be of the trusted tag, and the state trie rebuilt from it must have the trusted root.
+// The tag is written at last, so an interrupted or mismatched import leaves a storage without tag.
+func ImportSnapshot(path string, r io.Reader, trustedTag string, trustedRoot []byte) error {
+	storage, err := kv.NewStorage(path, kv.LevelDBStorage)
+	if err != nil {
+		return fmt.Errorf("failed to new storage: %v", err)
+	}
+	defer storage.Close()
+	keys, err := storage.Keys([]byte{})
+	if err != nil {
+		return err
+	}
+	if len(keys) != 0 {
+		return ErrStorageNotEmpty
+	}
+
+	hash := sha3.New256()
+	sr := &snapshotReader{
+		r:    bufio.NewReader(r),
+		hash: hash,
+		buf:  make([]byte, binary.MaxVarintLen64),
+	}
+	magic := make([]byte, len(snapshotMagic))
+	if _, err := io.ReadFull(sr.r, magic); err != nil || string(magic) != snapshotMagic {
+		return ErrSnapshotInvalid
+	}
+	var tag []byte
+	leaves := make([]stateLeaf, 0)
+	count := 0
+	if err := storage.BeginBatch(); err != nil {
+		return err
+	}
+	for {
+		k, err := sr.readBytes(snapshotMaxKeyLen)
+		if err != nil {
+			return fmt.Errorf("failed to read snapshot: %v", err)
+		}
+		if len(k) == 0 {
+			break
+		}
+		v, err := sr.readBytes(snapshotMaxValueLen)
+		if err != nil {
+			return fmt.Errorf("failed to read snapshot: %v", err)
+		}
+		if k[0] == SEPARATOR {
+			if string(k) == string(SEPARATOR)+"tag" {
+				tag = v
+			}
+			continue
+		}
+		leaves = append(leaves, stateLeaf{
+			key:   common.Sha3(k),
+			value: stateValueHash(string(v)),
+		})
+		if err := storage.Put(k, v); err != nil {
+			return err
+		}
+		count++
+		if count%snapshotBatchSize == 0 {
+			if err := storage.CommitBatch(); err != nil {
+				return err
+			}
+			if err := storage.BeginBatch(); err != nil {
+				return err
+			}
+		}
+	}
+	checksum := make([]byte, hash.Size())
+	if _, err := io.ReadFull(sr.r, checksum); err != nil {
+		return fmt.Errorf("failed to read snapshot: %v", err)
+	}
+	if !bytes.Equal(checksum, hash.Sum(nil)) {
+		return ErrSnapshotChecksum
+	}
+	if string(tag) != trustedTag {
+		return ErrSnapshotMismatch
+	}
+	store := &snapshotNodeStore{storage: storage}
+	t := &stateTrie{store: store}
+	root, err := t.Build(leaves)
+	if err != nil {
+		return err
+	}
+	if store.err != nil {
+		return store.err
+	}
+	if !bytes.Equal(root, trustedRoot) {
+		return ErrSnapshotMismatch
+	}
+	if err := storage.Put([]byte(string(SEPARATOR)+stateRootKey), root); err != nil {
+		return err
+	}
+	if err := storage.CommitBatch(); err != nil {
+		return err
+	}
+	return storage.Put([]byte(string(SEPARATOR)+"tag"), tag)
+}
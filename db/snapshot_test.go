@@ -0,0 +1,84 @@
+package db
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSnapshot(t *testing.T) {
+	os.RemoveAll("snapshot_test1")
+	defer os.RemoveAll("snapshot_test1")
+	mvccdb, err := NewArchiveMVCCDB("snapshot_test1")
+	require.Nil(t, err)
+	for i := 0; i < 100; i++ {
+		mvccdb.Put("state", fmt.Sprintf("key%v", i), fmt.Sprintf("value%v", i))
+	}
+	mvccdb.Tag("a")
+	require.Nil(t, mvccdb.Flush("a"))
+	for i := 0; i < 50; i++ {
+		mvccdb.Del("state", fmt.Sprintf("key%v", i))
+	}
+	mvccdb.Tag("b")
+	require.Nil(t, mvccdb.Flush("b"))
+	root, err := mvccdb.StateRoot()
+	require.Nil(t, err)
+	require.Nil(t, mvccdb.Close())
+
+	var buf bytes.Buffer
+	tag, err := ExportSnapshot("snapshot_test1", &buf)
+	require.Nil(t, err)
+	require.Equal(t, "b", tag)
+	snapshot := buf.Bytes()
+
+	os.RemoveAll("snapshot_test2")
+	defer os.RemoveAll("snapshot_test2")
+	require.Nil(t, ImportSnapshot("snapshot_test2", bytes.NewReader(snapshot), "b", root))
+	err = ImportSnapshot("snapshot_test2", bytes.NewReader(snapshot), "b", root)
+	require.Equal(t, ErrStorageNotEmpty, err)
+
+	imported, err := NewMVCCDB("snapshot_test2")
+	require.Nil(t, err)
+	defer imported.Close()
+	require.Equal(t, "b", imported.CurrentTag())
+	importedRoot, err := imported.StateRoot()
+	require.Nil(t, err)
+	require.Equal(t, root, importedRoot)
+	for i := 0; i < 100; i++ {
+		ok, err := imported.Has("state", fmt.Sprintf("key%v", i))
+		require.Nil(t, err)
+		require.Equal(t, i >= 50, ok)
+	}
+	_, err = imported.Historical("a")
+	require.Equal(t, ErrArchiveDisabled, err)
+	proof, err := imported.(*CacheMVCCDB).Prove("state", "key60")
+	require.Nil(t, err)
+	require.True(t, VerifyStateProof(root, "state", "key60", "value60", true, proof))
+
+	for i, tc := range []struct {
+		tag  string
+		root []byte
+	}{
+		{"a", root},
+		{"b", emptyStateHash},
+	} {
+		path := fmt.Sprintf("snapshot_test_mismatch%v", i)
+		os.RemoveAll(path)
+		defer os.RemoveAll(path)
+		err = ImportSnapshot(path, bytes.NewReader(snapshot), tc.tag, tc.root)
+		require.Equal(t, ErrSnapshotMismatch, err)
+		mismatched, err := NewMVCCDB(path)
+		require.Nil(t, err)
+		require.Equal(t, "", mismatched.CurrentTag())
+		mismatched.Close()
+	}
+
+	os.RemoveAll("snapshot_test3")
+	defer os.RemoveAll("snapshot_test3")
+	snapshot[len(snapshot)-1] ^= 1
+	err = ImportSnapshot("snapshot_test3", bytes.NewReader(snapshot), "b", root)
+	require.Equal(t, ErrSnapshotChecksum, err)
+}
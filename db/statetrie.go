@@ -0,0 +1,352 @@
+package db
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/iost-official/go-iost/common"
+)
+
+// The state trie is a binary merkle patricia trie over the hash of every key in the mvccdb.
+// A leaf is placed at the shallowest depth that distinguishes its key hash from all the others,
+// so the shape of the trie (and thus its root) only depends on the current key-value pairs.
+// Trie nodes are saved in the mvccdb itself under the reserved empty table, which makes them
+// follow the same commit, checkout and flush semantics as the state they commit to.
+// A leaf commits to its key hash, so a node is referenced only once in a trie. The nodes replaced
+// by an update are deleted in the same commit, and removed from the storage when the commit is flushed,
+// while the forks not flushed yet still read them from their own commits.
+
+// constant of state trie
+const (
+	// StateHashLength is the length of state root and state trie node hash
+	StateHashLength = 32
+
+	stateHashBits   = StateHashLength * 8
+	stateRootKey    = "stateroot"
+	stateNodePrefix = "trie" + string(SEPARATOR)
+
+	stateLeafNode   byte = 0
+	stateBranchNode byte = 1
+)
+
+// error of state trie
+var (
+	ErrStateNodeNotFound = fmt.Errorf("state trie node not found")
+	ErrStateNodeInvalid  = fmt.Errorf("state trie node is not valid")
+	ErrStateRootNotFound = fmt.Errorf("state root not found")
+)
+
+var emptyStateHash = make([]byte, StateHashLength)
+
+// StateProof is the merkle proof of a key in the state trie.
+// Siblings are ordered from the root down to the terminal node of the key's path.
+// LeafKey and LeafValue are the key hash and value hash of the terminal leaf,
+// both are nil if the path ends in an empty node.
+type StateProof struct {
+	Siblings  [][]byte
+	LeafKey   []byte
+	LeafValue []byte
+}
+
+type stateNode struct {
+	kind  byte
+	left  []byte
+	right []byte
+}
+
+type stateNodeStore interface {
+	getStateNode(hash []byte) ([]byte, error)
+	putStateNode(hash []byte, data []byte)
+	delStateNode(hash []byte)
+}
+
+type stateLeaf struct {
+	key   []byte
+	value []byte
+}
+
+type stateTrie struct {
+	store stateNodeStore
+}
+
+func stateKeyHash(table string, key string) []byte {
+	return common.Sha3([]byte(table + string(SEPARATOR) + key))
+}
+
+func stateValueHash(value string) []byte {
+	return common.Sha3([]byte(value))
+}
+
+func encodeStateNode(kind byte, left []byte, right []byte) []byte {
+	data := make([]byte, 0, 1+2*StateHashLength)
+	data = append(data, kind)
+	data = append(data, left...)
+	return append(data, right...)
+}
+
+func decodeStateNode(data []byte) (*stateNode, error) {
+	if len(data) != 1+2*StateHashLength {
+		return nil, ErrStateNodeInvalid
+	}
+	if data[0] != stateLeafNode && data[0] != stateBranchNode {
+		return nil, ErrStateNodeInvalid
+	}
+	return &stateNode{
+		kind:  data[0],
+		left:  data[1 : 1+StateHashLength],
+		right: data[1+StateHashLength:],
+	}, nil
+}
+
+func hashStateNode(kind byte, left []byte, right []byte) []byte {
+	return common.Sha3(encodeStateNode(kind, left, right))
+}
+
+func isEmptyStateHash(hash []byte) bool {
+	return bytes.Equal(hash, emptyStateHash)
+}
+
+// bit returns the i-th bit of the hash, counting from the most significant bit.
+func bit(hash []byte, i int) byte {
+	return (hash[i/8] >> uint(7-i%8)) & 1
+}
+
+func (t *stateTrie) put(kind byte, left []byte, right []byte) []byte {
+	data := encodeStateNode(kind, left, right)
+	hash := common.Sha3(data)
+	t.store.putStateNode(hash, data)
+	return hash
+}
+
+func (t *stateTrie) branch(left []byte, right []byte) []byte {
+	return t.put(stateBranchNode, left, right)
+}
+
+// replace deletes the node of old hash if it's replaced by another node, and returns the hash of the node.
+func (t *stateTrie) replace(old []byte, hash []byte) []byte {
+	if !bytes.Equal(old, hash) {
+		t.store.delStateNode(old)
+	}
+	return hash
+}
+
+func (t *stateTrie) load(hash []byte) (*stateNode, error) {
+	data, err := t.store.getStateNode(hash)
+	if err != nil {
+		return nil, err
+	}
+	return decodeStateNode(data)
+}
+
+// Update sets the value hash of the key hash and returns the new root.
+func (t *stateTrie) Update(root []byte, keyHash []byte, valueHash []byte) ([]byte, error) {
+	return t.update(root, 0, keyHash, valueHash)
+}
+
+func (t *stateTrie) update(hash []byte, depth int, keyHash []byte, valueHash []byte) ([]byte, error) {
+	if isEmptyStateHash(hash) {
+		return t.put(stateLeafNode, keyHash, valueHash), nil
+	}
+	n, err := t.load(hash)
+	if err != nil {
+		return nil, err
+	}
+	if n.kind == stateLeafNode {
+		if bytes.Equal(n.left, keyHash) {
+			return t.replace(hash, t.put(stateLeafNode, keyHash, valueHash)), nil
+		}
+		return t.split(hash, n.left, t.put(stateLeafNode, keyHash, valueHash), keyHash, depth)
+	}
+	if bit(keyHash, depth) == 0 {
+		left, err := t.update(n.left, depth+1, keyHash, valueHash)
+		if err != nil {
+			return nil, err
+		}
+		return t.replace(hash, t.branch(left, n.right)), nil
+	}
+	right, err := t.update(n.right, depth+1, keyHash, valueHash)
+	if err != nil {
+		return nil, err
+	}
+	return t.replace(hash, t.branch(n.left, right)), nil
+}
+
+func (t *stateTrie) split(oldLeaf []byte, oldKey []byte, newLeaf []byte, newKey []byte, depth int) ([]byte, error) {
+	if depth >= stateHashBits {
+		return nil, ErrStateNodeInvalid
+	}
+	ob, nb := bit(oldKey, depth), bit(newKey, depth)
+	if ob != nb {
+		if nb == 0 {
+			return t.branch(newLeaf, oldLeaf), nil
+		}
+		return t.branch(oldLeaf, newLeaf), nil
+	}
+	child, err := t.split(oldLeaf, oldKey, newLeaf, newKey, depth+1)
+	if err != nil {
+		return nil, err
+	}
+	if nb == 0 {
+		return t.branch(child, emptyStateHash), nil
+	}
+	return t.branch(emptyStateHash, child), nil
+}
+
+// Build puts the trie of the leaves and returns the root, which is the same as updating the leaves one by one.
+func (t *stateTrie) Build(leaves []stateLeaf) ([]byte, error) {
+	sort.Slice(leaves, func(i, j int) bool {
+		return bytes.Compare(leaves[i].key, leaves[j].key) < 0
+	})
+	for i := 1; i < len(leaves); i++ {
+		if bytes.Equal(leaves[i-1].key, leaves[i].key) {
+			return nil, ErrStateNodeInvalid
+		}
+	}
+	return t.build(leaves, 0)
+}
+
+// build returns the root of the sorted leaves which share the first depth bits of key hash.
+func (t *stateTrie) build(leaves []stateLeaf, depth int) ([]byte, error) {
+	switch len(leaves) {
+	case 0:
+		return emptyStateHash, nil
+	case 1:
+		return t.put(stateLeafNode, leaves[0].key, leaves[0].value), nil
+	}
+	if depth >= stateHashBits {
+		return nil, ErrStateNodeInvalid
+	}
+	i := sort.Search(len(leaves), func(i int) bool {
+		return bit(leaves[i].key, depth) == 1
+	})
+	left, err := t.build(leaves[:i], depth+1)
+	if err != nil {
+		return nil, err
+	}
+	right, err := t.build(leaves[i:], depth+1)
+	if err != nil {
+		return nil, err
+	}
+	return t.branch(left, right), nil
+}
+
+// Remove deletes the key hash and returns the new root.
+func (t *stateTrie) Remove(root []byte, keyHash []byte) ([]byte, error) {
+	return t.remove(root, 0, keyHash)
+}
+
+func (t *stateTrie) remove(hash []byte, depth int, keyHash []byte) ([]byte, error) {
+	if isEmptyStateHash(hash) {
+		return hash, nil
+	}
+	n, err := t.load(hash)
+	if err != nil {
+		return nil, err
+	}
+	if n.kind == stateLeafNode {
+		if bytes.Equal(n.left, keyHash) {
+			t.store.delStateNode(hash)
+			return emptyStateHash, nil
+		}
+		return hash, nil
+	}
+	left, right := n.left, n.right
+	if bit(keyHash, depth) == 0 {
+		left, err = t.remove(left, depth+1, keyHash)
+	} else {
+		right, err = t.remove(right, depth+1, keyHash)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(left, n.left) && bytes.Equal(right, n.right) {
+		return hash, nil
+	}
+	t.store.delStateNode(hash)
+	// a branch with a single leaf below it collapses into that leaf
+	var only []byte
+	switch {
+	case isEmptyStateHash(left) && isEmptyStateHash(right):
+		return emptyStateHash, nil
+	case isEmptyStateHash(left):
+		only = right
+	case isEmptyStateHash(right):
+		only = left
+	default:
+		return t.branch(left, right), nil
+	}
+	c, err := t.load(only)
+	if err != nil {
+		return nil, err
+	}
+	if c.kind == stateLeafNode {
+		return only, nil
+	}
+	return t.branch(left, right), nil
+}
+
+// Prove returns the merkle proof of the key hash.
+func (t *stateTrie) Prove(root []byte, keyHash []byte) (*StateProof, error) {
+	proof := &StateProof{
+		Siblings: make([][]byte, 0),
+	}
+	hash := root
+	for depth := 0; !isEmptyStateHash(hash); depth++ {
+		n, err := t.load(hash)
+		if err != nil {
+			return nil, err
+		}
+		if n.kind == stateLeafNode {
+			proof.LeafKey = n.left
+			proof.LeafValue = n.right
+			break
+		}
+		if bit(keyHash, depth) == 0 {
+			proof.Siblings = append(proof.Siblings, n.right)
+			hash = n.left
+		} else {
+			proof.Siblings = append(proof.Siblings, n.left)
+			hash = n.right
+		}
+	}
+	return proof, nil
+}
+
+// VerifyStateProof checks the proof against the state root.
+// If exist is false, the proof is checked as a proof of absence of the key.
+func VerifyStateProof(root []byte, table string, key string, value string, exist bool, proof *StateProof) bool {
+	if proof == nil || len(proof.Siblings) > stateHashBits {
+		return false
+	}
+	keyHash := stateKeyHash(table, key)
+	var hash []byte
+	switch {
+	case proof.LeafKey == nil:
+		if exist {
+			return false
+		}
+		hash = emptyStateHash
+	case bytes.Equal(proof.LeafKey, keyHash):
+		if !exist || !bytes.Equal(proof.LeafValue, stateValueHash(value)) {
+			return false
+		}
+		hash = hashStateNode(stateLeafNode, proof.LeafKey, proof.LeafValue)
+	default:
+		if exist || len(proof.LeafKey) != StateHashLength || len(proof.LeafValue) != StateHashLength {
+			return false
+		}
+		hash = hashStateNode(stateLeafNode, proof.LeafKey, proof.LeafValue)
+	}
+	for i := len(proof.Siblings) - 1; i >= 0; i-- {
+		if len(proof.Siblings[i]) != StateHashLength {
+			return false
+		}
+		if bit(keyHash, i) == 0 {
+			hash = hashStateNode(stateBranchNode, hash, proof.Siblings[i])
+		} else {
+			hash = hashStateNode(stateBranchNode, proof.Siblings[i], hash)
+		}
+	}
+	return bytes.Equal(hash, root)
+}
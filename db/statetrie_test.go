@@ -0,0 +1,292 @@
+package db
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/stretchr/testify/require"
+)
+
+func newStateTestDB(t *testing.T, path string) *CacheMVCCDB {
+	os.RemoveAll(path)
+	mvccdb, err := NewMVCCDB(path)
+	require.Nil(t, err)
+	return mvccdb.(*CacheMVCCDB)
+}
+
+func TestStateRootDeterministic(t *testing.T) {
+	db1 := newStateTestDB(t, "state_test1")
+	defer os.RemoveAll("state_test1")
+	defer db1.Close()
+	db2 := newStateTestDB(t, "state_test2")
+	defer os.RemoveAll("state_test2")
+	defer db2.Close()
+
+	empty, err := db1.StateRoot()
+	require.Nil(t, err)
+	require.Equal(t, emptyStateHash, empty)
+
+	for i := 0; i < 100; i++ {
+		db1.Put("state", fmt.Sprintf("key%v", i), fmt.Sprintf("value%v", i))
+	}
+	db1.Commit()
+	for i := 99; i >= 0; i-- {
+		db2.Put("state", fmt.Sprintf("key%v", i), "dummy")
+	}
+	db2.Commit()
+	for i := 0; i < 100; i++ {
+		db2.Put("state", fmt.Sprintf("key%v", i), fmt.Sprintf("value%v", i))
+	}
+	db2.Put("state", "key100", "value100")
+	db2.Commit()
+
+	root1, err := db1.StateRoot()
+	require.Nil(t, err)
+	root2, err := db2.StateRoot()
+	require.Nil(t, err)
+	require.NotEqual(t, root1, root2)
+	require.Len(t, root1, StateHashLength)
+
+	db2.Del("state", "key100")
+	root2, err = db2.StateRoot()
+	require.Nil(t, err)
+	require.Equal(t, root1, root2)
+
+	for i := 0; i < 100; i++ {
+		db2.Del("state", fmt.Sprintf("key%v", i))
+	}
+	root2, err = db2.StateRoot()
+	require.Nil(t, err)
+	require.Equal(t, emptyStateHash, root2)
+
+	db2.Rollback()
+	root2, err = db2.StateRoot()
+	require.Nil(t, err)
+	require.NotEqual(t, root1, root2)
+}
+
+func TestStateRootCheckoutAndFlush(t *testing.T) {
+	mvccdb := newStateTestDB(t, "state_test3")
+	defer os.RemoveAll("state_test3")
+
+	mvccdb.Put("table01", "key01", "value01")
+	mvccdb.Tag("a")
+	rootA, err := mvccdb.StateRoot()
+	require.Nil(t, err)
+
+	mvccdb.Put("table01", "key02", "value02")
+	mvccdb.Tag("b")
+	rootB, err := mvccdb.StateRoot()
+	require.Nil(t, err)
+	require.NotEqual(t, rootA, rootB)
+
+	require.True(t, mvccdb.Checkout("a"))
+	root, err := mvccdb.StateRoot()
+	require.Nil(t, err)
+	require.Equal(t, rootA, root)
+
+	fork := mvccdb.Fork()
+	require.True(t, fork.Checkout("b"))
+	root, err = fork.StateRoot()
+	require.Nil(t, err)
+	require.Equal(t, rootB, root)
+
+	require.Nil(t, mvccdb.Flush("b"))
+	require.Nil(t, mvccdb.Close())
+
+	reopened, err := NewMVCCDB("state_test3")
+	require.Nil(t, err)
+	defer reopened.Close()
+	root, err = reopened.StateRoot()
+	require.Nil(t, err)
+	require.Equal(t, rootB, root)
+
+	reopened.Del("table01", "key02")
+	root, err = reopened.StateRoot()
+	require.Nil(t, err)
+	require.Equal(t, rootA, root)
+}
+
+func TestStateProof(t *testing.T) {
+	mvccdb := newStateTestDB(t, "state_test4")
+	defer os.RemoveAll("state_test4")
+	defer mvccdb.Close()
+
+	for i := 0; i < 50; i++ {
+		mvccdb.Put("state", fmt.Sprintf("key%v", i), fmt.Sprintf("value%v", i))
+	}
+	mvccdb.Commit()
+	root, err := mvccdb.StateRoot()
+	require.Nil(t, err)
+
+	for i := 0; i < 50; i++ {
+		key := fmt.Sprintf("key%v", i)
+		proof, err := mvccdb.Prove("state", key)
+		require.Nil(t, err)
+		require.True(t, VerifyStateProof(root, "state", key, fmt.Sprintf("value%v", i), true, proof))
+		require.False(t, VerifyStateProof(root, "state", key, "fake value", true, proof))
+		require.False(t, VerifyStateProof(root, "state", key, "", false, proof))
+	}
+
+	proof, err := mvccdb.Prove("state", "key50")
+	require.Nil(t, err)
+	require.True(t, VerifyStateProof(root, "state", "key50", "", false, proof))
+	require.False(t, VerifyStateProof(root, "state", "key50", "value50", true, proof))
+
+	_, err = mvccdb.Prove("", "key0")
+	require.Equal(t, ErrTableNotValid, err)
+}
+
+func TestStateRootBuild(t *testing.T) {
+	db1 := newStateTestDB(t, "state_test5")
+	defer os.RemoveAll("state_test5")
+	db2 := newStateTestDB(t, "state_test6")
+	defer os.RemoveAll("state_test6")
+	defer db2.Close()
+
+	_, err := db2.StateRoot()
+	require.Nil(t, err)
+	for i := 0; i < 100; i++ {
+		db1.Put("state", fmt.Sprintf("key%v", i), fmt.Sprintf("value%v", i))
+		db2.Put("state", fmt.Sprintf("key%v", i), fmt.Sprintf("value%v", i))
+	}
+	db1.Tag("a")
+	db2.Tag("a")
+	require.Nil(t, db1.Flush("a"))
+	require.Nil(t, db1.Close())
+
+	reopened, err := NewMVCCDB("state_test5")
+	require.Nil(t, err)
+	defer reopened.Close()
+	reopened.Put("state", "key100", "value100")
+	reopened.Del("state", "key0")
+	reopened.Commit()
+	db2.Put("state", "key100", "value100")
+	db2.Del("state", "key0")
+	db2.Commit()
+
+	root1, err := reopened.StateRoot()
+	require.Nil(t, err)
+	root2, err := db2.StateRoot()
+	require.Nil(t, err)
+	require.Equal(t, root2, root1)
+
+	proof, err := reopened.Prove("state", "key100")
+	require.Nil(t, err)
+	require.True(t, VerifyStateProof(root1, "state", "key100", "value100", true, proof))
+}
+
+func TestStateRootCommitError(t *testing.T) {
+	mvccdb := newStateTestDB(t, "state_test7")
+	defer os.RemoveAll("state_test7")
+	defer mvccdb.Close()
+
+	mvccdb.Put("state", "key0", "value0")
+	require.Nil(t, mvccdb.Tag("a"))
+	_, err := mvccdb.StateRoot()
+	require.Nil(t, err)
+
+	// the root points to a missing node, so the trie can't be updated
+	mvccdb.putStateRoot(common.Sha3([]byte("missing")))
+	mvccdb.Put("state", "key1", "value1")
+	require.NotNil(t, mvccdb.Commit())
+	require.NotNil(t, mvccdb.Tag("b"))
+	require.False(t, mvccdb.Checkout("b"))
+	require.True(t, mvccdb.Checkout("a"))
+}
+
+func countStateNodes(t *testing.T, mvccdb *CacheMVCCDB) (stored int, reachable int) {
+	iter := mvccdb.storage.NewIteratorByPrefix([]byte(string(SEPARATOR) + stateNodePrefix))
+	for iter.Next() {
+		stored++
+	}
+	iter.Release()
+	require.Nil(t, iter.Error())
+
+	root, err := mvccdb.StateRoot()
+	require.Nil(t, err)
+	trie := &stateTrie{store: mvccdb}
+	hashes := [][]byte{root}
+	for len(hashes) > 0 {
+		hash := hashes[len(hashes)-1]
+		hashes = hashes[:len(hashes)-1]
+		if isEmptyStateHash(hash) {
+			continue
+		}
+		n, err := trie.load(hash)
+		require.Nil(t, err)
+		reachable++
+		if n.kind == stateBranchNode {
+			hashes = append(hashes, n.left, n.right)
+		}
+	}
+	return stored, reachable
+}
+
+func TestStateTriePrune(t *testing.T) {
+	mvccdb := newStateTestDB(t, "state_test8")
+	defer os.RemoveAll("state_test8")
+	defer mvccdb.Close()
+	os.RemoveAll("state_test9")
+	defer os.RemoveAll("state_test9")
+	archive, err := NewArchiveMVCCDB("state_test9")
+	require.Nil(t, err)
+	defer archive.Close()
+
+	for i := 0; i < 100; i++ {
+		mvccdb.Put("state", fmt.Sprintf("key%v", i), "value")
+		archive.Put("state", fmt.Sprintf("key%v", i), "value")
+	}
+	_, err = mvccdb.StateRoot()
+	require.Nil(t, err)
+	_, err = archive.StateRoot()
+	require.Nil(t, err)
+	require.Nil(t, mvccdb.Tag("0"))
+	require.Nil(t, mvccdb.Flush("0"))
+	require.Nil(t, archive.Tag("0"))
+	require.Nil(t, archive.Flush("0"))
+	stored, reachable := countStateNodes(t, mvccdb)
+	require.Equal(t, reachable, stored)
+
+	roots := make([][]byte, 0)
+	for round := 1; round <= 20; round++ {
+		tag := fmt.Sprintf("%v", round)
+		for i := 0; i < 10; i++ {
+			mvccdb.Put("state", fmt.Sprintf("key%v", (round*7+i)%100), tag)
+			archive.Put("state", fmt.Sprintf("key%v", (round*7+i)%100), tag)
+		}
+		mvccdb.Del("state", fmt.Sprintf("key%v", round))
+		archive.Del("state", fmt.Sprintf("key%v", round))
+		root, err := mvccdb.StateRoot()
+		require.Nil(t, err)
+		roots = append(roots, root)
+		_, err = archive.StateRoot()
+		require.Nil(t, err)
+		require.Nil(t, mvccdb.Tag(tag))
+		require.Nil(t, archive.Tag(tag))
+		// the replaced nodes are only removed from the storage when the tag is flushed
+		if round%5 == 0 {
+			require.Nil(t, mvccdb.Flush(tag))
+			require.Nil(t, archive.Flush(tag))
+			stored, reachable := countStateNodes(t, mvccdb)
+			require.Equal(t, reachable, stored)
+		}
+	}
+	stored, _ = countStateNodes(t, mvccdb)
+	archived, reachable := countStateNodes(t, archive.(*CacheMVCCDB))
+	require.Equal(t, stored, reachable)
+	require.True(t, archived > 2*stored)
+
+	proof, err := mvccdb.Prove("state", "key40")
+	require.Nil(t, err)
+	require.True(t, VerifyStateProof(roots[len(roots)-1], "state", "key40", "20", true, proof))
+
+	// the nodes are kept in archive mode to prove the archived tags
+	hdb, err := archive.Historical("5")
+	require.Nil(t, err)
+	proof, err = hdb.Prove("state", "key35")
+	require.Nil(t, err)
+	require.True(t, VerifyStateProof(roots[4], "state", "key35", "5", true, proof))
+}
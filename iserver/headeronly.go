@@ -0,0 +1,87 @@
+package iserver
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/iost-official/go-iost/consensus/synchronizer"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/blockcache"
+	"github.com/iost-official/go-iost/core/global"
+	"github.com/iost-official/go-iost/ilog"
+	"github.com/iost-official/go-iost/p2p"
+)
+
+// newHeaderOnly returns a iserver application which only syncs block heads.
+func newHeaderOnly(bv global.BaseVariable, p2pService *p2p.NetService) *IServer {
+	headerChain, err := initHeaderChain(bv)
+	if err != nil {
+		ilog.Fatalf("headerchain initialization failed, stop the program! err:%v", err)
+	}
+
+	headerSync, err := synchronizer.NewHeaderSync(headerChain, p2pService)
+	if err != nil {
+		ilog.Fatalf("header synchronizer initialization failed, stop the program! err:%v", err)
+	}
+
+	return &IServer{
+		bv:          bv,
+		p2p:         p2pService,
+		headerChain: headerChain,
+		headerSync:  headerSync,
+	}
+}
+
+// initHeaderChain opens the header chain and pushes the genesis head into it if it's empty.
+func initHeaderChain(bv global.BaseVariable) (*block.HeaderChain, error) {
+	headerChain, err := block.NewHeaderChain(bv.Config().DB.LdbPath + "HeaderChainDB")
+	if err != nil {
+		return nil, err
+	}
+	if headerChain.Length() != 0 {
+		return headerChain, nil
+	}
+	blk, err := bv.BlockChain().GetBlockByNumber(0)
+	if err != nil {
+		return nil, fmt.Errorf("get genesis block failed. err: %v", err)
+	}
+	var wl blockcache.WitnessList
+	if err := wl.UpdatePending(bv.StateDB()); err != nil {
+		return nil, fmt.Errorf("get genesis witness list failed. err: %v", err)
+	}
+	wl.LibWitnessHandle()
+	wlBytes, err := proto.Marshal(&wl)
+	if err != nil {
+		return nil, err
+	}
+	if err := headerChain.Push(blk, wlBytes); err != nil {
+		return nil, err
+	}
+	return headerChain, nil
+}
+
+func (s *IServer) startHeaderOnly() error {
+	Services := []Service{
+		s.p2p,
+		s.headerSync,
+	}
+	for _, s := range Services {
+		if err := s.Start(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *IServer) stopHeaderOnly() {
+	Services := []Service{
+		s.headerSync,
+		s.p2p,
+	}
+	for _, s := range Services {
+		s.Stop()
+	}
+	s.headerChain.Close()
+	s.bv.BlockChain().Close()
+	s.bv.StateDB().Close()
+}
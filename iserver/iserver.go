@@ -5,6 +5,7 @@ import (
 	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/consensus"
 	"github.com/iost-official/go-iost/consensus/synchronizer"
+	"github.com/iost-official/go-iost/core/block"
 	"github.com/iost-official/go-iost/core/blockcache"
 	"github.com/iost-official/go-iost/core/global"
 	"github.com/iost-official/go-iost/core/txpool"
@@ -29,10 +30,14 @@ type IServer struct {
 	rpcServer *rpc.Server
 	consensus consensus.Consensus
 	debug     *DebugServer
+
+	headerChain *block.HeaderChain
+	headerSync  *synchronizer.HeaderSync
 }
 
 // New returns a iserver application
 func New(conf *common.Config) *IServer {
+	common.SetForks(conf.Fork)
 	bv, err := global.New(conf)
 	if err != nil {
 		ilog.Fatalf("create global failed. err=%v", err)
@@ -40,6 +45,13 @@ func New(conf *common.Config) *IServer {
 	if err := checkGenesis(bv); err != nil {
 		ilog.Fatalf("Check genesis failed: %v", err)
 	}
+	if conf.Sync != nil && conf.Sync.HeaderOnly {
+		p2pService, err := p2p.NewNetService(conf.P2P)
+		if err != nil {
+			ilog.Fatalf("network initialization failed, stop the program! err:%v", err)
+		}
+		return newHeaderOnly(bv, p2pService)
+	}
 	if err := recoverDB(bv); err != nil {
 		ilog.Fatalf("Recover DB failed: %v", err)
 	}
@@ -65,9 +77,13 @@ func New(conf *common.Config) *IServer {
 		ilog.Fatalf("txpool initialization failed, stop the program! err:%v", err)
 	}
 
-	consensus := consensus.New(consensus.Pob, acc, bv, blkCache, txp, p2pService)
+	cType, err := consensus.TypeOf(conf.Consensus)
+	if err != nil {
+		ilog.Fatalf("consensus initialization failed, stop the program! err:%v", err)
+	}
+	consensus := consensus.New(cType, acc, bv, blkCache, txp, p2pService)
 
-	rpcServer := rpc.New(txp, blkCache, bv, p2pService)
+	rpcServer := rpc.New(txp, blkCache, bv, p2pService, consensus)
 
 	sync, err := synchronizer.NewSynchronizer(bv, blkCache, p2pService)
 	if err != nil {
@@ -89,6 +105,9 @@ func New(conf *common.Config) *IServer {
 
 // Start starts iserver application.
 func (s *IServer) Start() error {
+	if s.headerSync != nil {
+		return s.startHeaderOnly()
+	}
 	Services := []Service{
 		s.p2p,
 		s.sync,
@@ -112,6 +131,10 @@ func (s *IServer) Start() error {
 
 // Stop stops iserver application.
 func (s *IServer) Stop() {
+	if s.headerSync != nil {
+		s.stopHeaderOnly()
+		return
+	}
 	conf := s.bv.Config()
 	if conf.Debug != nil {
 		s.debug.Stop()
@@ -18,6 +18,14 @@ func checkGenesis(bv global.BaseVariable) error {
 	conf := bv.Config()
 
 	blk, err := blockChain.GetBlockByNumber(0)
+	if err != nil && blockChain.Length() != 0 { // blockchaindb is imported from a snapshot
+		blk, err = blockChain.Top()
+		if err != nil {
+			return fmt.Errorf("get top block failed, stop the program. err: %v", err)
+		}
+		ilog.Infof("Started from snapshot of block %v", blk.Head.Number)
+		return nil
+	}
 	if err != nil { //blockchaindb is empty
 		ilog.Infof("Genesis is not exist.")
 		hash := stateDB.CurrentTag()
@@ -75,7 +83,10 @@ func recoverDB(bv global.BaseVariable) error {
 			return fmt.Errorf("verify block with VM failed, stop the pogram. err: %v", err)
 		}
 		parent = blk
-		stateDB.Tag(string(blk.HeadHash()))
+		err = stateDB.Tag(string(blk.HeadHash()))
+		if err != nil {
+			return fmt.Errorf("tag stateDB failed, stop the pogram. err: %v", err)
+		}
 		err = stateDB.Flush(string(blk.HeadHash()))
 		if err != nil {
 			return fmt.Errorf("flush stateDB failed, stop the pogram. err: %v", err)
@@ -0,0 +1,177 @@
+package iserver
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/db"
+	"github.com/iost-official/go-iost/ilog"
+)
+
+// A snapshot file is the irreversible block followed by the state snapshot of db at that block
+// and the delay txs which haven't been executed at that block.
+// The block is read first, so that the state can be checked against the trusted block before it's used.
+// The same bufio reader and writer are shared with db, so that db doesn't read ahead of its part.
+
+const maxSnapshotBlockSize = 1 << 28
+
+func writeSnapshotBytes(w *bufio.Writer, b []byte) error {
+	buf := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(buf, uint64(len(b)))
+	if _, err := w.Write(buf[:n]); err != nil {
+		return err
+	}
+	_, err := w.Write(b)
+	return err
+}
+
+func readSnapshotBytes(r *bufio.Reader) ([]byte, error) {
+	l, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if l > maxSnapshotBlockSize {
+		return nil, db.ErrSnapshotInvalid
+	}
+	b := make([]byte, l)
+	_, err = io.ReadFull(r, b)
+	return b, err
+}
+
+// ExportSnapshot writes the snapshot of the last irreversible block to file. The iserver must be stopped.
+func ExportSnapshot(conf *common.Config, file string) error {
+	chain, err := block.NewBlockChain(conf.DB.LdbPath + "BlockChainDB")
+	if err != nil {
+		return err
+	}
+	defer chain.Close()
+
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	w := bufio.NewWriter(f)
+
+	stateDB, err := db.NewMVCCDB(conf.DB.LdbPath + "StateDB")
+	if err != nil {
+		return err
+	}
+	tag := stateDB.CurrentTag()
+	stateDB.Close()
+	blk, err := chain.GetBlockByHash([]byte(tag))
+	if err != nil {
+		return fmt.Errorf("get block of state failed: %v", err)
+	}
+	blkBytes, err := blk.Encode()
+	if err != nil {
+		return err
+	}
+	if err := writeSnapshotBytes(w, blkBytes); err != nil {
+		return err
+	}
+	stateTag, err := db.ExportSnapshot(conf.DB.LdbPath+"StateDB", w)
+	if err != nil {
+		return fmt.Errorf("export state failed: %v", err)
+	}
+	if stateTag != tag {
+		return fmt.Errorf("state changed while exporting")
+	}
+
+	delaytxs, err := chain.AllDelaytx()
+	if err != nil {
+		return err
+	}
+	for _, t := range delaytxs {
+		b, err := chain.GetBlockByTxHash(t.Hash())
+		if err != nil {
+			return fmt.Errorf("get block of delay tx failed: %v", err)
+		}
+		if err := writeSnapshotBytes(w, b.HeadHash()); err != nil {
+			return err
+		}
+		if err := writeSnapshotBytes(w, t.Encode()); err != nil {
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	ilog.Infof("Exported snapshot of block %v, hash: %v, delay txs: %v",
+		blk.Head.Number, common.Base58Encode(blk.HeadHash()), len(delaytxs))
+	return nil
+}
+
+// ImportSnapshot seeds the empty databases from the snapshot file of the trusted block hash.
+// The block of the snapshot must have a state root, which the imported state is checked against.
+func ImportSnapshot(conf *common.Config, file string, blockHash string) error {
+	common.SetForks(conf.Fork)
+	chain, err := block.NewBlockChain(conf.DB.LdbPath + "BlockChainDB")
+	if err != nil {
+		return err
+	}
+	defer chain.Close()
+	if chain.Length() != 0 {
+		return fmt.Errorf("blockchaindb is not empty")
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	r := bufio.NewReader(f)
+
+	blkBytes, err := readSnapshotBytes(r)
+	if err != nil {
+		return fmt.Errorf("read block failed: %v", err)
+	}
+	var blk block.Block
+	if err := blk.Decode(blkBytes); err != nil {
+		return err
+	}
+	if common.Base58Encode(blk.HeadHash()) != blockHash {
+		return fmt.Errorf("block hash %v doesn't match the trusted hash", common.Base58Encode(blk.HeadHash()))
+	}
+	if blk.Head.Version < block.V1 {
+		return fmt.Errorf("block %v has no state root to verify the state", blk.Head.Number)
+	}
+	err = db.ImportSnapshot(conf.DB.LdbPath+"StateDB", r, string(blk.HeadHash()), blk.Head.StateRoot)
+	if err != nil {
+		return fmt.Errorf("import state failed: %v", err)
+	}
+	if err := chain.Push(&blk); err != nil {
+		return err
+	}
+
+	var count int
+	for ; ; count++ {
+		blkHash, err := readSnapshotBytes(r)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("read delay tx failed: %v", err)
+		}
+		txBytes, err := readSnapshotBytes(r)
+		if err != nil {
+			return fmt.Errorf("read delay tx failed: %v", err)
+		}
+		var t tx.Tx
+		if err := t.Decode(txBytes); err != nil {
+			return err
+		}
+		if err := chain.PutDelaytx(&t, blkHash); err != nil {
+			return err
+		}
+	}
+	ilog.Infof("Imported snapshot of block %v, hash: %v, delay txs: %v",
+		blk.Head.Number, common.Base58Encode(blk.HeadHash()), count)
+	return nil
+}
@@ -0,0 +1,35 @@
+package iwallet
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	historyOffset int64
+	historyLimit  int64
+)
+
+// historyCmd represents the account history command
+var historyCmd = &cobra.Command{
+	Use:   "history",
+	Short: "list transactions of an account",
+	Long:  `list the irreversible transactions published, signed or received by an account, the node must enable the account index`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+		txs, err := sdk.GetAccountTransactions(args[0], historyOffset, historyLimit)
+		if err != nil {
+			fmt.Println(err.Error())
+			return
+		}
+		fmt.Println(marshalTextString(txs))
+		return nil
+	},
+}
+
+func init() {
+	accountCmd.AddCommand(historyCmd)
+	historyCmd.Flags().Int64VarP(&historyOffset, "offset", "", 0, "skip the first $offset transactions")
+	historyCmd.Flags().Int64VarP(&historyLimit, "limit", "", 20, "list at most $limit transactions")
+}
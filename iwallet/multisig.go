@@ -0,0 +1,371 @@
+package iwallet
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/iost-official/go-iost/rpc/pb"
+	"github.com/spf13/cobra"
+)
+
+// A partially signed transaction is saved as the json of rpcpb.TransactionRequest, the same message sent by
+// "call". The signatures of signers are collected into "signatures", and the ones of the sponsor into "sponsor_sigs".
+// The signers sign the tx content, while the sponsor and the publisher sign the content with the signatures of signers,
+// so the sponsor should sign after all the signers, and the publisher signs it last when publishing.
+// The sponsor signs the name of the publisher as well, so the publisher is fixed in the file when a sponsor is set.
+// The time and expiration are signed by everyone, so they are fixed at creation. The tx can only be published in the
+// window from its time to its expiration, which is no longer than the max expiration of chain after its time.
+
+var (
+	multisigSigners         []string
+	multisigSponsor         string
+	multisigPublisher       string
+	multisigSponsorGasLimit float64
+	multisigSponsorRAMLimit int64
+	multisigTime            string
+	multisigAsSponsor       bool
+	multisigOffline         bool
+)
+
+func loadPartialTx(path string) (*rpcpb.TransactionRequest, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	t := &rpcpb.TransactionRequest{}
+	if err := jsonpb.Unmarshal(bytes.NewReader(b), t); err != nil {
+		return nil, fmt.Errorf("invalid partially signed tx file %v: %v", path, err)
+	}
+	return t, nil
+}
+
+func savePartialTx(path string, t *rpcpb.TransactionRequest) error {
+	return ioutil.WriteFile(path, []byte(marshalTextString(t)), 0644)
+}
+
+func verifyTxSig(sig *rpcpb.Signature, data []byte) bool {
+	return crypto.Algorithm(sig.Algorithm).Verify(common.Sha3(data), sig.PublicKey, sig.Signature)
+}
+
+// addTxSig adds the signature to sigs, replacing the one of the same key.
+func addTxSig(sigs []*rpcpb.Signature, sig *rpcpb.Signature) []*rpcpb.Signature {
+	for i, s := range sigs {
+		if bytes.Equal(s.PublicKey, sig.PublicKey) {
+			sigs[i] = sig
+			return sigs
+		}
+	}
+	return append(sigs, sig)
+}
+
+// txValidity returns the window in which the tx can be published, the same as the txpool checks it:
+// the tx is accepted 1 second before its time at most, and expires at its expiration or max expiration after its time.
+func txValidity(t *rpcpb.TransactionRequest, maxExpiration int64) (from, to int64) {
+	from = t.Time - int64(time.Second)
+	to = t.Time + maxExpiration
+	if t.Expiration < to {
+		to = t.Expiration
+	}
+	return from, to
+}
+
+// checkTxValidity prints the validity window of the tx, and returns an error if the tx can't be published at now.
+func checkTxValidity(t *rpcpb.TransactionRequest, maxExpiration int64, now int64) error {
+	from, to := txValidity(t, maxExpiration)
+	fmt.Printf("valid from %v to %v\n", time.Unix(0, from).Format(time.RFC3339), time.Unix(0, to).Format(time.RFC3339))
+	if now < from {
+		return fmt.Errorf("tx is not valid yet, %v to wait", time.Duration(from-now).Round(time.Second))
+	}
+	if now >= to {
+		return fmt.Errorf("tx is expired %v ago", time.Duration(now-to).Round(time.Second))
+	}
+	fmt.Printf("%v remaining to publish\n", time.Duration(to-now).Round(time.Second))
+	return nil
+}
+
+// checkTxSigs returns the key ids of valid signatures of signers, and an error if any signature is invalid.
+func checkTxSigs(t *rpcpb.TransactionRequest) (map[string]bool, error) {
+	keys := make(map[string]bool)
+	for _, sig := range t.Signatures {
+		if !verifyTxSig(sig, txToBytes(t, false)) {
+			return nil, fmt.Errorf("invalid signature of key %v", account.GetIDByPubkey(sig.PublicKey))
+		}
+		keys[account.GetIDByPubkey(sig.PublicKey)] = true
+	}
+	for _, sig := range t.SponsorSigs {
+		if !verifyTxSig(sig, sponsorBytes(t)) {
+			return nil, fmt.Errorf("invalid sponsor signature of key %v, the sponsor should sign after all the signers", account.GetIDByPubkey(sig.PublicKey))
+		}
+	}
+	return keys, nil
+}
+
+// authWeight returns the weight of the permission satisfied by the keys, the same as host.Auth does on chain.
+func (s *SDK) authWeight(id, perm string, keys map[string]bool, reenter map[string]bool) (weight int64, threshold int64, err error) {
+	if reenter[id+"@"+perm] {
+		return 0, 1, nil
+	}
+	reenter[id+"@"+perm] = true
+	acc, err := s.getAccountInfo(id)
+	if err != nil {
+		return 0, 0, err
+	}
+	p, ok := acc.Permissions[perm]
+	if !ok {
+		p, ok = acc.Permissions["active"]
+		if !ok {
+			return 0, 0, fmt.Errorf("permission %v not found of account %v", perm, id)
+		}
+	}
+	items := p.Items
+	for _, g := range p.Groups {
+		if grp, ok := acc.Groups[g]; ok {
+			items = append(items, grp.Items...)
+		}
+	}
+	for _, item := range items {
+		if item.IsKeyPair {
+			if keys[item.Id] {
+				weight += item.Weight
+			}
+			continue
+		}
+		w, th, err := s.authWeight(item.Id, item.Permission, keys, reenter)
+		if err != nil {
+			return 0, 0, err
+		}
+		if w >= th {
+			weight += item.Weight
+		}
+	}
+	return weight, p.Threshold, nil
+}
+
+var multisigCmd = &cobra.Command{
+	Use:   "multisig",
+	Short: "Assemble a transaction signed by multiple signers offline",
+	Long: `Assemble a transaction signed by multiple signers offline
+	the transaction is saved in a partially signed tx file, which is the json of the transaction request.
+	the file is passed to each signer, who adds the signature with "multisig sign" on its own machine.
+	at last the publisher publishes it with "multisig publish".
+	example:
+	./iwallet multisig create tx.json --time 2006-01-02T15:00:00+08:00 --expiration 90 --signers treasury@active "token.iost" "transfer" '["iost","treasury","bob","100",""]'
+	./iwallet multisig sign tx.json --account alice
+	./iwallet multisig inspect tx.json
+	./iwallet multisig publish tx.json --account bob`,
+}
+
+var multisigCreateCmd = &cobra.Command{
+	Use:   "create file contract_name0 function_name0 parameters0 ...",
+	Short: "Create a partially signed tx file without any signature",
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+		if len(args) < 4 || (len(args)-1)%3 != 0 {
+			return fmt.Errorf("file and actions should be given, and number of action args should be a multiplier of 3")
+		}
+		actions := make([]*rpcpb.Action, 0, (len(args)-1)/3)
+		for i := 1; i < len(args); i += 3 {
+			actions = append(actions, NewAction(args[i], args[i+1], args[i+2]))
+		}
+		for _, signer := range multisigSigners {
+			if len(strings.Split(signer, "@")) != 2 {
+				return fmt.Errorf("illegal signer %v, should be like account@permission", signer)
+			}
+		}
+		trx, err := sdk.createTx(actions)
+		if err != nil {
+			return err
+		}
+		if multisigTime != "" {
+			t, err := time.Parse(time.RFC3339, multisigTime)
+			if err != nil {
+				return fmt.Errorf("invalid time %v, should be like 2006-01-02T15:04:05Z07:00: %v", multisigTime, err)
+			}
+			trx.Time = t.UnixNano()
+		}
+		if sdk.expiration <= 0 {
+			return fmt.Errorf("expiration should be positive")
+		}
+		trx.Expiration = trx.Time + sdk.expiration*int64(time.Second)
+		trx.Signers = multisigSigners
+		trx.Sponsor = multisigSponsor
+		trx.Publisher = multisigPublisher
+		if multisigSponsor != "" {
+			if multisigPublisher == "" {
+				return fmt.Errorf("publisher should be given when the tx has a sponsor")
+			}
+			trx.SponsorGasLimit = multisigSponsorGasLimit
+			trx.SponsorRamLimit = multisigSponsorRAMLimit
+		}
+		if err := savePartialTx(args[0], trx); err != nil {
+			return err
+		}
+		fmt.Println("partially signed tx is saved at:", args[0])
+		from, to := txValidity(trx, tx.MaxExpiration)
+		fmt.Printf("it should be published from %v to %v, or earlier if the max expiration of chain is shorter\n",
+			time.Unix(0, from).Format(time.RFC3339), time.Unix(0, to).Format(time.RFC3339))
+		return nil
+	},
+}
+
+var multisigSignCmd = &cobra.Command{
+	Use:   "sign file",
+	Short: "Add the signature of the account to a partially signed tx file",
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+		if len(args) < 1 {
+			return fmt.Errorf("partially signed tx file not given")
+		}
+		trx, err := loadPartialTx(args[0])
+		if err != nil {
+			return err
+		}
+		if _, err := checkTxSigs(trx); err != nil {
+			return err
+		}
+		if err := sdk.loadAccount(); err != nil {
+			return fmt.Errorf("load account err %v", err)
+		}
+		if multisigAsSponsor {
+			if trx.Sponsor != sdk.accountName {
+				return fmt.Errorf("account %v is not the sponsor %v", sdk.accountName, trx.Sponsor)
+			}
+			trx.SponsorSigs = addTxSig(trx.SponsorSigs, sdk.signData(sponsorBytes(trx)))
+		} else {
+			if len(trx.SponsorSigs) > 0 {
+				return fmt.Errorf("tx is already signed by the sponsor, signers should sign before it")
+			}
+			trx.Signatures = addTxSig(trx.Signatures, sdk.signData(txToBytes(trx, false)))
+		}
+		if err := savePartialTx(args[0], trx); err != nil {
+			return err
+		}
+		fmt.Printf("signature of %v is added to %v\n", sdk.accountName, args[0])
+		return nil
+	},
+}
+
+var multisigInspectCmd = &cobra.Command{
+	Use:   "inspect file",
+	Short: "Show a partially signed tx file and the signers still missing",
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+		if len(args) < 1 {
+			return fmt.Errorf("partially signed tx file not given")
+		}
+		trx, err := loadPartialTx(args[0])
+		if err != nil {
+			return err
+		}
+		fmt.Println(marshalTextString(trx))
+		keys, err := checkTxSigs(trx)
+		if err != nil {
+			return err
+		}
+		for key := range keys {
+			fmt.Println("signed by key:", key)
+		}
+		if trx.Sponsor != "" {
+			fmt.Printf("sponsor %v signed: %v\n", trx.Sponsor, len(trx.SponsorSigs) > 0)
+		}
+		if multisigOffline {
+			if err := checkTxValidity(trx, tx.MaxExpiration, time.Now().UnixNano()); err != nil {
+				fmt.Println(err)
+			}
+			return nil
+		}
+		limits, err := sdk.getTxLimits()
+		if err != nil {
+			return fmt.Errorf("get tx limits error %v", err)
+		}
+		if err := checkTxValidity(trx, limits.MaxExpiration, sdk.now()); err != nil {
+			fmt.Println(err)
+		}
+		missing := 0
+		for _, signer := range trx.Signers {
+			ss := strings.Split(signer, "@")
+			if len(ss) != 2 {
+				return fmt.Errorf("illegal signer: %v", signer)
+			}
+			weight, threshold, err := sdk.authWeight(ss[0], ss[1], keys, make(map[string]bool))
+			if err != nil {
+				return err
+			}
+			if weight >= threshold {
+				fmt.Printf("signer %v: satisfied\n", signer)
+			} else {
+				missing++
+				fmt.Printf("signer %v: missing, weight %v < threshold %v\n", signer, weight, threshold)
+			}
+		}
+		fmt.Printf("%v of %v signers missing\n", missing, len(trx.Signers))
+		return nil
+	},
+}
+
+var multisigPublishCmd = &cobra.Command{
+	Use:   "publish file",
+	Short: "Sign a partially signed tx file as the publisher and send it",
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+		if len(args) < 1 {
+			return fmt.Errorf("partially signed tx file not given")
+		}
+		trx, err := loadPartialTx(args[0])
+		if err != nil {
+			return err
+		}
+		if _, err := checkTxSigs(trx); err != nil {
+			return err
+		}
+		if trx.Sponsor != "" && len(trx.SponsorSigs) == 0 {
+			return fmt.Errorf("tx is not signed by the sponsor %v", trx.Sponsor)
+		}
+		limits, err := sdk.getTxLimits()
+		if err != nil {
+			return fmt.Errorf("get tx limits error %v", err)
+		}
+		if err := checkTxValidity(trx, limits.MaxExpiration, sdk.now()); err != nil {
+			return err
+		}
+		if err := sdk.loadAccount(); err != nil {
+			return fmt.Errorf("load account err %v", err)
+		}
+		if trx.Sponsor != "" && trx.Publisher != sdk.accountName {
+			return fmt.Errorf("account %v is not the publisher %v signed by the sponsor", sdk.accountName, trx.Publisher)
+		}
+		stx, err := sdk.signTx(trx)
+		if err != nil {
+			return fmt.Errorf("sign tx error %v", err)
+		}
+		txHash, err := sdk.sendTx(stx)
+		if err != nil {
+			return fmt.Errorf("send tx error %v", err)
+		}
+		fmt.Println("the transaction hash is:", txHash)
+		if sdk.checkResult {
+			if !sdk.checkTransaction(txHash) {
+				return fmt.Errorf("check transaction failed")
+			}
+		}
+		return nil
+	},
+}
+
+func init() {
+	multisigCreateCmd.Flags().StringSliceVarP(&multisigSigners, "signers", "", []string{}, "signers of the tx, eg treasury@active,alice@active")
+	multisigCreateCmd.Flags().StringVarP(&multisigSponsor, "sponsor", "", "", "sponsor paying the gas and ram of the tx")
+	multisigCreateCmd.Flags().StringVarP(&multisigTime, "time", "", "", "time of the tx in RFC3339, eg 2006-01-02T15:04:05+08:00, now if not given. the tx can't be published before it, and --expiration is counted from it")
+	multisigCreateCmd.Flags().StringVarP(&multisigPublisher, "publisher", "", "", "publisher of the tx, required when the tx has a sponsor")
+	multisigCreateCmd.Flags().Float64VarP(&multisigSponsorGasLimit, "sponsorGasLimit", "", 50000, "max gas limit the sponsor pays")
+	multisigCreateCmd.Flags().Int64VarP(&multisigSponsorRAMLimit, "sponsorRAMLimit", "", 0, "max ram in bytes the sponsor pays")
+	multisigSignCmd.Flags().BoolVarP(&multisigAsSponsor, "asSponsor", "", false, "sign as the sponsor of the tx, after all the signers")
+	multisigInspectCmd.Flags().BoolVarP(&multisigOffline, "offline", "", false, "do not query the permissions of signers from the server")
+
+	multisigCmd.AddCommand(multisigCreateCmd, multisigSignCmd, multisigInspectCmd, multisigPublishCmd)
+	rootCmd.AddCommand(multisigCmd)
+}
@@ -0,0 +1,172 @@
+package iwallet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iost-official/go-iost/account"
+	"github.com/iost-official/go-iost/core/contract"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/crypto"
+	"github.com/iost-official/go-iost/rpc/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func toTestSig(s *rpcpb.Signature) *crypto.Signature {
+	return &crypto.Signature{
+		Algorithm: crypto.Algorithm(s.Algorithm),
+		Sig:       s.Signature,
+		Pubkey:    s.PublicKey,
+	}
+}
+
+// toTestTx converts the request to tx.Tx the same as the rpc server does.
+func toTestTx(t *rpcpb.TransactionRequest) *tx.Tx {
+	ret := &tx.Tx{
+		Time:            t.Time,
+		Expiration:      t.Expiration,
+		GasRatio:        int64(t.GasRatio * 100),
+		GasLimit:        int64(t.GasLimit * 100),
+		Delay:           t.Delay,
+		Signers:         t.Signers,
+		Publisher:       t.Publisher,
+		Sequence:        t.Sequence,
+		Sponsor:         t.Sponsor,
+		SponsorGasLimit: int64(t.SponsorGasLimit * 100),
+		SponsorRAMLimit: t.SponsorRamLimit,
+		RecurInterval:   t.RecurInterval,
+		RecurCount:      t.RecurCount,
+	}
+	for _, a := range t.Actions {
+		ret.Actions = append(ret.Actions, tx.NewAction(a.Contract, a.ActionName, a.Data))
+	}
+	for _, a := range t.AmountLimit {
+		ret.AmountLimit = append(ret.AmountLimit, &contract.Amount{Token: a.Token, Val: a.Value})
+	}
+	for _, s := range t.Signatures {
+		ret.Signs = append(ret.Signs, toTestSig(s))
+	}
+	for _, s := range t.PublisherSigs {
+		ret.PublishSigns = append(ret.PublishSigns, toTestSig(s))
+	}
+	for _, s := range t.SponsorSigs {
+		ret.SponsorSigns = append(ret.SponsorSigns, toTestSig(s))
+	}
+	return ret
+}
+
+func newTestSDK(t *testing.T, name string) *SDK {
+	kp, err := account.NewKeyPair(nil, crypto.Ed25519)
+	assert.Nil(t, err)
+	s := &SDK{signAlgo: "ed25519"}
+	s.SetAccount(name, kp)
+	return s
+}
+
+func TestTxToBytes(t *testing.T) {
+	signer := newTestSDK(t, "treasury")
+	now := time.Now().UnixNano()
+	trx := &rpcpb.TransactionRequest{
+		Time:        now,
+		Expiration:  now + 90*int64(time.Second),
+		GasRatio:    1.5,
+		GasLimit:    100000,
+		Delay:       10 * int64(time.Second),
+		Signers:     []string{"treasury@active"},
+		Actions:     []*rpcpb.Action{NewAction("token.iost", "transfer", `["iost","treasury","bob","100",""]`)},
+		AmountLimit: []*rpcpb.AmountLimit{{Token: "iost", Value: "100"}},
+		Sequence:    3,
+	}
+	check := func() {
+		trx.Signatures = nil
+		assert.Equal(t, toTestTx(trx).ToBytes(tx.Base), txToBytes(trx, false))
+		trx.Signatures = []*rpcpb.Signature{signer.signData(txToBytes(trx, false))}
+		assert.Equal(t, toTestTx(trx).ToBytes(tx.Publish), txToBytes(trx, true))
+	}
+	check()
+
+	trx.Sponsor = "bob"
+	trx.SponsorGasLimit = 200000
+	trx.SponsorRamLimit = 1000
+	check()
+
+	trx.RecurInterval = 60 * int64(time.Second)
+	trx.RecurCount = 5
+	check()
+}
+
+func TestMultisigSigs(t *testing.T) {
+	signer := newTestSDK(t, "treasury")
+	sponsor := newTestSDK(t, "bob")
+	publisher := newTestSDK(t, "alice")
+	now := time.Now().UnixNano()
+	trx := &rpcpb.TransactionRequest{
+		Time:            now,
+		Expiration:      now + 90*int64(time.Second),
+		GasRatio:        1,
+		GasLimit:        100000,
+		Signers:         []string{"treasury@active"},
+		Actions:         []*rpcpb.Action{NewAction("token.iost", "transfer", `["iost","treasury","bob","100",""]`)},
+		Publisher:       "alice",
+		Sponsor:         "bob",
+		SponsorGasLimit: 100000,
+		RecurInterval:   60 * int64(time.Second),
+		RecurCount:      2,
+		Delay:           60 * int64(time.Second),
+	}
+	trx.Signatures = addTxSig(trx.Signatures, signer.signData(txToBytes(trx, false)))
+	trx.SponsorSigs = addTxSig(trx.SponsorSigs, sponsor.signData(sponsorBytes(trx)))
+	keys, err := checkTxSigs(trx)
+	assert.Nil(t, err)
+	assert.Len(t, keys, 1)
+
+	stx, err := publisher.signTx(trx)
+	assert.Nil(t, err)
+	coreTx := toTestTx(stx)
+	assert.Nil(t, coreTx.VerifySelf())
+	assert.True(t, coreTx.VerifySigner(coreTx.Signs[0]))
+
+	// the sponsor signature is not valid for another publisher
+	other := newTestSDK(t, "mallory")
+	stx, err = other.signTx(trx)
+	assert.Nil(t, err)
+	assert.NotNil(t, toTestTx(stx).VerifySelf())
+	_, err = checkTxSigs(stx)
+	assert.NotNil(t, err)
+}
+
+func TestTxValidity(t *testing.T) {
+	now := time.Now().UnixNano()
+	trx := &rpcpb.TransactionRequest{Time: now, Expiration: now + 300*int64(time.Second)}
+	from, to := txValidity(trx, tx.MaxExpiration)
+	assert.Equal(t, now-int64(time.Second), from)
+	assert.Equal(t, now+tx.MaxExpiration, to)
+	assert.Nil(t, checkTxValidity(trx, tx.MaxExpiration, now))
+
+	trx.Expiration = now + 30*int64(time.Second)
+	_, to = txValidity(trx, tx.MaxExpiration)
+	assert.Equal(t, trx.Expiration, to)
+
+	trx.Time = now + 60*int64(time.Second)
+	trx.Expiration = trx.Time + 60*int64(time.Second)
+	assert.NotNil(t, checkTxValidity(trx, tx.MaxExpiration, now))
+
+	trx.Time = now - 120*int64(time.Second)
+	trx.Expiration = now - 30*int64(time.Second)
+	assert.NotNil(t, checkTxValidity(trx, tx.MaxExpiration, now))
+
+	// the tx stamped with the node clock warped an hour forward is valid by the node clock only
+	warped := now + int64(time.Hour)
+	trx.Time = warped
+	trx.Expiration = warped + 60*int64(time.Second)
+	assert.NotNil(t, checkTxValidity(trx, tx.MaxExpiration, now))
+	assert.Nil(t, checkTxValidity(trx, tx.MaxExpiration, warped))
+}
+
+func TestSDKNow(t *testing.T) {
+	s := &SDK{server: "127.0.0.1:1"}
+	before := time.Now().UnixNano()
+	now := s.now()
+	assert.True(t, now >= before)
+	assert.True(t, now <= time.Now().UnixNano())
+}
@@ -0,0 +1,118 @@
+package iwallet
+
+import (
+	"fmt"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/merkletree"
+	"github.com/iost-official/go-iost/rpc/pb"
+	"github.com/spf13/cobra"
+)
+
+var (
+	trustedBlockHash string
+	receiptHash      string
+)
+
+// VerifyMerkleProof checks that the leaf of the proof is included in the merkle tree with the given root.
+func VerifyMerkleProof(proof *rpcpb.MerkleProofResponse, root string) (bool, error) {
+	if proof == nil || proof.LeafHash == "" {
+		return false, fmt.Errorf("empty merkle proof")
+	}
+	mp := make([][]byte, 0, len(proof.MerklePath))
+	for _, p := range proof.MerklePath {
+		mp = append(mp, common.Base58Decode(p))
+	}
+	m := merkletree.MerkleTree{}
+	return m.MerkleProve(common.Base58Decode(proof.LeafHash), common.Base58Decode(root), mp)
+}
+
+// verifyBlockHead decodes the block head of the proof and checks that its hash is the trusted block hash.
+func verifyBlockHead(blockHash string, proof *rpcpb.MerkleProofResponse) (*block.BlockHead, error) {
+	if proof == nil || proof.BlockHead == "" {
+		return nil, fmt.Errorf("block head of merkle proof not given")
+	}
+	head := &block.BlockHead{}
+	if err := head.Decode(common.Base58Decode(proof.BlockHead)); err != nil {
+		return nil, err
+	}
+	hash, err := head.Hash()
+	if err != nil {
+		return nil, err
+	}
+	if common.Base58Encode(hash) != blockHash {
+		return nil, fmt.Errorf("block head hash %v is not the trusted block hash %v", common.Base58Encode(hash), blockHash)
+	}
+	return head, nil
+}
+
+// VerifyTxProof checks that the transaction is included in the block of the trusted hash.
+func VerifyTxProof(txHash string, blockHash string, proof *rpcpb.MerkleProofResponse) (bool, error) {
+	head, err := verifyBlockHead(blockHash, proof)
+	if err != nil {
+		return false, err
+	}
+	if proof.LeafHash != txHash {
+		return false, fmt.Errorf("merkle proof is for %v, not for tx %v", proof.LeafHash, txHash)
+	}
+	return VerifyMerkleProof(proof, common.Base58Encode(head.TxMerkleHash))
+}
+
+// VerifyTxReceiptProof checks that the transaction receipt is included in the block of the trusted hash.
+func VerifyTxReceiptProof(receiptHash string, blockHash string, proof *rpcpb.MerkleProofResponse) (bool, error) {
+	head, err := verifyBlockHead(blockHash, proof)
+	if err != nil {
+		return false, err
+	}
+	if proof.LeafHash != receiptHash {
+		return false, fmt.Errorf("merkle proof is for %v, not for receipt %v", proof.LeafHash, receiptHash)
+	}
+	return VerifyMerkleProof(proof, common.Base58Encode(head.TxReceiptMerkleHash))
+}
+
+// proofCmd represents the proof command
+var proofCmd = &cobra.Command{
+	Use:   "proof",
+	Short: "fetch and verify the merkle proof of a transaction",
+	Long:  `fetch the merkle proof of an irreversible transaction (or its receipt) and verify it against the head of the block whose hash is trusted`,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+		if trustedBlockHash == "" {
+			return fmt.Errorf("trusted block hash should be given by --block")
+		}
+		var proof *rpcpb.MerkleProofResponse
+		var ok bool
+		if receiptHash != "" {
+			proof, err = sdk.GetTxReceiptProof(args[0])
+			if err != nil {
+				fmt.Println(err.Error())
+				return
+			}
+			ok, err = VerifyTxReceiptProof(receiptHash, trustedBlockHash, proof)
+		} else {
+			proof, err = sdk.GetTxProof(args[0])
+			if err != nil {
+				fmt.Println(err.Error())
+				return
+			}
+			ok, err = VerifyTxProof(args[0], trustedBlockHash, proof)
+		}
+		fmt.Println(marshalTextString(proof))
+		if err != nil {
+			fmt.Println("verify merkle proof failed:", err)
+			return
+		}
+		if !ok {
+			return fmt.Errorf("merkle proof is invalid")
+		}
+		fmt.Println("merkle proof is valid")
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(proofCmd)
+	proofCmd.Flags().StringVarP(&trustedBlockHash, "block", "b", "", "the trusted hash of the block containing the transaction")
+	proofCmd.Flags().StringVarP(&receiptHash, "receipt", "r", "", "verify the proof of the transaction receipt of this hash instead of the transaction")
+}
@@ -0,0 +1,61 @@
+package iwallet
+
+import (
+	"testing"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/block"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/rpc/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestProof(t *testing.T, blk *block.Block, leaf []byte, mp [][]byte) *rpcpb.MerkleProofResponse {
+	head, err := blk.Head.Encode()
+	assert.Nil(t, err)
+	proof := &rpcpb.MerkleProofResponse{
+		LeafHash:  common.Base58Encode(leaf),
+		BlockHead: common.Base58Encode(head),
+	}
+	for _, p := range mp {
+		proof.MerklePath = append(proof.MerklePath, common.Base58Encode(p))
+	}
+	return proof
+}
+
+func TestVerifyTxProof(t *testing.T) {
+	blk := &block.Block{Head: &block.BlockHead{Number: 10, Witness: "witness"}}
+	for i := int64(0); i < 3; i++ {
+		trx := tx.NewTx(nil, nil, 10000, 100, i, 0)
+		blk.Txs = append(blk.Txs, trx)
+		blk.Receipts = append(blk.Receipts, tx.NewTxReceipt(trx.Hash()))
+	}
+	blk.Head.TxMerkleHash = blk.CalculateTxMerkleHash()
+	blk.Head.TxReceiptMerkleHash = blk.CalculateTxReceiptMerkleHash()
+	assert.Nil(t, blk.CalculateHeadHash())
+	blockHash := common.Base58Encode(blk.HeadHash())
+
+	txHash := blk.Txs[1].Hash()
+	mp, err := blk.TxMerklePath(txHash)
+	assert.Nil(t, err)
+	proof := newTestProof(t, blk, txHash, mp)
+	ok, err := VerifyTxProof(common.Base58Encode(txHash), blockHash, proof)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	_, err = VerifyTxProof(common.Base58Encode(blk.Txs[0].Hash()), blockHash, proof)
+	assert.NotNil(t, err)
+
+	receiptHash, mp, err := blk.TxReceiptMerklePath(txHash)
+	assert.Nil(t, err)
+	proof = newTestProof(t, blk, receiptHash, mp)
+	ok, err = VerifyTxReceiptProof(common.Base58Encode(receiptHash), blockHash, proof)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	forged := &block.Block{Head: &block.BlockHead{Number: 10, Witness: "witness"}}
+	forged.Txs = []*tx.Tx{blk.Txs[1]}
+	forged.Head.TxMerkleHash = forged.CalculateTxMerkleHash()
+	proof = newTestProof(t, forged, txHash, nil)
+	_, err = VerifyTxProof(common.Base58Encode(txHash), blockHash, proof)
+	assert.NotNil(t, err)
+}
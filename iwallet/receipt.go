@@ -17,6 +17,7 @@ package iwallet
 import (
 	"fmt"
 
+	"github.com/iost-official/go-iost/rpc/pb"
 	"github.com/spf13/cobra"
 )
 
@@ -36,10 +37,22 @@ var receiptCmd = &cobra.Command{
 			return
 		}
 		fmt.Println(marshalTextString(txReceipt))
+		printActionReceipts(txReceipt)
 		return nil
 	},
 }
 
+// printActionReceipts prints the result of each action, so that the failed action is easy to find.
+func printActionReceipts(txReceipt *rpcpb.TxReceipt) {
+	for i, ar := range txReceipt.ActionReceipts {
+		result := ar.StatusCode.String()
+		if ar.Message != "" {
+			result += ": " + ar.Message
+		}
+		fmt.Printf("action %v: %v, gas usage: %v\n", i, result, ar.GasUsage)
+	}
+}
+
 func init() {
 	rootCmd.AddCommand(receiptCmd)
 }
@@ -50,6 +50,10 @@ func init() {
 	rootCmd.PersistentFlags().Float64VarP(&sdk.gasRatio, "gasratio", "p", 1.0, "gasRatio for a transaction")
 	rootCmd.PersistentFlags().StringVarP(&sdk.amountLimit, "amountLimit", "", "", "amount limit for one transaction, eg iost:300.00|ram:2000")
 	rootCmd.PersistentFlags().Int64VarP(&sdk.expiration, "expiration", "e", 60*5, "expiration time for a transaction,for example,-e 60 means the tx will expire after 60 seconds from now on")
+	rootCmd.PersistentFlags().Int64VarP(&sdk.sequence, "sequence", "", 0, "sequence of the account for a transaction, which should be the next one of the account. 0 means no sequence")
+	rootCmd.PersistentFlags().Int64VarP(&sdk.delaySecond, "delay", "", 0, "delay seconds to execute a transaction. 0 means no delay")
+	rootCmd.PersistentFlags().Int64VarP(&sdk.recurIntervalSecond, "recurInterval", "", 0, "interval seconds to execute a delayed transaction repeatedly")
+	rootCmd.PersistentFlags().Int64VarP(&sdk.recurCount, "recurCount", "", 0, "count of executions of a delayed transaction, 0 or 1 means executed once")
 
 	//rootCmd.PersistentFlags().StringVarP(&dest, "dest", "d", "default", "Set destination of output file")
 	//rootCmd.Flags().StringSliceVarP(&signers, "signers", "n", []string{}, "signers who should sign this transaction")
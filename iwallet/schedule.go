@@ -0,0 +1,30 @@
+package iwallet
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var scheduleSlots int64
+
+// scheduleCmd represents the witness schedule command
+var scheduleCmd = &cobra.Command{
+	Use:   "schedule",
+	Short: "print the witness schedule and the production statistics of the witnesses",
+	Long:  `print the witnesses scheduled in the next slots, and the blocks produced, the slots missed and the last block time of each witness counted since the node started`,
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+		schedule, err := sdk.GetWitnessSchedule(scheduleSlots)
+		if err != nil {
+			fmt.Println(err.Error())
+			return
+		}
+		fmt.Println(marshalTextString(schedule))
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(scheduleCmd)
+	scheduleCmd.Flags().Int64VarP(&scheduleSlots, "slots", "", 0, "print the next $slots slots, which is the number of witnesses if not set")
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/iost-official/go-iost/account"
 	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/core/contract"
+	"github.com/iost-official/go-iost/core/tx"
 	"github.com/iost-official/go-iost/crypto"
 	"github.com/iost-official/go-iost/rpc/pb"
 	"github.com/mitchellh/go-homedir"
@@ -32,6 +33,10 @@ type SDK struct {
 	expiration  int64
 	amountLimit string
 	delaySecond int64
+	sequence    int64
+
+	recurIntervalSecond int64
+	recurCount          int64
 
 	checkResult         bool
 	checkResultDelay    float32
@@ -100,11 +105,11 @@ func (s *SDK) createTx(actions []*rpcpb.Action) (*rpcpb.TransactionRequest, erro
 	if err != nil {
 		return nil, err
 	}
-	now := time.Now().UnixNano()
+	now := s.now()
 	expiration := now + s.expiration*1e9
 
 	ret := &rpcpb.TransactionRequest{
-		Time:          time.Now().UnixNano(),
+		Time:          now,
 		Actions:       actions,
 		Signers:       []string{},
 		GasLimit:      s.gasLimit,
@@ -113,19 +118,25 @@ func (s *SDK) createTx(actions []*rpcpb.Action) (*rpcpb.TransactionRequest, erro
 		PublisherSigs: []*rpcpb.Signature{},
 		Delay:         s.delaySecond * 1e9,
 		AmountLimit:   amountLimits,
+		Sequence:      s.sequence,
+		RecurInterval: s.recurIntervalSecond * 1e9,
+		RecurCount:    s.recurCount,
 	}
 	return ret, nil
 }
 
 func (s *SDK) signTx(t *rpcpb.TransactionRequest) (*rpcpb.TransactionRequest, error) {
-	sig := &rpcpb.Signature{
+	t.PublisherSigs = []*rpcpb.Signature{s.signData(txToBytes(t, true))}
+	t.Publisher = s.accountName
+	return t, nil
+}
+
+func (s *SDK) signData(data []byte) *rpcpb.Signature {
+	return &rpcpb.Signature{
 		Algorithm: rpcpb.Signature_Algorithm(s.getSignAlgo()),
-		Signature: s.getSignAlgo().Sign(common.Sha3(txToBytes(t)), s.keyPair.Seckey),
+		Signature: s.getSignAlgo().Sign(common.Sha3(data), s.keyPair.Seckey),
 		PublicKey: s.getSignAlgo().GetPubkey(s.keyPair.Seckey),
 	}
-	t.PublisherSigs = []*rpcpb.Signature{sig}
-	t.Publisher = s.accountName
-	return t, nil
 }
 
 func (s *SDK) getSignAlgoName() string {
@@ -165,6 +176,37 @@ func (s *SDK) GetContractStorage(r *rpcpb.GetContractStorageRequest) (*rpcpb.Get
 	return value, nil
 }
 
+// getTxLimits returns the tx limits set on chain through system.iost, the default ones if they are not set.
+func (s *SDK) getTxLimits() (*tx.Limits, error) {
+	value, err := s.GetContractStorage(&rpcpb.GetContractStorageRequest{
+		Id:             "system.iost",
+		Key:            "settings",
+		Field:          "tx",
+		ByLongestChain: s.useLongestChain,
+	})
+	if err != nil {
+		return nil, err
+	}
+	l := tx.DefaultLimits()
+	if value.Data == "" || value.Data == "null" {
+		return l, nil
+	}
+	if err := json.Unmarshal([]byte(value.Data), l); err != nil {
+		return nil, fmt.Errorf("invalid tx limits %v: %v", value.Data, err)
+	}
+	return l, nil
+}
+
+// now returns the time of the node clock, which txs are stamped with. It is ahead of the local time if the node is
+// a dev chain warped forward. The local time is returned if the node can not be reached, such as signing offline.
+func (s *SDK) now() int64 {
+	info, err := s.getNodeInfo()
+	if err != nil || info.Time == 0 {
+		return time.Now().UnixNano()
+	}
+	return info.Time
+}
+
 func (s *SDK) getNodeInfo() (*rpcpb.NodeInfoResponse, error) {
 	conn, err := grpc.Dial(s.server, grpc.WithInsecure())
 	if err != nil {
@@ -249,6 +291,50 @@ func (s *SDK) GetTxReceiptByTxHash(txHashStr string) (*rpcpb.TxReceipt, error) {
 	return client.GetTxReceiptByTxHash(context.Background(), &rpcpb.TxHashRequest{Hash: txHashStr})
 }
 
+// GetTxProof ...
+func (s *SDK) GetTxProof(txHashStr string) (*rpcpb.MerkleProofResponse, error) {
+	conn, err := grpc.Dial(s.server, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	client := rpcpb.NewApiServiceClient(conn)
+	return client.GetTxProof(context.Background(), &rpcpb.TxHashRequest{Hash: txHashStr})
+}
+
+// GetTxReceiptProof ...
+func (s *SDK) GetTxReceiptProof(txHashStr string) (*rpcpb.MerkleProofResponse, error) {
+	conn, err := grpc.Dial(s.server, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	client := rpcpb.NewApiServiceClient(conn)
+	return client.GetTxReceiptProof(context.Background(), &rpcpb.TxHashRequest{Hash: txHashStr})
+}
+
+// GetAccountTransactions ...
+func (s *SDK) GetAccountTransactions(name string, offset int64, limit int64) (*rpcpb.GetAccountTransactionsResponse, error) {
+	conn, err := grpc.Dial(s.server, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	client := rpcpb.NewApiServiceClient(conn)
+	return client.GetAccountTransactions(context.Background(), &rpcpb.GetAccountTransactionsRequest{Name: name, Offset: offset, Limit: limit})
+}
+
+// GetWitnessSchedule returns the witnesses scheduled in the next slots and the production statistics of them.
+func (s *SDK) GetWitnessSchedule(slots int64) (*rpcpb.GetWitnessScheduleResponse, error) {
+	conn, err := grpc.Dial(s.server, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	client := rpcpb.NewApiServiceClient(conn)
+	return client.GetWitnessSchedule(context.Background(), &rpcpb.GetWitnessScheduleRequest{Slots: slots})
+}
+
 func (s *SDK) sendTx(stx *rpcpb.TransactionRequest) (string, error) {
 	fmt.Println("sending tx")
 	if sdk.verbose {
@@ -549,7 +635,18 @@ func signatureToBytes(s *rpcpb.Signature) []byte {
 	return sn.Bytes()
 }
 
-func txToBytes(t *rpcpb.TransactionRequest) []byte {
+// flags of the optional fields present in the bytes of tx, the same as tx.ToBytes
+const (
+	sequenceFlag int64 = 1 << iota
+	sponsorFlag
+	sponsorGasLimitFlag
+	sponsorRAMLimitFlag
+	recurIntervalFlag
+	recurCountFlag
+)
+
+// txToBytes converts the tx to bytes the same as tx.ToBytes, with the signatures of signers or not.
+func txToBytes(t *rpcpb.TransactionRequest, withSign bool) []byte {
 	sn := common.NewSimpleNotation()
 	sn.WriteInt64(t.Time, true)
 	sn.WriteInt64(t.Expiration, true)
@@ -570,15 +667,66 @@ func txToBytes(t *rpcpb.TransactionRequest) []byte {
 	}
 	sn.WriteBytesSlice(amountBytes, false)
 
-	signBytes := make([][]byte, 0, len(t.Signatures))
-	for _, sig := range t.Signatures {
-		signBytes = append(signBytes, signatureToBytes(sig))
+	var flags int64
+	if t.Sequence != 0 {
+		flags |= sequenceFlag
+	}
+	if t.Sponsor != "" {
+		flags |= sponsorFlag
+	}
+	if int64(t.SponsorGasLimit*100) != 0 {
+		flags |= sponsorGasLimitFlag
+	}
+	if t.SponsorRamLimit != 0 {
+		flags |= sponsorRAMLimitFlag
+	}
+	if t.RecurInterval != 0 {
+		flags |= recurIntervalFlag
+	}
+	if t.RecurCount != 0 {
+		flags |= recurCountFlag
+	}
+	if flags != 0 {
+		sn.WriteInt64(flags, true)
+		if flags&sequenceFlag != 0 {
+			sn.WriteInt64(t.Sequence, true)
+		}
+		if flags&sponsorFlag != 0 {
+			sn.WriteString(t.Sponsor, true)
+		}
+		if flags&sponsorGasLimitFlag != 0 {
+			sn.WriteInt64(int64(t.SponsorGasLimit*100), true)
+		}
+		if flags&sponsorRAMLimitFlag != 0 {
+			sn.WriteInt64(t.SponsorRamLimit, true)
+		}
+		if flags&recurIntervalFlag != 0 {
+			sn.WriteInt64(t.RecurInterval, true)
+		}
+		if flags&recurCountFlag != 0 {
+			sn.WriteInt64(t.RecurCount, true)
+		}
+	}
+
+	if withSign {
+		signBytes := make([][]byte, 0, len(t.Signatures))
+		for _, sig := range t.Signatures {
+			signBytes = append(signBytes, signatureToBytes(sig))
+		}
+		sn.WriteBytesSlice(signBytes, false)
 	}
-	sn.WriteBytesSlice(signBytes, false)
 
 	return sn.Bytes()
 }
 
+// sponsorBytes returns the bytes signed by the sponsor, the same as tx.sponsorHash, which binds the publisher.
+func sponsorBytes(t *rpcpb.TransactionRequest) []byte {
+	sn := common.NewSimpleNotation()
+	sn.WriteBytes(txToBytes(t, true), false)
+	sn.WriteString(t.Publisher, true)
+	return sn.Bytes()
+}
+
 // NewAction ...
 func NewAction(contract string, name string, data string) *rpcpb.Action {
 	return &rpcpb.Action{
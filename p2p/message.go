@@ -52,6 +52,11 @@ const (
 	SyncBlockResponse
 	SyncHeight
 	PublishTx
+	SyncHeaderRequest
+	SyncHeaderResponse
+	DoubleSignEvidence
+	PreCommitVote
+	CommitVote
 
 	UrgentMessage = 1
 	NormalMessage = 2
@@ -81,6 +86,16 @@ func (m MessageType) String() string {
 		return "PublishTx"
 	case NewBlockHash:
 		return "NewBlockHash"
+	case SyncHeaderRequest:
+		return "SyncHeaderRequest"
+	case SyncHeaderResponse:
+		return "SyncHeaderResponse"
+	case DoubleSignEvidence:
+		return "DoubleSignEvidence"
+	case PreCommitVote:
+		return "PreCommitVote"
+	case CommitVote:
+		return "CommitVote"
 	default:
 		return "unknown_type:" + strconv.Itoa(int(m))
 	}
@@ -153,7 +168,8 @@ func (m *p2pMessage) data() ([]byte, error) {
 }
 
 func (m *p2pMessage) needDedup() bool {
-	return m.messageType() == PublishTx || m.messageType() == NewBlockHash
+	return m.messageType() == PublishTx || m.messageType() == NewBlockHash || m.messageType() == DoubleSignEvidence ||
+		m.messageType() == PreCommitVote || m.messageType() == CommitVote
 }
 
 func newP2PMessage(chainID uint32, messageType MessageType, version uint16, reserved uint32, data []byte) *p2pMessage {
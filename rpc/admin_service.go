@@ -0,0 +1,143 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/consensus"
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/core/txpool"
+	"github.com/iost-official/go-iost/rpc/pb"
+)
+
+// AdminService implements the rpc APIs to inspect and manage the node, which are only served on the admin listener.
+type AdminService struct {
+	txpool    txpool.TxPool
+	consensus consensus.Consensus
+}
+
+var errNotSealer = errors.New("not supported by the consensus, which should be dev")
+
+// NewAdminService returns a new AdminService instance.
+func NewAdminService(tp txpool.TxPool, cons consensus.Consensus) *AdminService {
+	return &AdminService{
+		txpool:    tp,
+		consensus: cons,
+	}
+}
+
+func matchPendingTx(t *tx.Tx, req *rpcpb.GetPendingTxsRequest) bool {
+	if req.GetPublisher() != "" && t.Publisher != req.GetPublisher() {
+		return false
+	}
+	gasRatio := float64(t.GasRatio) / 100
+	if gasRatio < req.GetMinGasRatio() || (req.GetMaxGasRatio() > 0 && gasRatio > req.GetMaxGasRatio()) {
+		return false
+	}
+	if req.GetContract() == "" {
+		return true
+	}
+	for _, a := range t.Actions {
+		if a.Contract == req.GetContract() {
+			return true
+		}
+	}
+	return false
+}
+
+// GetPendingTxs returns the pending transactions matching the filters in order of gas ratio.
+func (as *AdminService) GetPendingTxs(ctx context.Context, req *rpcpb.GetPendingTxsRequest) (*rpcpb.GetPendingTxsResponse, error) {
+	limit, err := checkTxsPage(req.GetOffset(), req.GetLimit())
+	if err != nil {
+		return nil, err
+	}
+	pendingTx, _ := as.txpool.PendingTx()
+	res := &rpcpb.GetPendingTxsResponse{}
+	iter := pendingTx.Iter()
+	t, ok := iter.Next()
+	for ; ok; t, ok = iter.Next() {
+		if !matchPendingTx(t, req) {
+			continue
+		}
+		if res.Total >= req.GetOffset() && int64(len(res.Transactions)) < limit {
+			res.Transactions = append(res.Transactions, toPbTx(t, nil))
+		}
+		res.Total++
+	}
+	return res, nil
+}
+
+// GetPendingTxCounts returns the count of pending transactions of each publisher.
+func (as *AdminService) GetPendingTxCounts(context.Context, *rpcpb.EmptyRequest) (*rpcpb.GetPendingTxCountsResponse, error) {
+	pendingTx, _ := as.txpool.PendingTx()
+	res := &rpcpb.GetPendingTxCountsResponse{}
+	for publisher, count := range pendingTx.PublisherCounts() {
+		res.Counts = append(res.Counts, &rpcpb.GetPendingTxCountsResponse_PublisherCount{
+			Publisher: publisher,
+			Count:     int64(count),
+		})
+	}
+	sort.Slice(res.Counts, func(i, j int) bool {
+		if res.Counts[i].Count == res.Counts[j].Count {
+			return res.Counts[i].Publisher < res.Counts[j].Publisher
+		}
+		return res.Counts[i].Count > res.Counts[j].Count
+	})
+	return res, nil
+}
+
+// GetDroppedTx returns the status and the reason of the transaction dropped by the transaction pool.
+func (as *AdminService) GetDroppedTx(ctx context.Context, req *rpcpb.TxHashRequest) (*rpcpb.TxStatusResponse, error) {
+	status, reason := as.txpool.GetTxStatus(common.Base58Decode(req.GetHash()))
+	if status != txpool.TxStatusExpired && status != txpool.TxStatusRejected && status != txpool.TxStatusDropped {
+		return nil, errors.New("tx is not dropped, status: " + status.String())
+	}
+	return toPbTxStatus(status, reason), nil
+}
+
+// RemovePendingTxs removes the transactions from the pending pool and returns the hashes of the removed ones.
+func (as *AdminService) RemovePendingTxs(ctx context.Context, req *rpcpb.RemovePendingTxsRequest) (*rpcpb.RemovePendingTxsResponse, error) {
+	res := &rpcpb.RemovePendingTxsResponse{}
+	for _, hash := range req.GetHashes() {
+		if err := as.txpool.RemoveTx(common.Base58Decode(hash), "removed by admin"); err != nil {
+			continue
+		}
+		res.Hashes = append(res.Hashes, hash)
+	}
+	return res, nil
+}
+
+// SealBlock seals a block with the pending transactions at once in the dev consensus.
+func (as *AdminService) SealBlock(context.Context, *rpcpb.EmptyRequest) (*rpcpb.BlockResponse, error) {
+	sealer, ok := as.consensus.(consensus.Sealer)
+	if !ok {
+		return nil, errNotSealer
+	}
+	blk, err := sealer.Seal()
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.BlockResponse{
+		Status: rpcpb.BlockResponse_IRREVERSIBLE,
+		Block:  toPbBlock(blk, true),
+	}, nil
+}
+
+// WarpTime moves the block time forward in the dev consensus.
+func (as *AdminService) WarpTime(ctx context.Context, req *rpcpb.WarpTimeRequest) (*rpcpb.WarpTimeResponse, error) {
+	sealer, ok := as.consensus.(consensus.Sealer)
+	if !ok {
+		return nil, errNotSealer
+	}
+	offset, err := sealer.WarpTime(time.Duration(req.GetDuration()))
+	if err != nil {
+		return nil, err
+	}
+	return &rpcpb.WarpTimeResponse{
+		Offset: int64(offset),
+		Time:   common.Now(),
+	}, nil
+}
@@ -0,0 +1,29 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/core/txpool"
+	"github.com/iost-official/go-iost/core/txpool/mock"
+	"github.com/iost-official/go-iost/rpc/pb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemovePendingTxs(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	tp := txpool_mock.NewMockTxPool(ctl)
+	pending, missing := []byte("pending"), []byte("missing")
+	tp.EXPECT().RemoveTx(pending, "removed by admin").Return(nil)
+	tp.EXPECT().RemoveTx(missing, "removed by admin").Return(txpool.ErrTxNotFound)
+
+	as := NewAdminService(tp, nil)
+	res, err := as.RemovePendingTxs(context.Background(), &rpcpb.RemovePendingTxsRequest{
+		Hashes: []string{common.Base58Encode(pending), common.Base58Encode(missing)},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{common.Base58Encode(pending)}, res.Hashes)
+}
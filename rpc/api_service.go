@@ -2,23 +2,26 @@ package rpc
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/iost-official/go-iost/vm"
 	"reflect"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/iost-official/go-iost/common"
+	"github.com/iost-official/go-iost/consensus"
 	"github.com/iost-official/go-iost/consensus/cverifier"
-	"github.com/iost-official/go-iost/consensus/pob"
 	"github.com/iost-official/go-iost/core/block"
 	"github.com/iost-official/go-iost/core/blockcache"
 	"github.com/iost-official/go-iost/core/event"
 	"github.com/iost-official/go-iost/core/global"
 	"github.com/iost-official/go-iost/core/tx"
 	"github.com/iost-official/go-iost/core/txpool"
+	"github.com/iost-official/go-iost/db"
 	"github.com/iost-official/go-iost/ilog"
 	"github.com/iost-official/go-iost/p2p"
 	"github.com/iost-official/go-iost/rpc/pb"
@@ -27,6 +30,15 @@ import (
 	"github.com/iost-official/go-iost/vm/host"
 )
 
+// limits of the block range, transaction page, witness schedule and event replay
+const (
+	maxBlockRange       = 100
+	defaultTxsPageSize  = 100
+	maxTxsPageSize      = 1000
+	maxScheduleSlots    = 1000
+	defaultReplayBlocks = 7200
+)
+
 //go:generate mockgen -destination mock_rpc/mock_api.go -package main github.com/iost-official/go-iost/rpc/pb ApiServiceServer
 
 // APIService implements all rpc APIs.
@@ -36,18 +48,20 @@ type APIService struct {
 	txpool     txpool.TxPool
 	blockchain block.Chain
 	bv         global.BaseVariable
+	consensus  consensus.Consensus
 
 	quitCh chan struct{}
 }
 
 // NewAPIService returns a new APIService instance.
-func NewAPIService(tp txpool.TxPool, bcache blockcache.BlockCache, bv global.BaseVariable, p2pService p2p.Service, quitCh chan struct{}) *APIService {
+func NewAPIService(tp txpool.TxPool, bcache blockcache.BlockCache, bv global.BaseVariable, p2pService p2p.Service, cons consensus.Consensus, quitCh chan struct{}) *APIService {
 	return &APIService{
 		p2pService: p2pService,
 		txpool:     tp,
 		blockchain: bv.BlockChain(),
 		bc:         bcache,
 		bv:         bv,
+		consensus:  cons,
 		quitCh:     quitCh,
 	}
 }
@@ -59,6 +73,7 @@ func (as *APIService) GetNodeInfo(context.Context, *rpcpb.EmptyRequest) (*rpcpb.
 		GitHash:   global.GitHash,
 		Mode:      as.bv.Mode().String(),
 		Network:   &rpcpb.NetworkInfo{},
+		Time:      common.Now(),
 	}
 	p2pNeighbors := as.p2pService.GetAllNeighbors()
 	networkInfo := &rpcpb.NetworkInfo{
@@ -100,7 +115,7 @@ func (as *APIService) GetChainInfo(context.Context, *rpcpb.EmptyRequest) (*rpcpb
 	return &rpcpb.ChainInfoResponse{
 		NetName:         netName,
 		ProtocolVersion: version,
-		WitnessList:     pob.GetStaticProperty().WitnessList,
+		WitnessList:     as.consensus.Witnesses(),
 		HeadBlock:       headBlock.Head.Number,
 		HeadBlockHash:   common.Base58Encode(headBlock.HeadHash()),
 		LibBlock:        libBlock.Head.Number,
@@ -136,6 +151,48 @@ func (as *APIService) GetTxByHash(ctx context.Context, req *rpcpb.TxHashRequest)
 	}, nil
 }
 
+// GetTxStatus returns the lifecycle status of the transaction corresponding to the given hash.
+func (as *APIService) GetTxStatus(ctx context.Context, req *rpcpb.TxHashRequest) (*rpcpb.TxStatusResponse, error) {
+	status, reason := as.txpool.GetTxStatus(common.Base58Decode(req.GetHash()))
+	return toPbTxStatus(status, reason), nil
+}
+
+// SubscribeTxStatus streams the status of the transaction on each transition until it's final.
+func (as *APIService) SubscribeTxStatus(req *rpcpb.TxHashRequest, res rpcpb.ApiService_SubscribeTxStatusServer) error {
+	hash := common.Base58Decode(req.GetHash())
+	topics := []event.Topic{event.NewBlock, event.IrreversibleBlock, event.ChainReorg, event.TxDropped}
+	ec := event.GetCollector()
+	id := time.Now().UnixNano()
+	ch := ec.Subscribe(id, topics, nil)
+	defer ec.Unsubscribe(id, topics)
+
+	// txs received from p2p don't post events, so the status is also checked periodically
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	var last *rpcpb.TxStatusResponse
+	for {
+		status, reason := as.txpool.GetTxStatus(hash)
+		cur := toPbTxStatus(status, reason)
+		if last == nil || cur.Status != last.Status {
+			if err := res.Send(cur); err != nil {
+				return err
+			}
+			last = cur
+		}
+		if status.IsFinal() {
+			return nil
+		}
+		select {
+		case <-as.quitCh:
+			return nil
+		case <-res.Context().Done():
+			return res.Context().Err()
+		case <-ch:
+		case <-ticker.C:
+		}
+	}
+}
+
 // GetTxReceiptByTxHash returns transaction receipts corresponding to the given tx hash.
 func (as *APIService) GetTxReceiptByTxHash(ctx context.Context, req *rpcpb.TxHashRequest) (*rpcpb.TxReceipt, error) {
 	txHashBytes := common.Base58Decode(req.GetHash())
@@ -146,21 +203,39 @@ func (as *APIService) GetTxReceiptByTxHash(ctx context.Context, req *rpcpb.TxHas
 	return toPbTxReceipt(receipt), nil
 }
 
+// GetTxProof returns the merkle proof of the irreversible transaction corresponding to the given hash.
+func (as *APIService) GetTxProof(ctx context.Context, req *rpcpb.TxHashRequest) (*rpcpb.MerkleProofResponse, error) {
+	txHashBytes := common.Base58Decode(req.GetHash())
+	blk, err := as.blockchain.GetBlockByTxHash(txHashBytes)
+	if err != nil {
+		return nil, err
+	}
+	mp, err := blk.TxMerklePath(txHashBytes)
+	if err != nil {
+		return nil, err
+	}
+	return toPbMerkleProof(txHashBytes, mp, blk)
+}
+
+// GetTxReceiptProof returns the merkle proof of the receipt of the irreversible transaction corresponding to the given hash.
+func (as *APIService) GetTxReceiptProof(ctx context.Context, req *rpcpb.TxHashRequest) (*rpcpb.MerkleProofResponse, error) {
+	txHashBytes := common.Base58Decode(req.GetHash())
+	blk, err := as.blockchain.GetBlockByTxHash(txHashBytes)
+	if err != nil {
+		return nil, err
+	}
+	receiptHash, mp, err := blk.TxReceiptMerklePath(txHashBytes)
+	if err != nil {
+		return nil, err
+	}
+	return toPbMerkleProof(receiptHash, mp, blk)
+}
+
 // GetBlockByHash returns block corresponding to the given hash.
 func (as *APIService) GetBlockByHash(ctx context.Context, req *rpcpb.GetBlockByHashRequest) (*rpcpb.BlockResponse, error) {
-	hashBytes := common.Base58Decode(req.GetHash())
-	var (
-		blk *block.Block
-		err error
-	)
-	status := rpcpb.BlockResponse_PENDIND
-	blk, err = as.bc.GetBlockByHash(hashBytes)
+	blk, status, err := as.getBlockByHash(common.Base58Decode(req.GetHash()))
 	if err != nil {
-		status = rpcpb.BlockResponse_IRREVERSIBLE
-		blk, err = as.blockchain.GetBlockByHash(hashBytes)
-		if err != nil {
-			return nil, err
-		}
+		return nil, err
 	}
 	return &rpcpb.BlockResponse{
 		Status: status,
@@ -170,19 +245,9 @@ func (as *APIService) GetBlockByHash(ctx context.Context, req *rpcpb.GetBlockByH
 
 // GetBlockByNumber returns block corresponding to the given number.
 func (as *APIService) GetBlockByNumber(ctx context.Context, req *rpcpb.GetBlockByNumberRequest) (*rpcpb.BlockResponse, error) {
-	number := req.GetNumber()
-	var (
-		blk *block.Block
-		err error
-	)
-	status := rpcpb.BlockResponse_PENDIND
-	blk, err = as.bc.GetBlockByNumber(number)
+	blk, status, err := as.getBlockByNumber(req.GetNumber())
 	if err != nil {
-		status = rpcpb.BlockResponse_IRREVERSIBLE
-		blk, err = as.blockchain.GetBlockByNumber(number)
-		if err != nil {
-			return nil, err
-		}
+		return nil, err
 	}
 	return &rpcpb.BlockResponse{
 		Status: status,
@@ -190,9 +255,119 @@ func (as *APIService) GetBlockByNumber(ctx context.Context, req *rpcpb.GetBlockB
 	}, nil
 }
 
+// GetBlocksByRange streams the blocks from start number to end number.
+// The stream ends early at the head of the longest chain.
+func (as *APIService) GetBlocksByRange(req *rpcpb.GetBlocksByRangeRequest, res rpcpb.ApiService_GetBlocksByRangeServer) error {
+	start, end := req.GetStartNumber(), req.GetEndNumber()
+	if start < 0 || end < start {
+		return fmt.Errorf("invalid block range [%v, %v]", start, end)
+	}
+	if end-start+1 > maxBlockRange {
+		return fmt.Errorf("block range exceeds the limit %v", maxBlockRange)
+	}
+	for number := start; number <= end; number++ {
+		if number > as.bc.Head().Head.Number {
+			return nil
+		}
+		blk, status, err := as.getBlockByNumber(number)
+		if err != nil {
+			return err
+		}
+		select {
+		case <-as.quitCh:
+			return nil
+		case <-res.Context().Done():
+			return res.Context().Err()
+		default:
+		}
+		err = res.Send(&rpcpb.BlockResponse{
+			Status: status,
+			Block:  toPbBlock(blk, req.GetComplete()),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetTxsByBlock returns a page of transactions in the block.
+func (as *APIService) GetTxsByBlock(ctx context.Context, req *rpcpb.GetTxsByBlockRequest) (*rpcpb.GetTxsByBlockResponse, error) {
+	offset := req.GetOffset()
+	limit, err := checkTxsPage(offset, req.GetLimit())
+	if err != nil {
+		return nil, err
+	}
+	var (
+		blk    *block.Block
+		status rpcpb.BlockResponse_Status
+	)
+	if req.GetHash() != "" {
+		blk, status, err = as.getBlockByHash(common.Base58Decode(req.GetHash()))
+	} else {
+		blk, status, err = as.getBlockByNumber(req.GetNumber())
+	}
+	if err != nil {
+		return nil, err
+	}
+	total := int64(len(blk.Txs))
+	ret := &rpcpb.GetTxsByBlockResponse{
+		Status:    status,
+		BlockHash: common.Base58Encode(blk.HeadHash()),
+		Total:     total,
+	}
+	for i := offset; i < total && i < offset+limit; i++ {
+		ret.Transactions = append(ret.Transactions, toPbTx(blk.Txs[i], blk.Receipts[i]))
+	}
+	return ret, nil
+}
+
+// checkTxsPage checks the offset and limit of a page and returns the limit to use.
+func checkTxsPage(offset int64, limit int64) (int64, error) {
+	if offset < 0 || limit < 0 {
+		return 0, errors.New("offset and limit should not be negative")
+	}
+	if limit == 0 {
+		return defaultTxsPageSize, nil
+	}
+	if limit > maxTxsPageSize {
+		return 0, fmt.Errorf("limit exceeds the max page size %v", maxTxsPageSize)
+	}
+	return limit, nil
+}
+
+// getBlockByHash looks up the block in block cache first, then in the irreversible block chain.
+func (as *APIService) getBlockByHash(hash []byte) (*block.Block, rpcpb.BlockResponse_Status, error) {
+	blk, err := as.bc.GetBlockByHash(hash)
+	if err == nil {
+		return blk, rpcpb.BlockResponse_PENDIND, nil
+	}
+	blk, err = as.blockchain.GetBlockByHash(hash)
+	if err != nil {
+		return nil, rpcpb.BlockResponse_IRREVERSIBLE, err
+	}
+	return blk, rpcpb.BlockResponse_IRREVERSIBLE, nil
+}
+
+// getBlockByNumber looks up the block in block cache first, then in the irreversible block chain.
+func (as *APIService) getBlockByNumber(number int64) (*block.Block, rpcpb.BlockResponse_Status, error) {
+	blk, err := as.bc.GetBlockByNumber(number)
+	if err == nil {
+		return blk, rpcpb.BlockResponse_PENDIND, nil
+	}
+	blk, err = as.blockchain.GetBlockByNumber(number)
+	if err != nil {
+		return nil, rpcpb.BlockResponse_IRREVERSIBLE, err
+	}
+	return blk, rpcpb.BlockResponse_IRREVERSIBLE, nil
+}
+
 // GetAccount returns account information corresponding to the given account name.
 func (as *APIService) GetAccount(ctx context.Context, req *rpcpb.GetAccountRequest) (*rpcpb.Account, error) {
-	dbVisitor := as.getStateDBVisitor(req.ByLongestChain)
+	dbVisitor, blkTime, err := as.getStateDBVisitorAt(req.ByLongestChain, req.GetBlockNumber(), req.GetBlockHash())
+	if err != nil {
+		return nil, err
+	}
 	// pack basic account information
 	acc, _ := host.ReadAuth(dbVisitor, req.GetName())
 	if acc == nil {
@@ -208,12 +383,6 @@ func (as *APIService) GetAccount(ctx context.Context, req *rpcpb.GetAccountReque
 	}
 
 	// pack gas information
-	var blkTime int64
-	if req.GetByLongestChain() {
-		blkTime = as.bc.Head().Head.Time
-	} else {
-		blkTime = as.bc.LinkedRoot().Head.Time
-	}
 	pGas := dbVisitor.PGasAtTime(req.GetName(), blkTime)
 	tGas := dbVisitor.TGas(req.GetName())
 	totalGas := pGas.Add(tGas)
@@ -242,13 +411,72 @@ func (as *APIService) GetAccount(ctx context.Context, req *rpcpb.GetAccountReque
 			Time:   f.Ftime,
 		})
 	}
+	ret.Sequence = dbVisitor.Sequence(req.GetName())
 
 	return ret, nil
 }
 
+// GetAccountTransactions returns a page of the irreversible transactions involving the account.
+func (as *APIService) GetAccountTransactions(ctx context.Context, req *rpcpb.GetAccountTransactionsRequest) (*rpcpb.GetAccountTransactionsResponse, error) {
+	limit, err := checkTxsPage(req.GetOffset(), req.GetLimit())
+	if err != nil {
+		return nil, err
+	}
+	hashes, err := as.blockchain.GetAccountTxs(req.GetName(), req.GetOffset(), limit)
+	if err != nil {
+		return nil, err
+	}
+	ret := &rpcpb.GetAccountTransactionsResponse{}
+	for _, hash := range hashes {
+		t, err := as.blockchain.GetTx(hash)
+		if err != nil {
+			return nil, err
+		}
+		receipt, err := as.blockchain.GetReceiptByTxHash(hash)
+		if err != nil {
+			return nil, err
+		}
+		ret.Transactions = append(ret.Transactions, toPbTx(t, receipt))
+	}
+	return ret, nil
+}
+
+// GetScheduledTxs returns the delay transactions published by the account which are not executed all the times yet.
+func (as *APIService) GetScheduledTxs(ctx context.Context, req *rpcpb.GetScheduledTxsRequest) (*rpcpb.GetScheduledTxsResponse, error) {
+	txs, err := as.blockchain.AllDelaytx()
+	if err != nil {
+		return nil, err
+	}
+	// the delay txs in blockchain are irreversible, so is the state to read the recurrence
+	dbVisitor := as.getStateDBVisitor(false)
+	ret := &rpcpb.GetScheduledTxsResponse{}
+	for _, t := range txs {
+		if t.Publisher != req.GetName() {
+			continue
+		}
+		scheduled := &rpcpb.GetScheduledTxsResponse_ScheduledTx{
+			Transaction: toPbTx(t, nil),
+			NextTime:    t.DeferTime(0),
+			Remaining:   1,
+		}
+		if r := dbVisitor.GetDelaytxRecurrence(string(t.Hash())); r != nil {
+			scheduled.NextTime = r.Next
+			scheduled.Remaining = r.Remaining
+		}
+		ret.ScheduledTxs = append(ret.ScheduledTxs, scheduled)
+	}
+	sort.Slice(ret.ScheduledTxs, func(i, j int) bool {
+		return ret.ScheduledTxs[i].NextTime < ret.ScheduledTxs[j].NextTime
+	})
+	return ret, nil
+}
+
 // GetTokenBalance returns contract information corresponding to the given contract ID.
 func (as *APIService) GetTokenBalance(ctx context.Context, req *rpcpb.GetTokenBalanceRequest) (*rpcpb.GetTokenBalanceResponse, error) {
-	dbVisitor := as.getStateDBVisitor(req.ByLongestChain)
+	dbVisitor, _, err := as.getStateDBVisitorAt(req.ByLongestChain, req.GetBlockNumber(), req.GetBlockHash())
+	if err != nil {
+		return nil, err
+	}
 	// pack basic account information
 	acc, _ := host.ReadAuth(dbVisitor, req.GetAccount())
 	if acc == nil {
@@ -272,7 +500,10 @@ func (as *APIService) GetTokenBalance(ctx context.Context, req *rpcpb.GetTokenBa
 
 // GetToken721Balance returns balance of account of an specific token721 token.
 func (as *APIService) GetToken721Balance(ctx context.Context, req *rpcpb.GetTokenBalanceRequest) (*rpcpb.GetToken721BalanceResponse, error) {
-	dbVisitor := as.getStateDBVisitor(req.ByLongestChain)
+	dbVisitor, _, err := as.getStateDBVisitorAt(req.ByLongestChain, req.GetBlockNumber(), req.GetBlockHash())
+	if err != nil {
+		return nil, err
+	}
 	// pack basic account information
 	acc, _ := host.ReadAuth(dbVisitor, req.GetAccount())
 	if acc == nil {
@@ -337,9 +568,77 @@ func (as *APIService) GetGasRatio(ctx context.Context, req *rpcpb.EmptyRequest)
 	}, nil
 }
 
+// GetDoubleSignEvidences returns the evidences of double signing received by the node.
+func (as *APIService) GetDoubleSignEvidences(ctx context.Context, req *rpcpb.EmptyRequest) (*rpcpb.GetDoubleSignEvidencesResponse, error) {
+	evidencer, ok := as.consensus.(consensus.Evidencer)
+	if !ok {
+		return nil, errors.New("double sign evidences are not supported by the consensus")
+	}
+	evidences, err := evidencer.DoubleSignEvidences()
+	if err != nil {
+		return nil, err
+	}
+	ret := &rpcpb.GetDoubleSignEvidencesResponse{}
+	for _, e := range evidences {
+		b, err := e.Encode()
+		if err != nil {
+			return nil, err
+		}
+		h1, _ := e.Head1.Hash()
+		h2, _ := e.Head2.Hash()
+		ret.Evidences = append(ret.Evidences, &rpcpb.GetDoubleSignEvidencesResponse_Evidence{
+			Witness:     e.Witness(),
+			Number:      e.Head1.Number,
+			Slot:        e.Slot(),
+			BlockHashes: []string{common.Base58Encode(h1), common.Base58Encode(h2)},
+			Data:        base64.StdEncoding.EncodeToString(b),
+		})
+	}
+	return ret, nil
+}
+
+// GetWitnessSchedule returns the witnesses scheduled in the next slots and the production statistics of the witnesses.
+func (as *APIService) GetWitnessSchedule(ctx context.Context, req *rpcpb.GetWitnessScheduleRequest) (*rpcpb.GetWitnessScheduleResponse, error) {
+	scheduler, ok := as.consensus.(consensus.Scheduler)
+	if !ok {
+		return nil, errors.New("witness schedule is not supported by the consensus")
+	}
+	witnesses := as.consensus.Witnesses()
+	slots := req.GetSlots()
+	if slots < 0 || slots > maxScheduleSlots {
+		return nil, fmt.Errorf("slots should be between 0 and %v", maxScheduleSlots)
+	}
+	if slots == 0 {
+		slots = int64(len(witnesses))
+	}
+	ret := &rpcpb.GetWitnessScheduleResponse{
+		CurrentSlot: block.SlotOf(time.Now().UnixNano()),
+	}
+	for _, s := range scheduler.Schedule(int(slots)) {
+		ret.Slots = append(ret.Slots, &rpcpb.GetWitnessScheduleResponse_Slot{
+			Slot:    s.Slot,
+			Witness: s.Witness,
+			Time:    s.Time,
+		})
+	}
+	stats := scheduler.ProducerStats()
+	for _, w := range witnesses {
+		ret.Producers = append(ret.Producers, &rpcpb.GetWitnessScheduleResponse_ProducerStat{
+			Witness:          w,
+			Produced:         stats[w].Produced,
+			Missed:           stats[w].Missed,
+			LastProducedTime: stats[w].LastProduced,
+		})
+	}
+	return ret, nil
+}
+
 // GetContractStorage returns contract storage corresponding to the given key and field.
 func (as *APIService) GetContractStorage(ctx context.Context, req *rpcpb.GetContractStorageRequest) (*rpcpb.GetContractStorageResponse, error) {
-	dbVisitor := as.getStateDBVisitor(req.ByLongestChain)
+	dbVisitor, _, err := as.getStateDBVisitorAt(req.ByLongestChain, req.GetBlockNumber(), req.GetBlockHash())
+	if err != nil {
+		return nil, err
+	}
 	h := host.NewHost(host.NewContext(nil), dbVisitor, nil, nil)
 	var value interface{}
 	if req.GetField() == "" {
@@ -362,10 +661,40 @@ func (as *APIService) GetContractStorage(ctx context.Context, req *rpcpb.GetCont
 	}, nil
 }
 
+// GetStateProof returns the merkle proof of a contract storage against the state root of the block.
+func (as *APIService) GetStateProof(ctx context.Context, req *rpcpb.GetContractStorageRequest) (*rpcpb.StateProofResponse, error) {
+	stateDB, blk, err := as.getStateDBAt(req.ByLongestChain, req.GetBlockNumber(), req.GetBlockHash())
+	if err != nil {
+		return nil, err
+	}
+	if blk.Head.Version < block.V1 {
+		return nil, fmt.Errorf("block %v doesn't commit to the state root", blk.Head.Number)
+	}
+	var key string
+	if req.GetField() == "" {
+		key = database.BasicPrefix + req.GetId() + database.Separator + req.GetKey()
+	} else {
+		key = database.MapPrefix + req.GetId() + database.Separator + req.GetKey() + database.Separator + req.GetField()
+	}
+	value, err := stateDB.Get(database.StateTable, key)
+	if err != nil {
+		return nil, err
+	}
+	exist, err := stateDB.Has(database.StateTable, key)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := stateDB.Prove(database.StateTable, key)
+	if err != nil {
+		return nil, err
+	}
+	return toPbStateProof(key, value, exist, proof, blk), nil
+}
+
 func (as *APIService) tryTransaction(t *tx.Tx) (*tx.TxReceipt, error) {
 	topBlock := as.bc.Head()
 	blkHead := &block.BlockHead{
-		Version:    0,
+		Version:    block.VersionOf(topBlock.Head.Number + 1),
 		ParentHash: topBlock.HeadHash(),
 		Number:     topBlock.Head.Number + 1,
 		Time:       time.Now().UnixNano(),
@@ -421,6 +750,20 @@ func (as *APIService) Subscribe(req *rpcpb.SubscribeRequest, res rpcpb.ApiServic
 	if req.GetFilter() != nil {
 		filter = &event.Meta{
 			ContractID: req.GetFilter().GetContractId(),
+			ActionName: req.GetFilter().GetActionName(),
+			Publisher:  req.GetFilter().GetPublisher(),
+		}
+		for _, c := range req.GetFilter().GetStatusCodes() {
+			filter.StatusCodes = append(filter.StatusCodes, int32(c))
+		}
+	}
+
+	replay := req.GetFromBlock() > 0
+	if replay {
+		for _, t := range topics {
+			if !isReplayable(t) {
+				return fmt.Errorf("topic %v can't be replayed", t)
+			}
 		}
 	}
 
@@ -429,28 +772,156 @@ func (as *APIService) Subscribe(req *rpcpb.SubscribeRequest, res rpcpb.ApiServic
 	ch := ec.Subscribe(id, topics, filter)
 	defer ec.Unsubscribe(id, topics)
 
-	timeup := time.NewTimer(time.Hour)
+	if replay {
+		// the head is read after subscribing, so no block falls between the replay and the live events
+		head := as.bc.Head().Head.Number
+		if limit := as.maxReplayBlocks(); head-req.GetFromBlock()+1 > limit {
+			return fmt.Errorf("replay range exceeds the limit %v", limit)
+		}
+		// the live events are buffered during the replay, otherwise the subscription channel overflows
+		stop := make(chan struct{})
+		bufCh := make(chan []*event.Event)
+		go func() {
+			buf := make([]*event.Event, 0)
+			for {
+				select {
+				case ev := <-ch:
+					buf = append(buf, ev)
+				case <-stop:
+					bufCh <- buf
+					return
+				}
+			}
+		}()
+		err := as.replayEvents(req.GetFromBlock(), head, topics, filter, res)
+		close(stop)
+		buf := <-bufCh
+		if err != nil {
+			return err
+		}
+		for _, ev := range buf {
+			if err := sendEvent(ev, 0, res); err != nil {
+				return err
+			}
+		}
+	}
+
 	for {
 		select {
-		case <-timeup.C:
-			return nil
 		case <-as.quitCh:
 			return nil
 		case <-res.Context().Done():
 			return res.Context().Err()
 		case ev := <-ch:
+			if err := sendEvent(ev, ec.TakeDropped(id), res); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func sendEvent(ev *event.Event, dropped int64, res rpcpb.ApiService_SubscribeServer) error {
+	e := &rpcpb.Event{
+		Topic: rpcpb.Event_Topic(ev.Topic),
+		Data:  ev.Data,
+		Time:  ev.Time,
+	}
+	err := res.Send(&rpcpb.SubscribeResponse{Event: e, Dropped: dropped})
+	if err != nil {
+		ilog.Errorf("stream send failed. err=%v", err)
+	}
+	return err
+}
+
+// isReplayable returns whether the events of the topic can be replayed from the stored blocks.
+func isReplayable(t event.Topic) bool {
+	return t == event.ContractReceipt || t == event.NewBlock || t == event.IrreversibleBlock
+}
+
+// maxReplayBlocks returns the limit of blocks replayed by Subscribe.
+func (as *APIService) maxReplayBlocks() int64 {
+	if n := as.bv.Config().RPC.MaxReplayBlocks; n > 0 {
+		return n
+	}
+	return defaultReplayBlocks
+}
+
+// replayEvents sends the events of the topics in the blocks from start to end, block by block.
+// The irreversible block events are only sent for the blocks irreversible now, the later ones come as live events.
+func (as *APIService) replayEvents(start int64, end int64, topics []event.Topic, filter *event.Meta, res rpcpb.ApiService_SubscribeServer) error {
+	subscribed := make(map[event.Topic]bool)
+	for _, t := range topics {
+		subscribed[t] = true
+	}
+	lib := as.bc.LinkedRoot().Head.Number
+	for number := start; number <= end; number++ {
+		select {
+		case <-as.quitCh:
+			return nil
+		case <-res.Context().Done():
+			return res.Context().Err()
+		default:
+		}
+		blk, _, err := as.getBlockByNumber(number)
+		if err != nil {
+			return err
+		}
+		if subscribed[event.NewBlock] {
+			if err := sendBlockEvent(event.NewBlock, blk, res); err != nil {
+				return err
+			}
+		}
+		if subscribed[event.ContractReceipt] {
+			if err := replayReceipts(blk, filter, res); err != nil {
+				return err
+			}
+		}
+		if subscribed[event.IrreversibleBlock] && number <= lib {
+			if err := sendBlockEvent(event.IrreversibleBlock, blk, res); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func sendBlockEvent(topic event.Topic, blk *block.Block, res rpcpb.ApiService_SubscribeServer) error {
+	ev := event.NewDataEvent(topic, blockcache.BlockEventData(blk))
+	e := &rpcpb.Event{
+		Topic: rpcpb.Event_Topic(topic),
+		Data:  ev.Data,
+		Time:  blk.Head.Time,
+	}
+	return res.Send(&rpcpb.SubscribeResponse{Event: e})
+}
+
+// replayReceipts sends the contract receipts stored in the block as events.
+func replayReceipts(blk *block.Block, filter *event.Meta, res rpcpb.ApiService_SubscribeServer) error {
+	for i, t := range blk.Txs {
+		tr := blk.Receipts[i]
+		for _, r := range tr.Receipts {
+			meta := &event.Meta{
+				Publisher:  t.Publisher,
+				TxHash:     common.Base58Encode(t.Hash()),
+				StatusCode: int32(tr.Status.Code),
+			}
+			if idx := strings.LastIndex(r.FuncName, "/"); idx >= 0 {
+				meta.ContractID, meta.ActionName = r.FuncName[:idx], r.FuncName[idx+1:]
+			}
+			if filter != nil && !filter.Match(meta) {
+				continue
+			}
 			e := &rpcpb.Event{
-				Topic: rpcpb.Event_Topic(ev.Topic),
-				Data:  ev.Data,
-				Time:  ev.Time,
+				Topic: rpcpb.Event_CONTRACT_RECEIPT,
+				Data:  r.Content,
+				Time:  blk.Head.Time,
 			}
-			err := res.Send(&rpcpb.SubscribeResponse{Event: e})
-			if err != nil {
-				ilog.Errorf("stream send failed. err=%v", err)
+			if err := res.Send(&rpcpb.SubscribeResponse{Event: e}); err != nil {
 				return err
 			}
 		}
 	}
+	return nil
 }
 
 func (as *APIService) getStateDBVisitor(longestChain bool) *database.Visitor {
@@ -462,3 +933,50 @@ func (as *APIService) getStateDBVisitor(longestChain bool) *database.Visitor {
 	}
 	return database.NewVisitor(0, stateDB)
 }
+
+// getStateDBVisitorAt returns the visitor of the state at the block specified by hash or number,
+// or by longestChain if neither is specified. The time of the block is returned too.
+func (as *APIService) getStateDBVisitorAt(longestChain bool, number int64, hash string) (*database.Visitor, int64, error) {
+	stateDB, blk, err := as.getStateDBAt(longestChain, number, hash)
+	if err != nil {
+		return nil, 0, err
+	}
+	return database.NewVisitor(0, stateDB), blk.Head.Time, nil
+}
+
+// getStateDBAt returns the state db at the block specified by hash or number,
+// or by longestChain if neither is specified, and the block.
+func (as *APIService) getStateDBAt(longestChain bool, number int64, hash string) (db.MVCCDB, *block.Block, error) {
+	var (
+		blk *block.Block
+		err error
+	)
+	switch {
+	case hash != "":
+		hashBytes := common.Base58Decode(hash)
+		blk, err = as.bc.GetBlockByHash(hashBytes)
+		if err != nil {
+			blk, err = as.blockchain.GetBlockByHash(hashBytes)
+		}
+	case number != 0:
+		blk, err = as.bc.GetBlockByNumber(number)
+		if err != nil {
+			blk, err = as.blockchain.GetBlockByNumber(number)
+		}
+	case longestChain:
+		blk = as.bc.Head().Block
+	default:
+		blk = as.bc.LinkedRoot().Block
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("block not found: %v", err)
+	}
+	stateDB := as.bv.StateDB().Fork()
+	if !stateDB.Checkout(string(blk.HeadHash())) {
+		stateDB, err = as.bv.StateDB().Historical(string(blk.HeadHash()))
+		if err != nil {
+			return nil, nil, fmt.Errorf("state of block %v is not available: %v", blk.Head.Number, err)
+		}
+	}
+	return stateDB, blk, nil
+}
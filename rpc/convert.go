@@ -7,7 +7,9 @@ import (
 	"github.com/iost-official/go-iost/core/block"
 	"github.com/iost-official/go-iost/core/contract"
 	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/core/txpool"
 	"github.com/iost-official/go-iost/crypto"
+	"github.com/iost-official/go-iost/db"
 	"github.com/iost-official/go-iost/rpc/pb"
 	"github.com/iost-official/go-iost/verifier"
 )
@@ -38,6 +40,14 @@ func toPbTxReceipt(tr *tx.TxReceipt) *rpcpb.TxReceipt {
 			Content:  r.Content,
 		})
 	}
+	for _, ar := range tr.ActionReceipts {
+		ret.ActionReceipts = append(ret.ActionReceipts, &rpcpb.TxReceipt_ActionReceipt{
+			StatusCode:  rpcpb.TxReceipt_StatusCode(ar.Status.Code),
+			Message:     ar.Status.Message,
+			ReturnValue: ar.Return,
+			GasUsage:    float64(ar.GasUsage) / 100,
+		})
+	}
 	return ret
 }
 
@@ -60,6 +70,14 @@ func toPbTx(t *tx.Tx, tr *tx.TxReceipt) *rpcpb.Transaction {
 		Publisher:  t.Publisher,
 		ReferredTx: common.Base58Encode(t.ReferredTx),
 		TxReceipt:  toPbTxReceipt(tr),
+		Sequence:   t.Sequence,
+
+		Sponsor:         t.Sponsor,
+		SponsorGasLimit: float64(t.SponsorGasLimit) / 100,
+		SponsorRamLimit: t.SponsorRAMLimit,
+
+		RecurInterval: t.RecurInterval,
+		RecurCount:    t.RecurCount,
 	}
 	for _, a := range t.Actions {
 		ret.Actions = append(ret.Actions, toPbAction(a))
@@ -70,6 +88,27 @@ func toPbTx(t *tx.Tx, tr *tx.TxReceipt) *rpcpb.Transaction {
 	return ret
 }
 
+func toPbTxStatus(status txpool.TxStatus, reason string) *rpcpb.TxStatusResponse {
+	ret := &rpcpb.TxStatusResponse{Reason: reason}
+	switch status {
+	case txpool.TxStatusPending:
+		ret.Status = rpcpb.TxStatusResponse_PENDING
+	case txpool.TxStatusPacked:
+		ret.Status = rpcpb.TxStatusResponse_PACKED
+	case txpool.TxStatusIrreversible:
+		ret.Status = rpcpb.TxStatusResponse_IRREVERSIBLE
+	case txpool.TxStatusExpired:
+		ret.Status = rpcpb.TxStatusResponse_EXPIRED
+	case txpool.TxStatusRejected:
+		ret.Status = rpcpb.TxStatusResponse_REJECTED
+	case txpool.TxStatusDropped:
+		ret.Status = rpcpb.TxStatusResponse_DROPPED
+	default:
+		ret.Status = rpcpb.TxStatusResponse_UNKNOWN
+	}
+	return ret
+}
+
 func toPbBlock(blk *block.Block, complete bool) *rpcpb.Block {
 	ret := &rpcpb.Block{
 		Hash:                common.Base58Encode(blk.HeadHash()),
@@ -82,6 +121,7 @@ func toPbBlock(blk *block.Block, complete bool) *rpcpb.Block {
 		Time:                blk.Head.Time,
 		GasUsage:            float64(blk.CalculateGasUsage()) / 100,
 		TxCount:             int64(len(blk.Txs)),
+		StateRoot:           common.Base58Encode(blk.Head.StateRoot),
 	}
 	var info verifier.Info
 	json.Unmarshal(blk.Head.Info, &info)
@@ -100,6 +140,37 @@ func toPbBlock(blk *block.Block, complete bool) *rpcpb.Block {
 	return ret
 }
 
+func toPbMerkleProof(leaf []byte, mp [][]byte, blk *block.Block) (*rpcpb.MerkleProofResponse, error) {
+	head, err := blk.Head.Encode()
+	if err != nil {
+		return nil, err
+	}
+	ret := &rpcpb.MerkleProofResponse{
+		LeafHash:  common.Base58Encode(leaf),
+		Block:     toPbBlock(blk, false),
+		BlockHead: common.Base58Encode(head),
+	}
+	for _, p := range mp {
+		ret.MerklePath = append(ret.MerklePath, common.Base58Encode(p))
+	}
+	return ret, nil
+}
+
+func toPbStateProof(key string, value string, exist bool, proof *db.StateProof, blk *block.Block) *rpcpb.StateProofResponse {
+	ret := &rpcpb.StateProofResponse{
+		Key:       key,
+		Value:     value,
+		Exist:     exist,
+		LeafKey:   common.Base58Encode(proof.LeafKey),
+		LeafValue: common.Base58Encode(proof.LeafValue),
+		Block:     toPbBlock(blk, false),
+	}
+	for _, s := range proof.Siblings {
+		ret.Siblings = append(ret.Siblings, common.Base58Encode(s))
+	}
+	return ret
+}
+
 func toPbItem(item *account.Item) *rpcpb.Account_Item {
 	return &rpcpb.Account_Item{
 		Id:         item.ID,
@@ -173,6 +244,14 @@ func toCoreTx(t *rpcpb.TransactionRequest) *tx.Tx {
 		Delay:      t.Delay,
 		Signers:    t.Signers,
 		Publisher:  t.Publisher,
+		Sequence:   t.Sequence,
+
+		Sponsor:         t.Sponsor,
+		SponsorGasLimit: int64(t.SponsorGasLimit * 100),
+		SponsorRAMLimit: t.SponsorRamLimit,
+
+		RecurInterval: t.RecurInterval,
+		RecurCount:    t.RecurCount,
 	}
 	for _, a := range t.Actions {
 		ret.Actions = append(ret.Actions, &tx.Action{
@@ -201,5 +280,12 @@ func toCoreTx(t *rpcpb.TransactionRequest) *tx.Tx {
 			Sig:       s.Signature,
 		})
 	}
+	for _, s := range t.SponsorSigs {
+		ret.SponsorSigns = append(ret.SponsorSigns, &crypto.Signature{
+			Algorithm: crypto.Algorithm(s.Algorithm),
+			Pubkey:    s.PublicKey,
+			Sig:       s.Signature,
+		})
+	}
 	return ret
 }
@@ -60,6 +60,19 @@ func (mr *MockApiServiceServerMockRecorder) GetAccount(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockApiServiceServer)(nil).GetAccount), arg0, arg1)
 }
 
+// GetAccountTransactions mocks base method
+func (m *MockApiServiceServer) GetAccountTransactions(arg0 context.Context, arg1 *pb.GetAccountTransactionsRequest) (*pb.GetAccountTransactionsResponse, error) {
+	ret := m.ctrl.Call(m, "GetAccountTransactions", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetAccountTransactionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountTransactions indicates an expected call of GetAccountTransactions
+func (mr *MockApiServiceServerMockRecorder) GetAccountTransactions(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountTransactions", reflect.TypeOf((*MockApiServiceServer)(nil).GetAccountTransactions), arg0, arg1)
+}
+
 // GetBlockByHash mocks base method
 func (m *MockApiServiceServer) GetBlockByHash(arg0 context.Context, arg1 *pb.GetBlockByHashRequest) (*pb.BlockResponse, error) {
 	ret := m.ctrl.Call(m, "GetBlockByHash", arg0, arg1)
@@ -86,6 +99,18 @@ func (mr *MockApiServiceServerMockRecorder) GetBlockByNumber(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockByNumber", reflect.TypeOf((*MockApiServiceServer)(nil).GetBlockByNumber), arg0, arg1)
 }
 
+// GetBlocksByRange mocks base method
+func (m *MockApiServiceServer) GetBlocksByRange(arg0 *pb.GetBlocksByRangeRequest, arg1 pb.ApiService_GetBlocksByRangeServer) error {
+	ret := m.ctrl.Call(m, "GetBlocksByRange", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetBlocksByRange indicates an expected call of GetBlocksByRange
+func (mr *MockApiServiceServerMockRecorder) GetBlocksByRange(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlocksByRange", reflect.TypeOf((*MockApiServiceServer)(nil).GetBlocksByRange), arg0, arg1)
+}
+
 // GetChainInfo mocks base method
 func (m *MockApiServiceServer) GetChainInfo(arg0 context.Context, arg1 *pb.EmptyRequest) (*pb.ChainInfoResponse, error) {
 	ret := m.ctrl.Call(m, "GetChainInfo", arg0, arg1)
@@ -125,6 +150,19 @@ func (mr *MockApiServiceServerMockRecorder) GetContractStorage(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContractStorage", reflect.TypeOf((*MockApiServiceServer)(nil).GetContractStorage), arg0, arg1)
 }
 
+// GetDoubleSignEvidences mocks base method
+func (m *MockApiServiceServer) GetDoubleSignEvidences(arg0 context.Context, arg1 *pb.EmptyRequest) (*pb.GetDoubleSignEvidencesResponse, error) {
+	ret := m.ctrl.Call(m, "GetDoubleSignEvidences", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetDoubleSignEvidencesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDoubleSignEvidences indicates an expected call of GetDoubleSignEvidences
+func (mr *MockApiServiceServerMockRecorder) GetDoubleSignEvidences(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDoubleSignEvidences", reflect.TypeOf((*MockApiServiceServer)(nil).GetDoubleSignEvidences), arg0, arg1)
+}
+
 // GetGasRatio mocks base method
 func (m *MockApiServiceServer) GetGasRatio(arg0 context.Context, arg1 *pb.EmptyRequest) (*pb.GasRatioResponse, error) {
 	ret := m.ctrl.Call(m, "GetGasRatio", arg0, arg1)
@@ -164,6 +202,32 @@ func (mr *MockApiServiceServerMockRecorder) GetRAMInfo(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRAMInfo", reflect.TypeOf((*MockApiServiceServer)(nil).GetRAMInfo), arg0, arg1)
 }
 
+// GetScheduledTxs mocks base method
+func (m *MockApiServiceServer) GetScheduledTxs(arg0 context.Context, arg1 *pb.GetScheduledTxsRequest) (*pb.GetScheduledTxsResponse, error) {
+	ret := m.ctrl.Call(m, "GetScheduledTxs", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetScheduledTxsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScheduledTxs indicates an expected call of GetScheduledTxs
+func (mr *MockApiServiceServerMockRecorder) GetScheduledTxs(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduledTxs", reflect.TypeOf((*MockApiServiceServer)(nil).GetScheduledTxs), arg0, arg1)
+}
+
+// GetStateProof mocks base method
+func (m *MockApiServiceServer) GetStateProof(arg0 context.Context, arg1 *pb.GetContractStorageRequest) (*pb.StateProofResponse, error) {
+	ret := m.ctrl.Call(m, "GetStateProof", arg0, arg1)
+	ret0, _ := ret[0].(*pb.StateProofResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStateProof indicates an expected call of GetStateProof
+func (mr *MockApiServiceServerMockRecorder) GetStateProof(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStateProof", reflect.TypeOf((*MockApiServiceServer)(nil).GetStateProof), arg0, arg1)
+}
+
 // GetToken721Balance mocks base method
 func (m *MockApiServiceServer) GetToken721Balance(arg0 context.Context, arg1 *pb.GetTokenBalanceRequest) (*pb.GetToken721BalanceResponse, error) {
 	ret := m.ctrl.Call(m, "GetToken721Balance", arg0, arg1)
@@ -229,6 +293,19 @@ func (mr *MockApiServiceServerMockRecorder) GetTxByHash(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxByHash", reflect.TypeOf((*MockApiServiceServer)(nil).GetTxByHash), arg0, arg1)
 }
 
+// GetTxProof mocks base method
+func (m *MockApiServiceServer) GetTxProof(arg0 context.Context, arg1 *pb.TxHashRequest) (*pb.MerkleProofResponse, error) {
+	ret := m.ctrl.Call(m, "GetTxProof", arg0, arg1)
+	ret0, _ := ret[0].(*pb.MerkleProofResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTxProof indicates an expected call of GetTxProof
+func (mr *MockApiServiceServerMockRecorder) GetTxProof(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxProof", reflect.TypeOf((*MockApiServiceServer)(nil).GetTxProof), arg0, arg1)
+}
+
 // GetTxReceiptByTxHash mocks base method
 func (m *MockApiServiceServer) GetTxReceiptByTxHash(arg0 context.Context, arg1 *pb.TxHashRequest) (*pb.TxReceipt, error) {
 	ret := m.ctrl.Call(m, "GetTxReceiptByTxHash", arg0, arg1)
@@ -242,6 +319,58 @@ func (mr *MockApiServiceServerMockRecorder) GetTxReceiptByTxHash(arg0, arg1 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxReceiptByTxHash", reflect.TypeOf((*MockApiServiceServer)(nil).GetTxReceiptByTxHash), arg0, arg1)
 }
 
+// GetTxReceiptProof mocks base method
+func (m *MockApiServiceServer) GetTxReceiptProof(arg0 context.Context, arg1 *pb.TxHashRequest) (*pb.MerkleProofResponse, error) {
+	ret := m.ctrl.Call(m, "GetTxReceiptProof", arg0, arg1)
+	ret0, _ := ret[0].(*pb.MerkleProofResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTxReceiptProof indicates an expected call of GetTxReceiptProof
+func (mr *MockApiServiceServerMockRecorder) GetTxReceiptProof(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxReceiptProof", reflect.TypeOf((*MockApiServiceServer)(nil).GetTxReceiptProof), arg0, arg1)
+}
+
+// GetTxStatus mocks base method
+func (m *MockApiServiceServer) GetTxStatus(arg0 context.Context, arg1 *pb.TxHashRequest) (*pb.TxStatusResponse, error) {
+	ret := m.ctrl.Call(m, "GetTxStatus", arg0, arg1)
+	ret0, _ := ret[0].(*pb.TxStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTxStatus indicates an expected call of GetTxStatus
+func (mr *MockApiServiceServerMockRecorder) GetTxStatus(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxStatus", reflect.TypeOf((*MockApiServiceServer)(nil).GetTxStatus), arg0, arg1)
+}
+
+// GetTxsByBlock mocks base method
+func (m *MockApiServiceServer) GetTxsByBlock(arg0 context.Context, arg1 *pb.GetTxsByBlockRequest) (*pb.GetTxsByBlockResponse, error) {
+	ret := m.ctrl.Call(m, "GetTxsByBlock", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetTxsByBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTxsByBlock indicates an expected call of GetTxsByBlock
+func (mr *MockApiServiceServerMockRecorder) GetTxsByBlock(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTxsByBlock", reflect.TypeOf((*MockApiServiceServer)(nil).GetTxsByBlock), arg0, arg1)
+}
+
+// GetWitnessSchedule mocks base method
+func (m *MockApiServiceServer) GetWitnessSchedule(arg0 context.Context, arg1 *pb.GetWitnessScheduleRequest) (*pb.GetWitnessScheduleResponse, error) {
+	ret := m.ctrl.Call(m, "GetWitnessSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*pb.GetWitnessScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWitnessSchedule indicates an expected call of GetWitnessSchedule
+func (mr *MockApiServiceServerMockRecorder) GetWitnessSchedule(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWitnessSchedule", reflect.TypeOf((*MockApiServiceServer)(nil).GetWitnessSchedule), arg0, arg1)
+}
+
 // SendTransaction mocks base method
 func (m *MockApiServiceServer) SendTransaction(arg0 context.Context, arg1 *pb.TransactionRequest) (*pb.SendTransactionResponse, error) {
 	ret := m.ctrl.Call(m, "SendTransaction", arg0, arg1)
@@ -266,3 +395,15 @@ func (m *MockApiServiceServer) Subscribe(arg0 *pb.SubscribeRequest, arg1 pb.ApiS
 func (mr *MockApiServiceServerMockRecorder) Subscribe(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockApiServiceServer)(nil).Subscribe), arg0, arg1)
 }
+
+// SubscribeTxStatus mocks base method
+func (m *MockApiServiceServer) SubscribeTxStatus(arg0 *pb.TxHashRequest, arg1 pb.ApiService_SubscribeTxStatusServer) error {
+	ret := m.ctrl.Call(m, "SubscribeTxStatus", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubscribeTxStatus indicates an expected call of SubscribeTxStatus
+func (mr *MockApiServiceServerMockRecorder) SubscribeTxStatus(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeTxStatus", reflect.TypeOf((*MockApiServiceServer)(nil).SubscribeTxStatus), arg0, arg1)
+}
@@ -171,6 +171,54 @@ func (BlockResponse_Status) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_1b773bf3e696f610, []int{13, 0}
 }
 
+// The enumeration defines transaction lifecycle status.
+type TxStatusResponse_Status int32
+
+const (
+	// not known by the node
+	TxStatusResponse_UNKNOWN TxStatusResponse_Status = 0
+	// pending in the transaction pool
+	TxStatusResponse_PENDING TxStatusResponse_Status = 1
+	// packed in a non-irreversible block of the longest chain
+	TxStatusResponse_PACKED TxStatusResponse_Status = 2
+	// packed in an irreversible block
+	TxStatusResponse_IRREVERSIBLE TxStatusResponse_Status = 3
+	// expired before being packed
+	TxStatusResponse_EXPIRED TxStatusResponse_Status = 4
+	// rejected by the transaction pool or block producer
+	TxStatusResponse_REJECTED TxStatusResponse_Status = 5
+	// dropped by the transaction pool for lack of room, it may be accepted if resubmitted
+	TxStatusResponse_DROPPED TxStatusResponse_Status = 6
+)
+
+var TxStatusResponse_Status_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "PENDING",
+	2: "PACKED",
+	3: "IRREVERSIBLE",
+	4: "EXPIRED",
+	5: "REJECTED",
+	6: "DROPPED",
+}
+
+var TxStatusResponse_Status_value = map[string]int32{
+	"UNKNOWN":      0,
+	"PENDING":      1,
+	"PACKED":       2,
+	"IRREVERSIBLE": 3,
+	"EXPIRED":      4,
+	"REJECTED":     5,
+	"DROPPED":      6,
+}
+
+func (x TxStatusResponse_Status) String() string {
+	return proto.EnumName(TxStatusResponse_Status_name, int32(x))
+}
+
+func (TxStatusResponse_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{17, 0}
+}
+
 type Event_Topic int32
 
 const (
@@ -178,16 +226,32 @@ const (
 	Event_CONTRACT_RECEIPT Event_Topic = 0
 	// contract event
 	Event_CONTRACT_EVENT Event_Topic = 1
+	// block added to the head of the longest chain
+	Event_NEW_BLOCK Event_Topic = 2
+	// block becomes irreversible
+	Event_IRREVERSIBLE_BLOCK Event_Topic = 3
+	// the longest chain switches to another fork
+	Event_CHAIN_REORG Event_Topic = 4
+	// transaction dropped from the pending pool without being packed
+	Event_TX_DROPPED Event_Topic = 5
 )
 
 var Event_Topic_name = map[int32]string{
 	0: "CONTRACT_RECEIPT",
 	1: "CONTRACT_EVENT",
+	2: "NEW_BLOCK",
+	3: "IRREVERSIBLE_BLOCK",
+	4: "CHAIN_REORG",
+	5: "TX_DROPPED",
 }
 
 var Event_Topic_value = map[string]int32{
-	"CONTRACT_RECEIPT": 0,
-	"CONTRACT_EVENT":   1,
+	"CONTRACT_RECEIPT":   0,
+	"CONTRACT_EVENT":     1,
+	"NEW_BLOCK":          2,
+	"IRREVERSIBLE_BLOCK": 3,
+	"CHAIN_REORG":        4,
+	"TX_DROPPED":         5,
 }
 
 func (x Event_Topic) String() string {
@@ -195,7 +259,7 @@ func (x Event_Topic) String() string {
 }
 
 func (Event_Topic) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{33, 0}
+	return fileDescriptor_1b773bf3e696f610, []int{46, 0}
 }
 
 // The message defines an empty request.
@@ -425,10 +489,12 @@ type NodeInfoResponse struct {
 	// node mode
 	Mode string `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
 	// network connection information
-	Network              *NetworkInfo `protobuf:"bytes,4,opt,name=network,proto3" json:"network,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Network *NetworkInfo `protobuf:"bytes,4,opt,name=network,proto3" json:"network,omitempty"`
+	// time of the node clock in nanoseconds, which transactions should be stamped with. it is ahead of the local time if warped in the dev consensus
+	Time                 int64    `protobuf:"varint,5,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *NodeInfoResponse) Reset()         { *m = NodeInfoResponse{} }
@@ -484,6 +550,13 @@ func (m *NodeInfoResponse) GetNetwork() *NetworkInfo {
 	return nil
 }
 
+func (m *NodeInfoResponse) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
 // The message defines transaction amount limit struct.
 type AmountLimit struct {
 	// token name
@@ -608,10 +681,12 @@ type TxReceipt struct {
 	// transaction returns
 	Returns []string `protobuf:"bytes,6,rep,name=returns,proto3" json:"returns,omitempty"`
 	// transaction receipts
-	Receipts             []*TxReceipt_Receipt `protobuf:"bytes,7,rep,name=receipts,proto3" json:"receipts,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Receipts []*TxReceipt_Receipt `protobuf:"bytes,7,rep,name=receipts,proto3" json:"receipts,omitempty"`
+	// execution results of the actions in order, until the first failed one
+	ActionReceipts       []*TxReceipt_ActionReceipt `protobuf:"bytes,8,rep,name=action_receipts,json=actionReceipts,proto3" json:"action_receipts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
 }
 
 func (m *TxReceipt) Reset()         { *m = TxReceipt{} }
@@ -688,6 +763,13 @@ func (m *TxReceipt) GetReceipts() []*TxReceipt_Receipt {
 	return nil
 }
 
+func (m *TxReceipt) GetActionReceipts() []*TxReceipt_ActionReceipt {
+	if m != nil {
+		return m.ActionReceipts
+	}
+	return nil
+}
+
 // The message defines transaction execution receipt.
 type TxReceipt_Receipt struct {
 	// function name
@@ -738,6 +820,74 @@ func (m *TxReceipt_Receipt) GetContent() string {
 	return ""
 }
 
+// The message defines the execution result of an action.
+type TxReceipt_ActionReceipt struct {
+	// status code
+	StatusCode TxReceipt_StatusCode `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3,enum=rpcpb.TxReceipt_StatusCode" json:"status_code,omitempty"`
+	// message
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// return value
+	ReturnValue string `protobuf:"bytes,3,opt,name=return_value,json=returnValue,proto3" json:"return_value,omitempty"`
+	// gas usage
+	GasUsage             float64  `protobuf:"fixed64,4,opt,name=gas_usage,json=gasUsage,proto3" json:"gas_usage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxReceipt_ActionReceipt) Reset()         { *m = TxReceipt_ActionReceipt{} }
+func (m *TxReceipt_ActionReceipt) String() string { return proto.CompactTextString(m) }
+func (*TxReceipt_ActionReceipt) ProtoMessage()    {}
+func (*TxReceipt_ActionReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{7, 2}
+}
+
+func (m *TxReceipt_ActionReceipt) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxReceipt_ActionReceipt.Unmarshal(m, b)
+}
+func (m *TxReceipt_ActionReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxReceipt_ActionReceipt.Marshal(b, m, deterministic)
+}
+func (m *TxReceipt_ActionReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxReceipt_ActionReceipt.Merge(m, src)
+}
+func (m *TxReceipt_ActionReceipt) XXX_Size() int {
+	return xxx_messageInfo_TxReceipt_ActionReceipt.Size(m)
+}
+func (m *TxReceipt_ActionReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxReceipt_ActionReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxReceipt_ActionReceipt proto.InternalMessageInfo
+
+func (m *TxReceipt_ActionReceipt) GetStatusCode() TxReceipt_StatusCode {
+	if m != nil {
+		return m.StatusCode
+	}
+	return TxReceipt_SUCCESS
+}
+
+func (m *TxReceipt_ActionReceipt) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *TxReceipt_ActionReceipt) GetReturnValue() string {
+	if m != nil {
+		return m.ReturnValue
+	}
+	return ""
+}
+
+func (m *TxReceipt_ActionReceipt) GetGasUsage() float64 {
+	if m != nil {
+		return m.GasUsage
+	}
+	return 0
+}
+
 // The message defines transaction struct.
 type Transaction struct {
 	// transaction hash
@@ -763,10 +913,22 @@ type Transaction struct {
 	// amount limit
 	AmountLimit []*AmountLimit `protobuf:"bytes,11,rep,name=amount_limit,json=amountLimit,proto3" json:"amount_limit,omitempty"`
 	// transaction receipt
-	TxReceipt            *TxReceipt `protobuf:"bytes,12,opt,name=tx_receipt,json=txReceipt,proto3" json:"tx_receipt,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	TxReceipt *TxReceipt `protobuf:"bytes,12,opt,name=tx_receipt,json=txReceipt,proto3" json:"tx_receipt,omitempty"`
+	// sequence of the publisher, 0 if not sequenced
+	Sequence int64 `protobuf:"varint,13,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// sponsor paying the gas and ram, empty if not sponsored
+	Sponsor string `protobuf:"bytes,14,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// max gas limit the sponsor pays
+	SponsorGasLimit float64 `protobuf:"fixed64,15,opt,name=sponsor_gas_limit,json=sponsorGasLimit,proto3" json:"sponsor_gas_limit,omitempty"`
+	// max ram in bytes the sponsor pays
+	SponsorRamLimit int64 `protobuf:"varint,16,opt,name=sponsor_ram_limit,json=sponsorRamLimit,proto3" json:"sponsor_ram_limit,omitempty"`
+	// interval nanoseconds of a recurring delay transaction
+	RecurInterval int64 `protobuf:"varint,17,opt,name=recur_interval,json=recurInterval,proto3" json:"recur_interval,omitempty"`
+	// count of executions of a recurring delay transaction
+	RecurCount           int64    `protobuf:"varint,18,opt,name=recur_count,json=recurCount,proto3" json:"recur_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Transaction) Reset()         { *m = Transaction{} }
@@ -878,6 +1040,48 @@ func (m *Transaction) GetTxReceipt() *TxReceipt {
 	return nil
 }
 
+func (m *Transaction) GetSequence() int64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *Transaction) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *Transaction) GetSponsorGasLimit() float64 {
+	if m != nil {
+		return m.SponsorGasLimit
+	}
+	return 0
+}
+
+func (m *Transaction) GetSponsorRamLimit() int64 {
+	if m != nil {
+		return m.SponsorRamLimit
+	}
+	return 0
+}
+
+func (m *Transaction) GetRecurInterval() int64 {
+	if m != nil {
+		return m.RecurInterval
+	}
+	return 0
+}
+
+func (m *Transaction) GetRecurCount() int64 {
+	if m != nil {
+		return m.RecurCount
+	}
+	return 0
+}
+
 // The message defines transaction response.
 type TransactionResponse struct {
 	// transaction status
@@ -1010,10 +1214,24 @@ type TransactionRequest struct {
 	// publisher
 	Publisher string `protobuf:"bytes,10,opt,name=publisher,proto3" json:"publisher,omitempty"`
 	// signatures of publisher
-	PublisherSigs        []*Signature `protobuf:"bytes,11,rep,name=publisher_sigs,json=publisherSigs,proto3" json:"publisher_sigs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	PublisherSigs []*Signature `protobuf:"bytes,11,rep,name=publisher_sigs,json=publisherSigs,proto3" json:"publisher_sigs,omitempty"`
+	// sequence of the publisher, 0 if not sequenced
+	Sequence int64 `protobuf:"varint,12,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// sponsor paying the gas and ram, empty if not sponsored
+	Sponsor string `protobuf:"bytes,13,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// max gas limit the sponsor pays
+	SponsorGasLimit float64 `protobuf:"fixed64,14,opt,name=sponsor_gas_limit,json=sponsorGasLimit,proto3" json:"sponsor_gas_limit,omitempty"`
+	// max ram in bytes the sponsor pays
+	SponsorRamLimit int64 `protobuf:"varint,15,opt,name=sponsor_ram_limit,json=sponsorRamLimit,proto3" json:"sponsor_ram_limit,omitempty"`
+	// signatures of sponsor
+	SponsorSigs []*Signature `protobuf:"bytes,16,rep,name=sponsor_sigs,json=sponsorSigs,proto3" json:"sponsor_sigs,omitempty"`
+	// interval nanoseconds of a recurring delay transaction
+	RecurInterval int64 `protobuf:"varint,17,opt,name=recur_interval,json=recurInterval,proto3" json:"recur_interval,omitempty"`
+	// count of executions of a recurring delay transaction, 0 or 1 means executed once
+	RecurCount           int64    `protobuf:"varint,18,opt,name=recur_count,json=recurCount,proto3" json:"recur_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *TransactionRequest) Reset()         { *m = TransactionRequest{} }
@@ -1118,6 +1336,55 @@ func (m *TransactionRequest) GetPublisherSigs() []*Signature {
 	return nil
 }
 
+func (m *TransactionRequest) GetSequence() int64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *TransactionRequest) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *TransactionRequest) GetSponsorGasLimit() float64 {
+	if m != nil {
+		return m.SponsorGasLimit
+	}
+	return 0
+}
+
+func (m *TransactionRequest) GetSponsorRamLimit() int64 {
+	if m != nil {
+		return m.SponsorRamLimit
+	}
+	return 0
+}
+
+func (m *TransactionRequest) GetSponsorSigs() []*Signature {
+	if m != nil {
+		return m.SponsorSigs
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetRecurInterval() int64 {
+	if m != nil {
+		return m.RecurInterval
+	}
+	return 0
+}
+
+func (m *TransactionRequest) GetRecurCount() int64 {
+	if m != nil {
+		return m.RecurCount
+	}
+	return 0
+}
+
 // The message defines the block struct.
 type Block struct {
 	// block hash
@@ -1143,10 +1410,12 @@ type Block struct {
 	// extra information
 	Info *Block_Info `protobuf:"bytes,11,opt,name=info,proto3" json:"info,omitempty"`
 	// block transactions
-	Transactions         []*Transaction `protobuf:"bytes,12,rep,name=transactions,proto3" json:"transactions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	Transactions []*Transaction `protobuf:"bytes,12,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	// state trie root hash
+	StateRoot            string   `protobuf:"bytes,13,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Block) Reset()         { *m = Block{} }
@@ -1258,6 +1527,13 @@ func (m *Block) GetTransactions() []*Transaction {
 	return nil
 }
 
+func (m *Block) GetStateRoot() string {
+	if m != nil {
+		return m.StateRoot
+	}
+	return ""
+}
+
 // The message defines block extra information
 type Block_Info struct {
 	// pack mode
@@ -1366,6 +1642,74 @@ func (m *BlockResponse) GetBlock() *Block {
 	return nil
 }
 
+// The message defines the merkle proof of a leaf in a block's merkle tree.
+type MerkleProofResponse struct {
+	// proven leaf, transaction hash or transaction receipt hash
+	LeafHash string `protobuf:"bytes,1,opt,name=leaf_hash,json=leafHash,proto3" json:"leaf_hash,omitempty"`
+	// merkle path from the leaf up to the root
+	MerklePath []string `protobuf:"bytes,2,rep,name=merkle_path,json=merklePath,proto3" json:"merkle_path,omitempty"`
+	// block containing the leaf
+	Block *Block `protobuf:"bytes,3,opt,name=block,proto3" json:"block,omitempty"`
+	// base58 encoded block head, whose hash is the block hash
+	BlockHead            string   `protobuf:"bytes,4,opt,name=block_head,json=blockHead,proto3" json:"block_head,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MerkleProofResponse) Reset()         { *m = MerkleProofResponse{} }
+func (m *MerkleProofResponse) String() string { return proto.CompactTextString(m) }
+func (*MerkleProofResponse) ProtoMessage()    {}
+func (*MerkleProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{14}
+}
+
+func (m *MerkleProofResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MerkleProofResponse.Unmarshal(m, b)
+}
+func (m *MerkleProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MerkleProofResponse.Marshal(b, m, deterministic)
+}
+func (m *MerkleProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerkleProofResponse.Merge(m, src)
+}
+func (m *MerkleProofResponse) XXX_Size() int {
+	return xxx_messageInfo_MerkleProofResponse.Size(m)
+}
+func (m *MerkleProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerkleProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerkleProofResponse proto.InternalMessageInfo
+
+func (m *MerkleProofResponse) GetLeafHash() string {
+	if m != nil {
+		return m.LeafHash
+	}
+	return ""
+}
+
+func (m *MerkleProofResponse) GetMerklePath() []string {
+	if m != nil {
+		return m.MerklePath
+	}
+	return nil
+}
+
+func (m *MerkleProofResponse) GetBlock() *Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *MerkleProofResponse) GetBlockHead() string {
+	if m != nil {
+		return m.BlockHead
+	}
+	return ""
+}
+
 // The message defines chain information response.
 type ChainInfoResponse struct {
 	// the name of network, such mainnet or testnet
@@ -1391,7 +1735,7 @@ func (m *ChainInfoResponse) Reset()         { *m = ChainInfoResponse{} }
 func (m *ChainInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*ChainInfoResponse) ProtoMessage()    {}
 func (*ChainInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{14}
+	return fileDescriptor_1b773bf3e696f610, []int{15}
 }
 
 func (m *ChainInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -1474,7 +1818,7 @@ func (m *TxHashRequest) Reset()         { *m = TxHashRequest{} }
 func (m *TxHashRequest) String() string { return proto.CompactTextString(m) }
 func (*TxHashRequest) ProtoMessage()    {}
 func (*TxHashRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{15}
+	return fileDescriptor_1b773bf3e696f610, []int{16}
 }
 
 func (m *TxHashRequest) XXX_Unmarshal(b []byte) error {
@@ -1502,6 +1846,56 @@ func (m *TxHashRequest) GetHash() string {
 	return ""
 }
 
+// The message defines transaction status response.
+type TxStatusResponse struct {
+	// transaction status
+	Status TxStatusResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=rpcpb.TxStatusResponse_Status" json:"status,omitempty"`
+	// reason of EXPIRED, REJECTED or DROPPED
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxStatusResponse) Reset()         { *m = TxStatusResponse{} }
+func (m *TxStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*TxStatusResponse) ProtoMessage()    {}
+func (*TxStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{17}
+}
+
+func (m *TxStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxStatusResponse.Unmarshal(m, b)
+}
+func (m *TxStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *TxStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxStatusResponse.Merge(m, src)
+}
+func (m *TxStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_TxStatusResponse.Size(m)
+}
+func (m *TxStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxStatusResponse proto.InternalMessageInfo
+
+func (m *TxStatusResponse) GetStatus() TxStatusResponse_Status {
+	if m != nil {
+		return m.Status
+	}
+	return TxStatusResponse_UNKNOWN
+}
+
+func (m *TxStatusResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 // The request message containing the block's hash.
 type GetBlockByHashRequest struct {
 	// block hash
@@ -1517,7 +1911,7 @@ func (m *GetBlockByHashRequest) Reset()         { *m = GetBlockByHashRequest{} }
 func (m *GetBlockByHashRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBlockByHashRequest) ProtoMessage()    {}
 func (*GetBlockByHashRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{16}
+	return fileDescriptor_1b773bf3e696f610, []int{18}
 }
 
 func (m *GetBlockByHashRequest) XXX_Unmarshal(b []byte) error {
@@ -1567,7 +1961,7 @@ func (m *GetBlockByNumberRequest) Reset()         { *m = GetBlockByNumberRequest
 func (m *GetBlockByNumberRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBlockByNumberRequest) ProtoMessage()    {}
 func (*GetBlockByNumberRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{17}
+	return fileDescriptor_1b773bf3e696f610, []int{19}
 }
 
 func (m *GetBlockByNumberRequest) XXX_Unmarshal(b []byte) error {
@@ -1602,866 +1996,1695 @@ func (m *GetBlockByNumberRequest) GetComplete() bool {
 	return false
 }
 
-// The message defines the account's frozen balance.
-type FrozenBalance struct {
-	// balance amount
-	Amount float64 `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	// free time
-	Time                 int64    `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+// The request message containing the range of block number.
+type GetBlocksByRangeRequest struct {
+	// the first block number, inclusive
+	StartNumber int64 `protobuf:"varint,1,opt,name=start_number,json=startNumber,proto3" json:"start_number,omitempty"`
+	// the last block number, inclusive
+	EndNumber int64 `protobuf:"varint,2,opt,name=end_number,json=endNumber,proto3" json:"end_number,omitempty"`
+	// complete means whether including the full transactions and transaction receipts
+	Complete             bool     `protobuf:"varint,3,opt,name=complete,proto3" json:"complete,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FrozenBalance) Reset()         { *m = FrozenBalance{} }
-func (m *FrozenBalance) String() string { return proto.CompactTextString(m) }
-func (*FrozenBalance) ProtoMessage()    {}
-func (*FrozenBalance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{18}
+func (m *GetBlocksByRangeRequest) Reset()         { *m = GetBlocksByRangeRequest{} }
+func (m *GetBlocksByRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlocksByRangeRequest) ProtoMessage()    {}
+func (*GetBlocksByRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{20}
 }
 
-func (m *FrozenBalance) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FrozenBalance.Unmarshal(m, b)
+func (m *GetBlocksByRangeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBlocksByRangeRequest.Unmarshal(m, b)
 }
-func (m *FrozenBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FrozenBalance.Marshal(b, m, deterministic)
+func (m *GetBlocksByRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBlocksByRangeRequest.Marshal(b, m, deterministic)
 }
-func (m *FrozenBalance) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FrozenBalance.Merge(m, src)
+func (m *GetBlocksByRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlocksByRangeRequest.Merge(m, src)
 }
-func (m *FrozenBalance) XXX_Size() int {
-	return xxx_messageInfo_FrozenBalance.Size(m)
+func (m *GetBlocksByRangeRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBlocksByRangeRequest.Size(m)
 }
-func (m *FrozenBalance) XXX_DiscardUnknown() {
-	xxx_messageInfo_FrozenBalance.DiscardUnknown(m)
+func (m *GetBlocksByRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlocksByRangeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FrozenBalance proto.InternalMessageInfo
+var xxx_messageInfo_GetBlocksByRangeRequest proto.InternalMessageInfo
 
-func (m *FrozenBalance) GetAmount() float64 {
+func (m *GetBlocksByRangeRequest) GetStartNumber() int64 {
 	if m != nil {
-		return m.Amount
+		return m.StartNumber
 	}
 	return 0
 }
 
-func (m *FrozenBalance) GetTime() int64 {
+func (m *GetBlocksByRangeRequest) GetEndNumber() int64 {
 	if m != nil {
-		return m.Time
+		return m.EndNumber
 	}
 	return 0
 }
 
-type GasRatioResponse struct {
-	// lowest gas ratio in head block
-	LowestGasRatio float64 `protobuf:"fixed64,1,opt,name=lowest_gas_ratio,json=lowestGasRatio,proto3" json:"lowest_gas_ratio,omitempty"`
-	// median gas ratio in head block
-	MedianGasRatio       float64  `protobuf:"fixed64,2,opt,name=median_gas_ratio,json=medianGasRatio,proto3" json:"median_gas_ratio,omitempty"`
+func (m *GetBlocksByRangeRequest) GetComplete() bool {
+	if m != nil {
+		return m.Complete
+	}
+	return false
+}
+
+// The request message containing the block and the page of transactions.
+type GetTxsByBlockRequest struct {
+	// block number, used when hash is empty
+	Number int64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	// block hash
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// index of the first transaction
+	Offset int64 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	// max count of transactions
+	Limit                int64    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GasRatioResponse) Reset()         { *m = GasRatioResponse{} }
-func (m *GasRatioResponse) String() string { return proto.CompactTextString(m) }
-func (*GasRatioResponse) ProtoMessage()    {}
-func (*GasRatioResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{19}
+func (m *GetTxsByBlockRequest) Reset()         { *m = GetTxsByBlockRequest{} }
+func (m *GetTxsByBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTxsByBlockRequest) ProtoMessage()    {}
+func (*GetTxsByBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{21}
 }
 
-func (m *GasRatioResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GasRatioResponse.Unmarshal(m, b)
+func (m *GetTxsByBlockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTxsByBlockRequest.Unmarshal(m, b)
 }
-func (m *GasRatioResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GasRatioResponse.Marshal(b, m, deterministic)
+func (m *GetTxsByBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTxsByBlockRequest.Marshal(b, m, deterministic)
 }
-func (m *GasRatioResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GasRatioResponse.Merge(m, src)
+func (m *GetTxsByBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTxsByBlockRequest.Merge(m, src)
 }
-func (m *GasRatioResponse) XXX_Size() int {
-	return xxx_messageInfo_GasRatioResponse.Size(m)
+func (m *GetTxsByBlockRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTxsByBlockRequest.Size(m)
 }
-func (m *GasRatioResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GasRatioResponse.DiscardUnknown(m)
+func (m *GetTxsByBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTxsByBlockRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GasRatioResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetTxsByBlockRequest proto.InternalMessageInfo
 
-func (m *GasRatioResponse) GetLowestGasRatio() float64 {
+func (m *GetTxsByBlockRequest) GetNumber() int64 {
 	if m != nil {
-		return m.LowestGasRatio
+		return m.Number
 	}
 	return 0
 }
 
-func (m *GasRatioResponse) GetMedianGasRatio() float64 {
+func (m *GetTxsByBlockRequest) GetHash() string {
 	if m != nil {
-		return m.MedianGasRatio
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *GetTxsByBlockRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
 	}
 	return 0
 }
 
-// The message defines account struct.
-type Account struct {
-	// account name
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// account balance
-	Balance float64 `protobuf:"fixed64,2,opt,name=balance,proto3" json:"balance,omitempty"`
-	// gas information
-	GasInfo *Account_GasInfo `protobuf:"bytes,3,opt,name=gas_info,json=gasInfo,proto3" json:"gas_info,omitempty"`
-	// ram information
-	RamInfo *Account_RAMInfo `protobuf:"bytes,4,opt,name=ram_info,json=ramInfo,proto3" json:"ram_info,omitempty"`
-	// account permission
-	Permissions map[string]*Account_Permission `protobuf:"bytes,5,rep,name=permissions,proto3" json:"permissions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// account groups
-	Groups map[string]*Account_Group `protobuf:"bytes,6,rep,name=groups,proto3" json:"groups,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// frozen balance information
-	FrozenBalances       []*FrozenBalance `protobuf:"bytes,7,rep,name=frozen_balances,json=frozenBalances,proto3" json:"frozen_balances,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+func (m *GetTxsByBlockRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
 }
 
-func (m *Account) Reset()         { *m = Account{} }
-func (m *Account) String() string { return proto.CompactTextString(m) }
-func (*Account) ProtoMessage()    {}
-func (*Account) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{20}
+// The message containing a page of the block's transactions.
+type GetTxsByBlockResponse struct {
+	// block status
+	Status BlockResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=rpcpb.BlockResponse_Status" json:"status,omitempty"`
+	// block hash
+	BlockHash string `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// total count of transactions in the block
+	Total int64 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	// transactions of the page
+	Transactions         []*Transaction `protobuf:"bytes,4,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *Account) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Account.Unmarshal(m, b)
+func (m *GetTxsByBlockResponse) Reset()         { *m = GetTxsByBlockResponse{} }
+func (m *GetTxsByBlockResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTxsByBlockResponse) ProtoMessage()    {}
+func (*GetTxsByBlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{22}
 }
-func (m *Account) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Account.Marshal(b, m, deterministic)
+
+func (m *GetTxsByBlockResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTxsByBlockResponse.Unmarshal(m, b)
 }
-func (m *Account) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Account.Merge(m, src)
+func (m *GetTxsByBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTxsByBlockResponse.Marshal(b, m, deterministic)
 }
-func (m *Account) XXX_Size() int {
-	return xxx_messageInfo_Account.Size(m)
+func (m *GetTxsByBlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTxsByBlockResponse.Merge(m, src)
 }
-func (m *Account) XXX_DiscardUnknown() {
-	xxx_messageInfo_Account.DiscardUnknown(m)
+func (m *GetTxsByBlockResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTxsByBlockResponse.Size(m)
+}
+func (m *GetTxsByBlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTxsByBlockResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Account proto.InternalMessageInfo
+var xxx_messageInfo_GetTxsByBlockResponse proto.InternalMessageInfo
 
-func (m *Account) GetName() string {
+func (m *GetTxsByBlockResponse) GetStatus() BlockResponse_Status {
 	if m != nil {
-		return m.Name
+		return m.Status
+	}
+	return BlockResponse_PENDIND
+}
+
+func (m *GetTxsByBlockResponse) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
 	}
 	return ""
 }
 
-func (m *Account) GetBalance() float64 {
+func (m *GetTxsByBlockResponse) GetTotal() int64 {
 	if m != nil {
-		return m.Balance
+		return m.Total
 	}
 	return 0
 }
 
-func (m *Account) GetGasInfo() *Account_GasInfo {
+func (m *GetTxsByBlockResponse) GetTransactions() []*Transaction {
 	if m != nil {
-		return m.GasInfo
+		return m.Transactions
 	}
 	return nil
 }
 
-func (m *Account) GetRamInfo() *Account_RAMInfo {
+// The request message containing the account and the page of transactions.
+type GetAccountTransactionsRequest struct {
+	// account name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// index of the first transaction
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	// max count of transactions
+	Limit                int64    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAccountTransactionsRequest) Reset()         { *m = GetAccountTransactionsRequest{} }
+func (m *GetAccountTransactionsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAccountTransactionsRequest) ProtoMessage()    {}
+func (*GetAccountTransactionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{23}
+}
+
+func (m *GetAccountTransactionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAccountTransactionsRequest.Unmarshal(m, b)
+}
+func (m *GetAccountTransactionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAccountTransactionsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetAccountTransactionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountTransactionsRequest.Merge(m, src)
+}
+func (m *GetAccountTransactionsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAccountTransactionsRequest.Size(m)
+}
+func (m *GetAccountTransactionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountTransactionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAccountTransactionsRequest proto.InternalMessageInfo
+
+func (m *GetAccountTransactionsRequest) GetName() string {
 	if m != nil {
-		return m.RamInfo
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-func (m *Account) GetPermissions() map[string]*Account_Permission {
+func (m *GetAccountTransactionsRequest) GetOffset() int64 {
 	if m != nil {
-		return m.Permissions
+		return m.Offset
 	}
-	return nil
+	return 0
 }
 
-func (m *Account) GetGroups() map[string]*Account_Group {
+func (m *GetAccountTransactionsRequest) GetLimit() int64 {
 	if m != nil {
-		return m.Groups
+		return m.Limit
 	}
-	return nil
+	return 0
 }
 
-func (m *Account) GetFrozenBalances() []*FrozenBalance {
+// The message containing a page of the account's transactions in order of block number.
+type GetAccountTransactionsResponse struct {
+	// transactions of the page
+	Transactions         []*Transaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GetAccountTransactionsResponse) Reset()         { *m = GetAccountTransactionsResponse{} }
+func (m *GetAccountTransactionsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAccountTransactionsResponse) ProtoMessage()    {}
+func (*GetAccountTransactionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{24}
+}
+
+func (m *GetAccountTransactionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAccountTransactionsResponse.Unmarshal(m, b)
+}
+func (m *GetAccountTransactionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAccountTransactionsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetAccountTransactionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountTransactionsResponse.Merge(m, src)
+}
+func (m *GetAccountTransactionsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAccountTransactionsResponse.Size(m)
+}
+func (m *GetAccountTransactionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountTransactionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAccountTransactionsResponse proto.InternalMessageInfo
+
+func (m *GetAccountTransactionsResponse) GetTransactions() []*Transaction {
 	if m != nil {
-		return m.FrozenBalances
+		return m.Transactions
 	}
 	return nil
 }
 
-// The message defines account pledged coin information.
-type Account_PledgeInfo struct {
-	// the account who pledges
-	Pledger string `protobuf:"bytes,1,opt,name=pledger,proto3" json:"pledger,omitempty"`
-	// pledged amount
-	Amount               float64  `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+// The message defines the get scheduled transactions request.
+type GetScheduledTxsRequest struct {
+	// account name
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Account_PledgeInfo) Reset()         { *m = Account_PledgeInfo{} }
-func (m *Account_PledgeInfo) String() string { return proto.CompactTextString(m) }
-func (*Account_PledgeInfo) ProtoMessage()    {}
-func (*Account_PledgeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{20, 0}
+func (m *GetScheduledTxsRequest) Reset()         { *m = GetScheduledTxsRequest{} }
+func (m *GetScheduledTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetScheduledTxsRequest) ProtoMessage()    {}
+func (*GetScheduledTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{25}
 }
 
-func (m *Account_PledgeInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Account_PledgeInfo.Unmarshal(m, b)
+func (m *GetScheduledTxsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetScheduledTxsRequest.Unmarshal(m, b)
 }
-func (m *Account_PledgeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Account_PledgeInfo.Marshal(b, m, deterministic)
+func (m *GetScheduledTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetScheduledTxsRequest.Marshal(b, m, deterministic)
 }
-func (m *Account_PledgeInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Account_PledgeInfo.Merge(m, src)
+func (m *GetScheduledTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetScheduledTxsRequest.Merge(m, src)
 }
-func (m *Account_PledgeInfo) XXX_Size() int {
-	return xxx_messageInfo_Account_PledgeInfo.Size(m)
+func (m *GetScheduledTxsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetScheduledTxsRequest.Size(m)
 }
-func (m *Account_PledgeInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_Account_PledgeInfo.DiscardUnknown(m)
+func (m *GetScheduledTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetScheduledTxsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Account_PledgeInfo proto.InternalMessageInfo
+var xxx_messageInfo_GetScheduledTxsRequest proto.InternalMessageInfo
 
-func (m *Account_PledgeInfo) GetPledger() string {
+func (m *GetScheduledTxsRequest) GetName() string {
 	if m != nil {
-		return m.Pledger
+		return m.Name
 	}
 	return ""
 }
 
-func (m *Account_PledgeInfo) GetAmount() float64 {
-	if m != nil {
-		return m.Amount
-	}
-	return 0
-}
-
-// The message defines account gas information.
-type Account_GasInfo struct {
-	// current total gas amount
-	CurrentTotal    float64 `protobuf:"fixed64,1,opt,name=current_total,json=currentTotal,proto3" json:"current_total,omitempty"`
-	TransferableGas float64 `protobuf:"fixed64,2,opt,name=transferable_gas,json=transferableGas,proto3" json:"transferable_gas,omitempty"`
-	PledgeGas       float64 `protobuf:"fixed64,3,opt,name=pledge_gas,json=pledgeGas,proto3" json:"pledge_gas,omitempty"`
-	// gas increase speed
-	IncreaseSpeed float64 `protobuf:"fixed64,4,opt,name=increase_speed,json=increaseSpeed,proto3" json:"increase_speed,omitempty"`
-	// gas limit
-	Limit float64 `protobuf:"fixed64,5,opt,name=limit,proto3" json:"limit,omitempty"`
-	// pledge information
-	PledgedInfo          []*Account_PledgeInfo `protobuf:"bytes,6,rep,name=pledged_info,json=pledgedInfo,proto3" json:"pledged_info,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+// The message containing the delay transactions of the account which are not executed all the times yet.
+type GetScheduledTxsResponse struct {
+	// scheduled transactions in order of next execution time
+	ScheduledTxs         []*GetScheduledTxsResponse_ScheduledTx `protobuf:"bytes,1,rep,name=scheduled_txs,json=scheduledTxs,proto3" json:"scheduled_txs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                               `json:"-"`
+	XXX_unrecognized     []byte                                 `json:"-"`
+	XXX_sizecache        int32                                  `json:"-"`
 }
 
-func (m *Account_GasInfo) Reset()         { *m = Account_GasInfo{} }
-func (m *Account_GasInfo) String() string { return proto.CompactTextString(m) }
-func (*Account_GasInfo) ProtoMessage()    {}
-func (*Account_GasInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{20, 1}
+func (m *GetScheduledTxsResponse) Reset()         { *m = GetScheduledTxsResponse{} }
+func (m *GetScheduledTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetScheduledTxsResponse) ProtoMessage()    {}
+func (*GetScheduledTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{26}
 }
 
-func (m *Account_GasInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Account_GasInfo.Unmarshal(m, b)
+func (m *GetScheduledTxsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetScheduledTxsResponse.Unmarshal(m, b)
 }
-func (m *Account_GasInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Account_GasInfo.Marshal(b, m, deterministic)
+func (m *GetScheduledTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetScheduledTxsResponse.Marshal(b, m, deterministic)
 }
-func (m *Account_GasInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Account_GasInfo.Merge(m, src)
+func (m *GetScheduledTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetScheduledTxsResponse.Merge(m, src)
 }
-func (m *Account_GasInfo) XXX_Size() int {
-	return xxx_messageInfo_Account_GasInfo.Size(m)
+func (m *GetScheduledTxsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetScheduledTxsResponse.Size(m)
 }
-func (m *Account_GasInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_Account_GasInfo.DiscardUnknown(m)
+func (m *GetScheduledTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetScheduledTxsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Account_GasInfo proto.InternalMessageInfo
+var xxx_messageInfo_GetScheduledTxsResponse proto.InternalMessageInfo
 
-func (m *Account_GasInfo) GetCurrentTotal() float64 {
+func (m *GetScheduledTxsResponse) GetScheduledTxs() []*GetScheduledTxsResponse_ScheduledTx {
 	if m != nil {
-		return m.CurrentTotal
+		return m.ScheduledTxs
 	}
-	return 0
+	return nil
 }
 
-func (m *Account_GasInfo) GetTransferableGas() float64 {
-	if m != nil {
-		return m.TransferableGas
-	}
-	return 0
+// The message defines a scheduled delay transaction.
+type GetScheduledTxsResponse_ScheduledTx struct {
+	// delay transaction
+	Transaction *Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	// timestamp of the next execution
+	NextTime int64 `protobuf:"varint,2,opt,name=next_time,json=nextTime,proto3" json:"next_time,omitempty"`
+	// count of the executions left
+	Remaining            int64    `protobuf:"varint,3,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Account_GasInfo) GetPledgeGas() float64 {
-	if m != nil {
-		return m.PledgeGas
-	}
-	return 0
+func (m *GetScheduledTxsResponse_ScheduledTx) Reset()         { *m = GetScheduledTxsResponse_ScheduledTx{} }
+func (m *GetScheduledTxsResponse_ScheduledTx) String() string { return proto.CompactTextString(m) }
+func (*GetScheduledTxsResponse_ScheduledTx) ProtoMessage()    {}
+func (*GetScheduledTxsResponse_ScheduledTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{26, 0}
 }
 
-func (m *Account_GasInfo) GetIncreaseSpeed() float64 {
+func (m *GetScheduledTxsResponse_ScheduledTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetScheduledTxsResponse_ScheduledTx.Unmarshal(m, b)
+}
+func (m *GetScheduledTxsResponse_ScheduledTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetScheduledTxsResponse_ScheduledTx.Marshal(b, m, deterministic)
+}
+func (m *GetScheduledTxsResponse_ScheduledTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetScheduledTxsResponse_ScheduledTx.Merge(m, src)
+}
+func (m *GetScheduledTxsResponse_ScheduledTx) XXX_Size() int {
+	return xxx_messageInfo_GetScheduledTxsResponse_ScheduledTx.Size(m)
+}
+func (m *GetScheduledTxsResponse_ScheduledTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetScheduledTxsResponse_ScheduledTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetScheduledTxsResponse_ScheduledTx proto.InternalMessageInfo
+
+func (m *GetScheduledTxsResponse_ScheduledTx) GetTransaction() *Transaction {
 	if m != nil {
-		return m.IncreaseSpeed
+		return m.Transaction
 	}
-	return 0
+	return nil
 }
 
-func (m *Account_GasInfo) GetLimit() float64 {
+func (m *GetScheduledTxsResponse_ScheduledTx) GetNextTime() int64 {
 	if m != nil {
-		return m.Limit
+		return m.NextTime
 	}
 	return 0
 }
 
-func (m *Account_GasInfo) GetPledgedInfo() []*Account_PledgeInfo {
+func (m *GetScheduledTxsResponse_ScheduledTx) GetRemaining() int64 {
 	if m != nil {
-		return m.PledgedInfo
+		return m.Remaining
 	}
-	return nil
+	return 0
 }
 
-// The message defines account ram information.
-type Account_RAMInfo struct {
-	// available ram bytes
-	Available            int64    `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
+// The message defines the account's frozen balance.
+type FrozenBalance struct {
+	// balance amount
+	Amount float64 `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	// free time
+	Time                 int64    `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Account_RAMInfo) Reset()         { *m = Account_RAMInfo{} }
-func (m *Account_RAMInfo) String() string { return proto.CompactTextString(m) }
-func (*Account_RAMInfo) ProtoMessage()    {}
-func (*Account_RAMInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{20, 2}
+func (m *FrozenBalance) Reset()         { *m = FrozenBalance{} }
+func (m *FrozenBalance) String() string { return proto.CompactTextString(m) }
+func (*FrozenBalance) ProtoMessage()    {}
+func (*FrozenBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{27}
 }
 
-func (m *Account_RAMInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Account_RAMInfo.Unmarshal(m, b)
+func (m *FrozenBalance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FrozenBalance.Unmarshal(m, b)
 }
-func (m *Account_RAMInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Account_RAMInfo.Marshal(b, m, deterministic)
+func (m *FrozenBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FrozenBalance.Marshal(b, m, deterministic)
 }
-func (m *Account_RAMInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Account_RAMInfo.Merge(m, src)
+func (m *FrozenBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrozenBalance.Merge(m, src)
 }
-func (m *Account_RAMInfo) XXX_Size() int {
-	return xxx_messageInfo_Account_RAMInfo.Size(m)
+func (m *FrozenBalance) XXX_Size() int {
+	return xxx_messageInfo_FrozenBalance.Size(m)
 }
-func (m *Account_RAMInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_Account_RAMInfo.DiscardUnknown(m)
+func (m *FrozenBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrozenBalance.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Account_RAMInfo proto.InternalMessageInfo
+var xxx_messageInfo_FrozenBalance proto.InternalMessageInfo
 
-func (m *Account_RAMInfo) GetAvailable() int64 {
+func (m *FrozenBalance) GetAmount() float64 {
 	if m != nil {
-		return m.Available
+		return m.Amount
 	}
 	return 0
 }
 
-// The message defines permission item.
-type Account_Item struct {
-	// permission name or key pair id
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// whether it's a key pair
-	IsKeyPair bool `protobuf:"varint,2,opt,name=is_key_pair,json=isKeyPair,proto3" json:"is_key_pair,omitempty"`
-	// permission weight
-	Weight int64 `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
-	// permission
-	Permission           string   `protobuf:"bytes,4,opt,name=permission,proto3" json:"permission,omitempty"`
+func (m *FrozenBalance) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+type GasRatioResponse struct {
+	// lowest gas ratio in head block
+	LowestGasRatio float64 `protobuf:"fixed64,1,opt,name=lowest_gas_ratio,json=lowestGasRatio,proto3" json:"lowest_gas_ratio,omitempty"`
+	// median gas ratio in head block
+	MedianGasRatio       float64  `protobuf:"fixed64,2,opt,name=median_gas_ratio,json=medianGasRatio,proto3" json:"median_gas_ratio,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Account_Item) Reset()         { *m = Account_Item{} }
-func (m *Account_Item) String() string { return proto.CompactTextString(m) }
-func (*Account_Item) ProtoMessage()    {}
-func (*Account_Item) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{20, 3}
+func (m *GasRatioResponse) Reset()         { *m = GasRatioResponse{} }
+func (m *GasRatioResponse) String() string { return proto.CompactTextString(m) }
+func (*GasRatioResponse) ProtoMessage()    {}
+func (*GasRatioResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{28}
 }
 
-func (m *Account_Item) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Account_Item.Unmarshal(m, b)
+func (m *GasRatioResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GasRatioResponse.Unmarshal(m, b)
 }
-func (m *Account_Item) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Account_Item.Marshal(b, m, deterministic)
+func (m *GasRatioResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GasRatioResponse.Marshal(b, m, deterministic)
 }
-func (m *Account_Item) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Account_Item.Merge(m, src)
+func (m *GasRatioResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasRatioResponse.Merge(m, src)
 }
-func (m *Account_Item) XXX_Size() int {
-	return xxx_messageInfo_Account_Item.Size(m)
+func (m *GasRatioResponse) XXX_Size() int {
+	return xxx_messageInfo_GasRatioResponse.Size(m)
 }
-func (m *Account_Item) XXX_DiscardUnknown() {
-	xxx_messageInfo_Account_Item.DiscardUnknown(m)
+func (m *GasRatioResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasRatioResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Account_Item proto.InternalMessageInfo
+var xxx_messageInfo_GasRatioResponse proto.InternalMessageInfo
 
-func (m *Account_Item) GetId() string {
+func (m *GasRatioResponse) GetLowestGasRatio() float64 {
 	if m != nil {
-		return m.Id
+		return m.LowestGasRatio
 	}
-	return ""
+	return 0
 }
 
-func (m *Account_Item) GetIsKeyPair() bool {
+func (m *GasRatioResponse) GetMedianGasRatio() float64 {
 	if m != nil {
-		return m.IsKeyPair
+		return m.MedianGasRatio
 	}
-	return false
+	return 0
 }
 
-func (m *Account_Item) GetWeight() int64 {
-	if m != nil {
-		return m.Weight
-	}
-	return 0
+// The message containing the evidences of witnesses signing two blocks with the same number in one slot.
+type GetDoubleSignEvidencesResponse struct {
+	Evidences            []*GetDoubleSignEvidencesResponse_Evidence `protobuf:"bytes,1,rep,name=evidences,proto3" json:"evidences,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                   `json:"-"`
+	XXX_unrecognized     []byte                                     `json:"-"`
+	XXX_sizecache        int32                                      `json:"-"`
 }
 
-func (m *Account_Item) GetPermission() string {
+func (m *GetDoubleSignEvidencesResponse) Reset()         { *m = GetDoubleSignEvidencesResponse{} }
+func (m *GetDoubleSignEvidencesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDoubleSignEvidencesResponse) ProtoMessage()    {}
+func (*GetDoubleSignEvidencesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{29}
+}
+
+func (m *GetDoubleSignEvidencesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDoubleSignEvidencesResponse.Unmarshal(m, b)
+}
+func (m *GetDoubleSignEvidencesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDoubleSignEvidencesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetDoubleSignEvidencesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDoubleSignEvidencesResponse.Merge(m, src)
+}
+func (m *GetDoubleSignEvidencesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDoubleSignEvidencesResponse.Size(m)
+}
+func (m *GetDoubleSignEvidencesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDoubleSignEvidencesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDoubleSignEvidencesResponse proto.InternalMessageInfo
+
+func (m *GetDoubleSignEvidencesResponse) GetEvidences() []*GetDoubleSignEvidencesResponse_Evidence {
 	if m != nil {
-		return m.Permission
+		return m.Evidences
 	}
-	return ""
+	return nil
 }
 
-// The message defines a permission group.
-type Account_Group struct {
-	// group name
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// permission items
-	Items                []*Account_Item `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+// The message defines an evidence of double signing.
+type GetDoubleSignEvidencesResponse_Evidence struct {
+	// witness signing the blocks
+	Witness string `protobuf:"bytes,1,opt,name=witness,proto3" json:"witness,omitempty"`
+	// block number
+	Number int64 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	// slot of the blocks
+	Slot int64 `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	// hashes of the two blocks
+	BlockHashes []string `protobuf:"bytes,4,rep,name=block_hashes,json=blockHashes,proto3" json:"block_hashes,omitempty"`
+	// base64 encoded evidence, which is reported to vote_producer.iost by ReportDoubleSign
+	Data                 string   `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Account_Group) Reset()         { *m = Account_Group{} }
-func (m *Account_Group) String() string { return proto.CompactTextString(m) }
-func (*Account_Group) ProtoMessage()    {}
-func (*Account_Group) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{20, 4}
+func (m *GetDoubleSignEvidencesResponse_Evidence) Reset() {
+	*m = GetDoubleSignEvidencesResponse_Evidence{}
+}
+func (m *GetDoubleSignEvidencesResponse_Evidence) String() string { return proto.CompactTextString(m) }
+func (*GetDoubleSignEvidencesResponse_Evidence) ProtoMessage()    {}
+func (*GetDoubleSignEvidencesResponse_Evidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{29, 0}
 }
 
-func (m *Account_Group) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Account_Group.Unmarshal(m, b)
+func (m *GetDoubleSignEvidencesResponse_Evidence) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDoubleSignEvidencesResponse_Evidence.Unmarshal(m, b)
 }
-func (m *Account_Group) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Account_Group.Marshal(b, m, deterministic)
+func (m *GetDoubleSignEvidencesResponse_Evidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDoubleSignEvidencesResponse_Evidence.Marshal(b, m, deterministic)
 }
-func (m *Account_Group) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Account_Group.Merge(m, src)
+func (m *GetDoubleSignEvidencesResponse_Evidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDoubleSignEvidencesResponse_Evidence.Merge(m, src)
 }
-func (m *Account_Group) XXX_Size() int {
-	return xxx_messageInfo_Account_Group.Size(m)
+func (m *GetDoubleSignEvidencesResponse_Evidence) XXX_Size() int {
+	return xxx_messageInfo_GetDoubleSignEvidencesResponse_Evidence.Size(m)
 }
-func (m *Account_Group) XXX_DiscardUnknown() {
-	xxx_messageInfo_Account_Group.DiscardUnknown(m)
+func (m *GetDoubleSignEvidencesResponse_Evidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDoubleSignEvidencesResponse_Evidence.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Account_Group proto.InternalMessageInfo
+var xxx_messageInfo_GetDoubleSignEvidencesResponse_Evidence proto.InternalMessageInfo
 
-func (m *Account_Group) GetName() string {
+func (m *GetDoubleSignEvidencesResponse_Evidence) GetWitness() string {
 	if m != nil {
-		return m.Name
+		return m.Witness
 	}
 	return ""
 }
 
-func (m *Account_Group) GetItems() []*Account_Item {
+func (m *GetDoubleSignEvidencesResponse_Evidence) GetNumber() int64 {
 	if m != nil {
-		return m.Items
+		return m.Number
+	}
+	return 0
+}
+
+func (m *GetDoubleSignEvidencesResponse_Evidence) GetSlot() int64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *GetDoubleSignEvidencesResponse_Evidence) GetBlockHashes() []string {
+	if m != nil {
+		return m.BlockHashes
 	}
 	return nil
 }
 
-// The message defines the permission struct.
-type Account_Permission struct {
-	// permission name
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// permission groups
-	Groups []string `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
-	// permission items
-	Items []*Account_Item `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
-	// permission threshold
-	Threshold            int64    `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+func (m *GetDoubleSignEvidencesResponse_Evidence) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+// The request message containing the number of slots to schedule.
+type GetWitnessScheduleRequest struct {
+	// number of slots from the current one, which is the number of witnesses if not set
+	Slots                int64    `protobuf:"varint,1,opt,name=slots,proto3" json:"slots,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Account_Permission) Reset()         { *m = Account_Permission{} }
-func (m *Account_Permission) String() string { return proto.CompactTextString(m) }
-func (*Account_Permission) ProtoMessage()    {}
-func (*Account_Permission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{20, 5}
+func (m *GetWitnessScheduleRequest) Reset()         { *m = GetWitnessScheduleRequest{} }
+func (m *GetWitnessScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*GetWitnessScheduleRequest) ProtoMessage()    {}
+func (*GetWitnessScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{30}
 }
 
-func (m *Account_Permission) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Account_Permission.Unmarshal(m, b)
+func (m *GetWitnessScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetWitnessScheduleRequest.Unmarshal(m, b)
 }
-func (m *Account_Permission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Account_Permission.Marshal(b, m, deterministic)
+func (m *GetWitnessScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetWitnessScheduleRequest.Marshal(b, m, deterministic)
 }
-func (m *Account_Permission) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Account_Permission.Merge(m, src)
+func (m *GetWitnessScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWitnessScheduleRequest.Merge(m, src)
 }
-func (m *Account_Permission) XXX_Size() int {
-	return xxx_messageInfo_Account_Permission.Size(m)
+func (m *GetWitnessScheduleRequest) XXX_Size() int {
+	return xxx_messageInfo_GetWitnessScheduleRequest.Size(m)
 }
-func (m *Account_Permission) XXX_DiscardUnknown() {
-	xxx_messageInfo_Account_Permission.DiscardUnknown(m)
+func (m *GetWitnessScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWitnessScheduleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Account_Permission proto.InternalMessageInfo
+var xxx_messageInfo_GetWitnessScheduleRequest proto.InternalMessageInfo
 
-func (m *Account_Permission) GetName() string {
+func (m *GetWitnessScheduleRequest) GetSlots() int64 {
 	if m != nil {
-		return m.Name
+		return m.Slots
 	}
-	return ""
+	return 0
 }
 
-func (m *Account_Permission) GetGroups() []string {
+// The message containing the witness schedule and the production statistics of the witnesses.
+type GetWitnessScheduleResponse struct {
+	// current slot number
+	CurrentSlot int64 `protobuf:"varint,1,opt,name=current_slot,json=currentSlot,proto3" json:"current_slot,omitempty"`
+	// slots in order of time, from the current one
+	Slots []*GetWitnessScheduleResponse_Slot `protobuf:"bytes,2,rep,name=slots,proto3" json:"slots,omitempty"`
+	// statistics of the current witnesses
+	Producers            []*GetWitnessScheduleResponse_ProducerStat `protobuf:"bytes,3,rep,name=producers,proto3" json:"producers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                   `json:"-"`
+	XXX_unrecognized     []byte                                     `json:"-"`
+	XXX_sizecache        int32                                      `json:"-"`
+}
+
+func (m *GetWitnessScheduleResponse) Reset()         { *m = GetWitnessScheduleResponse{} }
+func (m *GetWitnessScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*GetWitnessScheduleResponse) ProtoMessage()    {}
+func (*GetWitnessScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{31}
+}
+
+func (m *GetWitnessScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetWitnessScheduleResponse.Unmarshal(m, b)
+}
+func (m *GetWitnessScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetWitnessScheduleResponse.Marshal(b, m, deterministic)
+}
+func (m *GetWitnessScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWitnessScheduleResponse.Merge(m, src)
+}
+func (m *GetWitnessScheduleResponse) XXX_Size() int {
+	return xxx_messageInfo_GetWitnessScheduleResponse.Size(m)
+}
+func (m *GetWitnessScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWitnessScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetWitnessScheduleResponse proto.InternalMessageInfo
+
+func (m *GetWitnessScheduleResponse) GetCurrentSlot() int64 {
 	if m != nil {
-		return m.Groups
+		return m.CurrentSlot
 	}
-	return nil
+	return 0
 }
 
-func (m *Account_Permission) GetItems() []*Account_Item {
+func (m *GetWitnessScheduleResponse) GetSlots() []*GetWitnessScheduleResponse_Slot {
 	if m != nil {
-		return m.Items
+		return m.Slots
 	}
 	return nil
 }
 
-func (m *Account_Permission) GetThreshold() int64 {
+func (m *GetWitnessScheduleResponse) GetProducers() []*GetWitnessScheduleResponse_ProducerStat {
 	if m != nil {
-		return m.Threshold
+		return m.Producers
 	}
-	return 0
+	return nil
 }
 
-// The message defines the get account request.
-type GetAccountRequest struct {
-	// account name
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// get account by longest chain's head block or last irreversible block
-	ByLongestChain       bool     `protobuf:"varint,2,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+// The message defines a slot and the witness scheduled in it.
+type GetWitnessScheduleResponse_Slot struct {
+	// slot number
+	Slot int64 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	// witness producing blocks in the slot
+	Witness string `protobuf:"bytes,2,opt,name=witness,proto3" json:"witness,omitempty"`
+	// start time of the slot in nanoseconds
+	Time                 int64    `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetAccountRequest) Reset()         { *m = GetAccountRequest{} }
-func (m *GetAccountRequest) String() string { return proto.CompactTextString(m) }
-func (*GetAccountRequest) ProtoMessage()    {}
-func (*GetAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{21}
+func (m *GetWitnessScheduleResponse_Slot) Reset()         { *m = GetWitnessScheduleResponse_Slot{} }
+func (m *GetWitnessScheduleResponse_Slot) String() string { return proto.CompactTextString(m) }
+func (*GetWitnessScheduleResponse_Slot) ProtoMessage()    {}
+func (*GetWitnessScheduleResponse_Slot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{31, 0}
 }
 
-func (m *GetAccountRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetAccountRequest.Unmarshal(m, b)
+func (m *GetWitnessScheduleResponse_Slot) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetWitnessScheduleResponse_Slot.Unmarshal(m, b)
 }
-func (m *GetAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetAccountRequest.Marshal(b, m, deterministic)
+func (m *GetWitnessScheduleResponse_Slot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetWitnessScheduleResponse_Slot.Marshal(b, m, deterministic)
 }
-func (m *GetAccountRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetAccountRequest.Merge(m, src)
+func (m *GetWitnessScheduleResponse_Slot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWitnessScheduleResponse_Slot.Merge(m, src)
 }
-func (m *GetAccountRequest) XXX_Size() int {
-	return xxx_messageInfo_GetAccountRequest.Size(m)
+func (m *GetWitnessScheduleResponse_Slot) XXX_Size() int {
+	return xxx_messageInfo_GetWitnessScheduleResponse_Slot.Size(m)
 }
-func (m *GetAccountRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetAccountRequest.DiscardUnknown(m)
+func (m *GetWitnessScheduleResponse_Slot) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWitnessScheduleResponse_Slot.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetAccountRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetWitnessScheduleResponse_Slot proto.InternalMessageInfo
 
-func (m *GetAccountRequest) GetName() string {
+func (m *GetWitnessScheduleResponse_Slot) GetSlot() int64 {
 	if m != nil {
-		return m.Name
+		return m.Slot
 	}
-	return ""
+	return 0
 }
 
-func (m *GetAccountRequest) GetByLongestChain() bool {
+func (m *GetWitnessScheduleResponse_Slot) GetWitness() string {
 	if m != nil {
-		return m.ByLongestChain
+		return m.Witness
 	}
-	return false
+	return ""
 }
 
-// The message defines the contract struct.
-type Contract struct {
-	// contract id
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// contract code
-	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	// contract language
-	Language string `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
-	// contract version
-	Version string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
-	// contract abis
-	Abis                 []*Contract_ABI `protobuf:"bytes,5,rep,name=abis,proto3" json:"abis,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+func (m *GetWitnessScheduleResponse_Slot) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
 }
 
-func (m *Contract) Reset()         { *m = Contract{} }
-func (m *Contract) String() string { return proto.CompactTextString(m) }
-func (*Contract) ProtoMessage()    {}
-func (*Contract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{22}
+// The message defines the production statistics of a witness since the node started.
+type GetWitnessScheduleResponse_ProducerStat struct {
+	// witness
+	Witness string `protobuf:"bytes,1,opt,name=witness,proto3" json:"witness,omitempty"`
+	// number of blocks produced
+	Produced int64 `protobuf:"varint,2,opt,name=produced,proto3" json:"produced,omitempty"`
+	// number of slots missed
+	Missed int64 `protobuf:"varint,3,opt,name=missed,proto3" json:"missed,omitempty"`
+	// time of the last block produced in nanoseconds
+	LastProducedTime     int64    `protobuf:"varint,4,opt,name=last_produced_time,json=lastProducedTime,proto3" json:"last_produced_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Contract) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Contract.Unmarshal(m, b)
+func (m *GetWitnessScheduleResponse_ProducerStat) Reset() {
+	*m = GetWitnessScheduleResponse_ProducerStat{}
 }
-func (m *Contract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Contract.Marshal(b, m, deterministic)
+func (m *GetWitnessScheduleResponse_ProducerStat) String() string { return proto.CompactTextString(m) }
+func (*GetWitnessScheduleResponse_ProducerStat) ProtoMessage()    {}
+func (*GetWitnessScheduleResponse_ProducerStat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{31, 1}
 }
-func (m *Contract) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Contract.Merge(m, src)
+
+func (m *GetWitnessScheduleResponse_ProducerStat) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetWitnessScheduleResponse_ProducerStat.Unmarshal(m, b)
 }
-func (m *Contract) XXX_Size() int {
-	return xxx_messageInfo_Contract.Size(m)
+func (m *GetWitnessScheduleResponse_ProducerStat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetWitnessScheduleResponse_ProducerStat.Marshal(b, m, deterministic)
 }
-func (m *Contract) XXX_DiscardUnknown() {
-	xxx_messageInfo_Contract.DiscardUnknown(m)
+func (m *GetWitnessScheduleResponse_ProducerStat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWitnessScheduleResponse_ProducerStat.Merge(m, src)
 }
-
-var xxx_messageInfo_Contract proto.InternalMessageInfo
-
-func (m *Contract) GetId() string {
-	if m != nil {
-		return m.Id
-	}
-	return ""
+func (m *GetWitnessScheduleResponse_ProducerStat) XXX_Size() int {
+	return xxx_messageInfo_GetWitnessScheduleResponse_ProducerStat.Size(m)
+}
+func (m *GetWitnessScheduleResponse_ProducerStat) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWitnessScheduleResponse_ProducerStat.DiscardUnknown(m)
 }
 
-func (m *Contract) GetCode() string {
+var xxx_messageInfo_GetWitnessScheduleResponse_ProducerStat proto.InternalMessageInfo
+
+func (m *GetWitnessScheduleResponse_ProducerStat) GetWitness() string {
 	if m != nil {
-		return m.Code
+		return m.Witness
 	}
 	return ""
 }
 
-func (m *Contract) GetLanguage() string {
+func (m *GetWitnessScheduleResponse_ProducerStat) GetProduced() int64 {
 	if m != nil {
-		return m.Language
+		return m.Produced
 	}
-	return ""
+	return 0
 }
 
-func (m *Contract) GetVersion() string {
+func (m *GetWitnessScheduleResponse_ProducerStat) GetMissed() int64 {
 	if m != nil {
-		return m.Version
+		return m.Missed
 	}
-	return ""
+	return 0
 }
 
-func (m *Contract) GetAbis() []*Contract_ABI {
+func (m *GetWitnessScheduleResponse_ProducerStat) GetLastProducedTime() int64 {
 	if m != nil {
-		return m.Abis
+		return m.LastProducedTime
 	}
-	return nil
+	return 0
 }
 
-// The message defines the ABI struct.
-type Contract_ABI struct {
-	// abi name
+// The message defines account struct.
+type Account struct {
+	// account name
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// abi arguments
-	Args []string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
-	// abi amount limt
-	AmountLimit          []*AmountLimit `protobuf:"bytes,3,rep,name=amount_limit,json=amountLimit,proto3" json:"amount_limit,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	// account balance
+	Balance float64 `protobuf:"fixed64,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	// gas information
+	GasInfo *Account_GasInfo `protobuf:"bytes,3,opt,name=gas_info,json=gasInfo,proto3" json:"gas_info,omitempty"`
+	// ram information
+	RamInfo *Account_RAMInfo `protobuf:"bytes,4,opt,name=ram_info,json=ramInfo,proto3" json:"ram_info,omitempty"`
+	// account permission
+	Permissions map[string]*Account_Permission `protobuf:"bytes,5,rep,name=permissions,proto3" json:"permissions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// account groups
+	Groups map[string]*Account_Group `protobuf:"bytes,6,rep,name=groups,proto3" json:"groups,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// frozen balance information
+	FrozenBalances []*FrozenBalance `protobuf:"bytes,7,rep,name=frozen_balances,json=frozenBalances,proto3" json:"frozen_balances,omitempty"`
+	// sequence of the last sequenced transaction
+	Sequence             int64    `protobuf:"varint,8,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Contract_ABI) Reset()         { *m = Contract_ABI{} }
-func (m *Contract_ABI) String() string { return proto.CompactTextString(m) }
-func (*Contract_ABI) ProtoMessage()    {}
-func (*Contract_ABI) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{22, 0}
+func (m *Account) Reset()         { *m = Account{} }
+func (m *Account) String() string { return proto.CompactTextString(m) }
+func (*Account) ProtoMessage()    {}
+func (*Account) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{32}
 }
 
-func (m *Contract_ABI) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Contract_ABI.Unmarshal(m, b)
+func (m *Account) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Account.Unmarshal(m, b)
 }
-func (m *Contract_ABI) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Contract_ABI.Marshal(b, m, deterministic)
+func (m *Account) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Account.Marshal(b, m, deterministic)
 }
-func (m *Contract_ABI) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Contract_ABI.Merge(m, src)
+func (m *Account) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account.Merge(m, src)
 }
-func (m *Contract_ABI) XXX_Size() int {
-	return xxx_messageInfo_Contract_ABI.Size(m)
+func (m *Account) XXX_Size() int {
+	return xxx_messageInfo_Account.Size(m)
 }
-func (m *Contract_ABI) XXX_DiscardUnknown() {
-	xxx_messageInfo_Contract_ABI.DiscardUnknown(m)
+func (m *Account) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Contract_ABI proto.InternalMessageInfo
+var xxx_messageInfo_Account proto.InternalMessageInfo
 
-func (m *Contract_ABI) GetName() string {
+func (m *Account) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *Contract_ABI) GetArgs() []string {
+func (m *Account) GetBalance() float64 {
 	if m != nil {
-		return m.Args
+		return m.Balance
 	}
-	return nil
+	return 0
 }
 
-func (m *Contract_ABI) GetAmountLimit() []*AmountLimit {
+func (m *Account) GetGasInfo() *Account_GasInfo {
 	if m != nil {
-		return m.AmountLimit
+		return m.GasInfo
 	}
 	return nil
 }
 
-// The message defines get contract request.
-type GetContractRequest struct {
-	// contract id
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// get data by longest chain's head block or last irreversible block
-	ByLongestChain       bool     `protobuf:"varint,2,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *Account) GetRamInfo() *Account_RAMInfo {
+	if m != nil {
+		return m.RamInfo
+	}
+	return nil
 }
 
-func (m *GetContractRequest) Reset()         { *m = GetContractRequest{} }
-func (m *GetContractRequest) String() string { return proto.CompactTextString(m) }
-func (*GetContractRequest) ProtoMessage()    {}
-func (*GetContractRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{23}
+func (m *Account) GetPermissions() map[string]*Account_Permission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
 }
 
-func (m *GetContractRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetContractRequest.Unmarshal(m, b)
-}
-func (m *GetContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetContractRequest.Marshal(b, m, deterministic)
-}
-func (m *GetContractRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetContractRequest.Merge(m, src)
-}
-func (m *GetContractRequest) XXX_Size() int {
-	return xxx_messageInfo_GetContractRequest.Size(m)
-}
-func (m *GetContractRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetContractRequest.DiscardUnknown(m)
+func (m *Account) GetGroups() map[string]*Account_Group {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
 }
 
-var xxx_messageInfo_GetContractRequest proto.InternalMessageInfo
-
-func (m *GetContractRequest) GetId() string {
+func (m *Account) GetFrozenBalances() []*FrozenBalance {
 	if m != nil {
-		return m.Id
+		return m.FrozenBalances
 	}
-	return ""
+	return nil
 }
 
-func (m *GetContractRequest) GetByLongestChain() bool {
+func (m *Account) GetSequence() int64 {
 	if m != nil {
-		return m.ByLongestChain
+		return m.Sequence
 	}
-	return false
+	return 0
 }
 
-// The message defines get contract storage request.
-type GetContractStorageRequest struct {
-	// contract id
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// the key in the StateDB
-	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	// get the value from StateDB, field is needed if StateDB[key] is a map.(we get StateDB[key][field] in this case)
-	Field string `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
-	// get data by longest chain's head block or last irreversible block
-	ByLongestChain       bool     `protobuf:"varint,4,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+// The message defines account pledged coin information.
+type Account_PledgeInfo struct {
+	// the account who pledges
+	Pledger string `protobuf:"bytes,1,opt,name=pledger,proto3" json:"pledger,omitempty"`
+	// pledged amount
+	Amount               float64  `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetContractStorageRequest) Reset()         { *m = GetContractStorageRequest{} }
-func (m *GetContractStorageRequest) String() string { return proto.CompactTextString(m) }
-func (*GetContractStorageRequest) ProtoMessage()    {}
-func (*GetContractStorageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{24}
+func (m *Account_PledgeInfo) Reset()         { *m = Account_PledgeInfo{} }
+func (m *Account_PledgeInfo) String() string { return proto.CompactTextString(m) }
+func (*Account_PledgeInfo) ProtoMessage()    {}
+func (*Account_PledgeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{32, 0}
 }
 
-func (m *GetContractStorageRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetContractStorageRequest.Unmarshal(m, b)
-}
-func (m *GetContractStorageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetContractStorageRequest.Marshal(b, m, deterministic)
+func (m *Account_PledgeInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Account_PledgeInfo.Unmarshal(m, b)
 }
-func (m *GetContractStorageRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetContractStorageRequest.Merge(m, src)
+func (m *Account_PledgeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Account_PledgeInfo.Marshal(b, m, deterministic)
 }
-func (m *GetContractStorageRequest) XXX_Size() int {
-	return xxx_messageInfo_GetContractStorageRequest.Size(m)
+func (m *Account_PledgeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account_PledgeInfo.Merge(m, src)
 }
-func (m *GetContractStorageRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetContractStorageRequest.DiscardUnknown(m)
+func (m *Account_PledgeInfo) XXX_Size() int {
+	return xxx_messageInfo_Account_PledgeInfo.Size(m)
 }
-
-var xxx_messageInfo_GetContractStorageRequest proto.InternalMessageInfo
-
-func (m *GetContractStorageRequest) GetId() string {
-	if m != nil {
-		return m.Id
-	}
-	return ""
+func (m *Account_PledgeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account_PledgeInfo.DiscardUnknown(m)
 }
 
-func (m *GetContractStorageRequest) GetKey() string {
-	if m != nil {
-		return m.Key
-	}
-	return ""
-}
+var xxx_messageInfo_Account_PledgeInfo proto.InternalMessageInfo
 
-func (m *GetContractStorageRequest) GetField() string {
+func (m *Account_PledgeInfo) GetPledger() string {
 	if m != nil {
-		return m.Field
+		return m.Pledger
 	}
 	return ""
 }
 
-func (m *GetContractStorageRequest) GetByLongestChain() bool {
+func (m *Account_PledgeInfo) GetAmount() float64 {
 	if m != nil {
-		return m.ByLongestChain
+		return m.Amount
 	}
-	return false
+	return 0
 }
 
-// The message defines get contract storage response.
+// The message defines account gas information.
+type Account_GasInfo struct {
+	// current total gas amount
+	CurrentTotal    float64 `protobuf:"fixed64,1,opt,name=current_total,json=currentTotal,proto3" json:"current_total,omitempty"`
+	TransferableGas float64 `protobuf:"fixed64,2,opt,name=transferable_gas,json=transferableGas,proto3" json:"transferable_gas,omitempty"`
+	PledgeGas       float64 `protobuf:"fixed64,3,opt,name=pledge_gas,json=pledgeGas,proto3" json:"pledge_gas,omitempty"`
+	// gas increase speed
+	IncreaseSpeed float64 `protobuf:"fixed64,4,opt,name=increase_speed,json=increaseSpeed,proto3" json:"increase_speed,omitempty"`
+	// gas limit
+	Limit float64 `protobuf:"fixed64,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	// pledge information
+	PledgedInfo          []*Account_PledgeInfo `protobuf:"bytes,6,rep,name=pledged_info,json=pledgedInfo,proto3" json:"pledged_info,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *Account_GasInfo) Reset()         { *m = Account_GasInfo{} }
+func (m *Account_GasInfo) String() string { return proto.CompactTextString(m) }
+func (*Account_GasInfo) ProtoMessage()    {}
+func (*Account_GasInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{32, 1}
+}
+
+func (m *Account_GasInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Account_GasInfo.Unmarshal(m, b)
+}
+func (m *Account_GasInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Account_GasInfo.Marshal(b, m, deterministic)
+}
+func (m *Account_GasInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account_GasInfo.Merge(m, src)
+}
+func (m *Account_GasInfo) XXX_Size() int {
+	return xxx_messageInfo_Account_GasInfo.Size(m)
+}
+func (m *Account_GasInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account_GasInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Account_GasInfo proto.InternalMessageInfo
+
+func (m *Account_GasInfo) GetCurrentTotal() float64 {
+	if m != nil {
+		return m.CurrentTotal
+	}
+	return 0
+}
+
+func (m *Account_GasInfo) GetTransferableGas() float64 {
+	if m != nil {
+		return m.TransferableGas
+	}
+	return 0
+}
+
+func (m *Account_GasInfo) GetPledgeGas() float64 {
+	if m != nil {
+		return m.PledgeGas
+	}
+	return 0
+}
+
+func (m *Account_GasInfo) GetIncreaseSpeed() float64 {
+	if m != nil {
+		return m.IncreaseSpeed
+	}
+	return 0
+}
+
+func (m *Account_GasInfo) GetLimit() float64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *Account_GasInfo) GetPledgedInfo() []*Account_PledgeInfo {
+	if m != nil {
+		return m.PledgedInfo
+	}
+	return nil
+}
+
+// The message defines account ram information.
+type Account_RAMInfo struct {
+	// available ram bytes
+	Available            int64    `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Account_RAMInfo) Reset()         { *m = Account_RAMInfo{} }
+func (m *Account_RAMInfo) String() string { return proto.CompactTextString(m) }
+func (*Account_RAMInfo) ProtoMessage()    {}
+func (*Account_RAMInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{32, 2}
+}
+
+func (m *Account_RAMInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Account_RAMInfo.Unmarshal(m, b)
+}
+func (m *Account_RAMInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Account_RAMInfo.Marshal(b, m, deterministic)
+}
+func (m *Account_RAMInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account_RAMInfo.Merge(m, src)
+}
+func (m *Account_RAMInfo) XXX_Size() int {
+	return xxx_messageInfo_Account_RAMInfo.Size(m)
+}
+func (m *Account_RAMInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account_RAMInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Account_RAMInfo proto.InternalMessageInfo
+
+func (m *Account_RAMInfo) GetAvailable() int64 {
+	if m != nil {
+		return m.Available
+	}
+	return 0
+}
+
+// The message defines permission item.
+type Account_Item struct {
+	// permission name or key pair id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// whether it's a key pair
+	IsKeyPair bool `protobuf:"varint,2,opt,name=is_key_pair,json=isKeyPair,proto3" json:"is_key_pair,omitempty"`
+	// permission weight
+	Weight int64 `protobuf:"varint,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	// permission
+	Permission           string   `protobuf:"bytes,4,opt,name=permission,proto3" json:"permission,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Account_Item) Reset()         { *m = Account_Item{} }
+func (m *Account_Item) String() string { return proto.CompactTextString(m) }
+func (*Account_Item) ProtoMessage()    {}
+func (*Account_Item) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{32, 3}
+}
+
+func (m *Account_Item) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Account_Item.Unmarshal(m, b)
+}
+func (m *Account_Item) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Account_Item.Marshal(b, m, deterministic)
+}
+func (m *Account_Item) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account_Item.Merge(m, src)
+}
+func (m *Account_Item) XXX_Size() int {
+	return xxx_messageInfo_Account_Item.Size(m)
+}
+func (m *Account_Item) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account_Item.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Account_Item proto.InternalMessageInfo
+
+func (m *Account_Item) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Account_Item) GetIsKeyPair() bool {
+	if m != nil {
+		return m.IsKeyPair
+	}
+	return false
+}
+
+func (m *Account_Item) GetWeight() int64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *Account_Item) GetPermission() string {
+	if m != nil {
+		return m.Permission
+	}
+	return ""
+}
+
+// The message defines a permission group.
+type Account_Group struct {
+	// group name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// permission items
+	Items                []*Account_Item `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Account_Group) Reset()         { *m = Account_Group{} }
+func (m *Account_Group) String() string { return proto.CompactTextString(m) }
+func (*Account_Group) ProtoMessage()    {}
+func (*Account_Group) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{32, 4}
+}
+
+func (m *Account_Group) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Account_Group.Unmarshal(m, b)
+}
+func (m *Account_Group) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Account_Group.Marshal(b, m, deterministic)
+}
+func (m *Account_Group) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account_Group.Merge(m, src)
+}
+func (m *Account_Group) XXX_Size() int {
+	return xxx_messageInfo_Account_Group.Size(m)
+}
+func (m *Account_Group) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account_Group.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Account_Group proto.InternalMessageInfo
+
+func (m *Account_Group) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Account_Group) GetItems() []*Account_Item {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+// The message defines the permission struct.
+type Account_Permission struct {
+	// permission name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// permission groups
+	Groups []string `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+	// permission items
+	Items []*Account_Item `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	// permission threshold
+	Threshold            int64    `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Account_Permission) Reset()         { *m = Account_Permission{} }
+func (m *Account_Permission) String() string { return proto.CompactTextString(m) }
+func (*Account_Permission) ProtoMessage()    {}
+func (*Account_Permission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{32, 5}
+}
+
+func (m *Account_Permission) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Account_Permission.Unmarshal(m, b)
+}
+func (m *Account_Permission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Account_Permission.Marshal(b, m, deterministic)
+}
+func (m *Account_Permission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account_Permission.Merge(m, src)
+}
+func (m *Account_Permission) XXX_Size() int {
+	return xxx_messageInfo_Account_Permission.Size(m)
+}
+func (m *Account_Permission) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account_Permission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Account_Permission proto.InternalMessageInfo
+
+func (m *Account_Permission) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Account_Permission) GetGroups() []string {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+func (m *Account_Permission) GetItems() []*Account_Item {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *Account_Permission) GetThreshold() int64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+// The message defines the get account request.
+type GetAccountRequest struct {
+	// account name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// get account by longest chain's head block or last irreversible block
+	ByLongestChain bool `protobuf:"varint,2,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	// get account at the block of the number, 0 means not specified. it overrides by_longest_chain
+	BlockNumber int64 `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// get account at the block of the base58 encoded hash. it overrides block_number and by_longest_chain
+	BlockHash            string   `protobuf:"bytes,4,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAccountRequest) Reset()         { *m = GetAccountRequest{} }
+func (m *GetAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAccountRequest) ProtoMessage()    {}
+func (*GetAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{33}
+}
+
+func (m *GetAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAccountRequest.Unmarshal(m, b)
+}
+func (m *GetAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAccountRequest.Marshal(b, m, deterministic)
+}
+func (m *GetAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountRequest.Merge(m, src)
+}
+func (m *GetAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAccountRequest.Size(m)
+}
+func (m *GetAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAccountRequest proto.InternalMessageInfo
+
+func (m *GetAccountRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GetAccountRequest) GetByLongestChain() bool {
+	if m != nil {
+		return m.ByLongestChain
+	}
+	return false
+}
+
+func (m *GetAccountRequest) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *GetAccountRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+// The message defines the contract struct.
+type Contract struct {
+	// contract id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// contract code
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// contract language
+	Language string `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	// contract version
+	Version string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	// contract abis
+	Abis                 []*Contract_ABI `protobuf:"bytes,5,rep,name=abis,proto3" json:"abis,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *Contract) Reset()         { *m = Contract{} }
+func (m *Contract) String() string { return proto.CompactTextString(m) }
+func (*Contract) ProtoMessage()    {}
+func (*Contract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{34}
+}
+
+func (m *Contract) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Contract.Unmarshal(m, b)
+}
+func (m *Contract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Contract.Marshal(b, m, deterministic)
+}
+func (m *Contract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Contract.Merge(m, src)
+}
+func (m *Contract) XXX_Size() int {
+	return xxx_messageInfo_Contract.Size(m)
+}
+func (m *Contract) XXX_DiscardUnknown() {
+	xxx_messageInfo_Contract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Contract proto.InternalMessageInfo
+
+func (m *Contract) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Contract) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *Contract) GetLanguage() string {
+	if m != nil {
+		return m.Language
+	}
+	return ""
+}
+
+func (m *Contract) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *Contract) GetAbis() []*Contract_ABI {
+	if m != nil {
+		return m.Abis
+	}
+	return nil
+}
+
+// The message defines the ABI struct.
+type Contract_ABI struct {
+	// abi name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// abi arguments
+	Args []string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+	// abi amount limt
+	AmountLimit          []*AmountLimit `protobuf:"bytes,3,rep,name=amount_limit,json=amountLimit,proto3" json:"amount_limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *Contract_ABI) Reset()         { *m = Contract_ABI{} }
+func (m *Contract_ABI) String() string { return proto.CompactTextString(m) }
+func (*Contract_ABI) ProtoMessage()    {}
+func (*Contract_ABI) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{34, 0}
+}
+
+func (m *Contract_ABI) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Contract_ABI.Unmarshal(m, b)
+}
+func (m *Contract_ABI) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Contract_ABI.Marshal(b, m, deterministic)
+}
+func (m *Contract_ABI) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Contract_ABI.Merge(m, src)
+}
+func (m *Contract_ABI) XXX_Size() int {
+	return xxx_messageInfo_Contract_ABI.Size(m)
+}
+func (m *Contract_ABI) XXX_DiscardUnknown() {
+	xxx_messageInfo_Contract_ABI.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Contract_ABI proto.InternalMessageInfo
+
+func (m *Contract_ABI) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Contract_ABI) GetArgs() []string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *Contract_ABI) GetAmountLimit() []*AmountLimit {
+	if m != nil {
+		return m.AmountLimit
+	}
+	return nil
+}
+
+// The message defines get contract request.
+type GetContractRequest struct {
+	// contract id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// get data by longest chain's head block or last irreversible block
+	ByLongestChain       bool     `protobuf:"varint,2,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetContractRequest) Reset()         { *m = GetContractRequest{} }
+func (m *GetContractRequest) String() string { return proto.CompactTextString(m) }
+func (*GetContractRequest) ProtoMessage()    {}
+func (*GetContractRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{35}
+}
+
+func (m *GetContractRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetContractRequest.Unmarshal(m, b)
+}
+func (m *GetContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetContractRequest.Marshal(b, m, deterministic)
+}
+func (m *GetContractRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetContractRequest.Merge(m, src)
+}
+func (m *GetContractRequest) XXX_Size() int {
+	return xxx_messageInfo_GetContractRequest.Size(m)
+}
+func (m *GetContractRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetContractRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetContractRequest proto.InternalMessageInfo
+
+func (m *GetContractRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *GetContractRequest) GetByLongestChain() bool {
+	if m != nil {
+		return m.ByLongestChain
+	}
+	return false
+}
+
+// The message defines get contract storage request.
+type GetContractStorageRequest struct {
+	// contract id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the key in the StateDB
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// get the value from StateDB, field is needed if StateDB[key] is a map.(we get StateDB[key][field] in this case)
+	Field string `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	// get data by longest chain's head block or last irreversible block
+	ByLongestChain bool `protobuf:"varint,4,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	// get data at the block of the number, 0 means not specified. it overrides by_longest_chain
+	BlockNumber int64 `protobuf:"varint,5,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// get data at the block of the base58 encoded hash. it overrides block_number and by_longest_chain
+	BlockHash            string   `protobuf:"bytes,6,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetContractStorageRequest) Reset()         { *m = GetContractStorageRequest{} }
+func (m *GetContractStorageRequest) String() string { return proto.CompactTextString(m) }
+func (*GetContractStorageRequest) ProtoMessage()    {}
+func (*GetContractStorageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{36}
+}
+
+func (m *GetContractStorageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetContractStorageRequest.Unmarshal(m, b)
+}
+func (m *GetContractStorageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetContractStorageRequest.Marshal(b, m, deterministic)
+}
+func (m *GetContractStorageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetContractStorageRequest.Merge(m, src)
+}
+func (m *GetContractStorageRequest) XXX_Size() int {
+	return xxx_messageInfo_GetContractStorageRequest.Size(m)
+}
+func (m *GetContractStorageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetContractStorageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetContractStorageRequest proto.InternalMessageInfo
+
+func (m *GetContractStorageRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *GetContractStorageRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *GetContractStorageRequest) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *GetContractStorageRequest) GetByLongestChain() bool {
+	if m != nil {
+		return m.ByLongestChain
+	}
+	return false
+}
+
+func (m *GetContractStorageRequest) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *GetContractStorageRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+// The message defines get contract storage response.
 type GetContractStorageResponse struct {
 	// the json string data
 	Data                 string   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
@@ -2470,564 +3693,1128 @@ type GetContractStorageResponse struct {
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetContractStorageResponse) Reset()         { *m = GetContractStorageResponse{} }
-func (m *GetContractStorageResponse) String() string { return proto.CompactTextString(m) }
-func (*GetContractStorageResponse) ProtoMessage()    {}
-func (*GetContractStorageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{25}
+func (m *GetContractStorageResponse) Reset()         { *m = GetContractStorageResponse{} }
+func (m *GetContractStorageResponse) String() string { return proto.CompactTextString(m) }
+func (*GetContractStorageResponse) ProtoMessage()    {}
+func (*GetContractStorageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{37}
+}
+
+func (m *GetContractStorageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetContractStorageResponse.Unmarshal(m, b)
+}
+func (m *GetContractStorageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetContractStorageResponse.Marshal(b, m, deterministic)
+}
+func (m *GetContractStorageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetContractStorageResponse.Merge(m, src)
+}
+func (m *GetContractStorageResponse) XXX_Size() int {
+	return xxx_messageInfo_GetContractStorageResponse.Size(m)
+}
+func (m *GetContractStorageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetContractStorageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetContractStorageResponse proto.InternalMessageInfo
+
+func (m *GetContractStorageResponse) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+// The message defines state proof response.
+type StateProofResponse struct {
+	// the key in the state table
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// the raw value in the state table
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// whether the key exists
+	Exist bool `protobuf:"varint,3,opt,name=exist,proto3" json:"exist,omitempty"`
+	// sibling hashes from the state root down to the terminal node of the key's path
+	Siblings []string `protobuf:"bytes,4,rep,name=siblings,proto3" json:"siblings,omitempty"`
+	// key hash of the terminal leaf, empty if the path ends in an empty node
+	LeafKey string `protobuf:"bytes,5,opt,name=leaf_key,json=leafKey,proto3" json:"leaf_key,omitempty"`
+	// value hash of the terminal leaf, empty if the path ends in an empty node
+	LeafValue string `protobuf:"bytes,6,opt,name=leaf_value,json=leafValue,proto3" json:"leaf_value,omitempty"`
+	// block whose state root the proof is against
+	Block                *Block   `protobuf:"bytes,7,opt,name=block,proto3" json:"block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StateProofResponse) Reset()         { *m = StateProofResponse{} }
+func (m *StateProofResponse) String() string { return proto.CompactTextString(m) }
+func (*StateProofResponse) ProtoMessage()    {}
+func (*StateProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{38}
+}
+
+func (m *StateProofResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateProofResponse.Unmarshal(m, b)
+}
+func (m *StateProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateProofResponse.Marshal(b, m, deterministic)
+}
+func (m *StateProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateProofResponse.Merge(m, src)
+}
+func (m *StateProofResponse) XXX_Size() int {
+	return xxx_messageInfo_StateProofResponse.Size(m)
+}
+func (m *StateProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateProofResponse proto.InternalMessageInfo
+
+func (m *StateProofResponse) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *StateProofResponse) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *StateProofResponse) GetExist() bool {
+	if m != nil {
+		return m.Exist
+	}
+	return false
+}
+
+func (m *StateProofResponse) GetSiblings() []string {
+	if m != nil {
+		return m.Siblings
+	}
+	return nil
+}
+
+func (m *StateProofResponse) GetLeafKey() string {
+	if m != nil {
+		return m.LeafKey
+	}
+	return ""
+}
+
+func (m *StateProofResponse) GetLeafValue() string {
+	if m != nil {
+		return m.LeafValue
+	}
+	return ""
+}
+
+func (m *StateProofResponse) GetBlock() *Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+// The message defines send transaction response.
+type SendTransactionResponse struct {
+	// the final transaction hash
+	Hash                 string   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SendTransactionResponse) Reset()         { *m = SendTransactionResponse{} }
+func (m *SendTransactionResponse) String() string { return proto.CompactTextString(m) }
+func (*SendTransactionResponse) ProtoMessage()    {}
+func (*SendTransactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{39}
+}
+
+func (m *SendTransactionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendTransactionResponse.Unmarshal(m, b)
+}
+func (m *SendTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendTransactionResponse.Marshal(b, m, deterministic)
+}
+func (m *SendTransactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendTransactionResponse.Merge(m, src)
+}
+func (m *SendTransactionResponse) XXX_Size() int {
+	return xxx_messageInfo_SendTransactionResponse.Size(m)
+}
+func (m *SendTransactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendTransactionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendTransactionResponse proto.InternalMessageInfo
+
+func (m *SendTransactionResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+// The message defines get token balance response.
+type GetTokenBalanceResponse struct {
+	// token balance
+	Balance float64 `protobuf:"fixed64,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	// frozen balance information
+	FrozenBalances       []*FrozenBalance `protobuf:"bytes,2,rep,name=frozen_balances,json=frozenBalances,proto3" json:"frozen_balances,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetTokenBalanceResponse) Reset()         { *m = GetTokenBalanceResponse{} }
+func (m *GetTokenBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTokenBalanceResponse) ProtoMessage()    {}
+func (*GetTokenBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{40}
+}
+
+func (m *GetTokenBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTokenBalanceResponse.Unmarshal(m, b)
+}
+func (m *GetTokenBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTokenBalanceResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTokenBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTokenBalanceResponse.Merge(m, src)
+}
+func (m *GetTokenBalanceResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTokenBalanceResponse.Size(m)
+}
+func (m *GetTokenBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTokenBalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTokenBalanceResponse proto.InternalMessageInfo
+
+func (m *GetTokenBalanceResponse) GetBalance() float64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+func (m *GetTokenBalanceResponse) GetFrozenBalances() []*FrozenBalance {
+	if m != nil {
+		return m.FrozenBalances
+	}
+	return nil
+}
+
+// The message defines get token balance request.
+type GetTokenBalanceRequest struct {
+	// account name
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// the token name
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// get data by longest chain's head block or last irreversible block
+	ByLongestChain bool `protobuf:"varint,3,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	// get data at the block of the number, 0 means not specified. it overrides by_longest_chain
+	BlockNumber int64 `protobuf:"varint,4,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// get data at the block of the base58 encoded hash. it overrides block_number and by_longest_chain
+	BlockHash            string   `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTokenBalanceRequest) Reset()         { *m = GetTokenBalanceRequest{} }
+func (m *GetTokenBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTokenBalanceRequest) ProtoMessage()    {}
+func (*GetTokenBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{41}
+}
+
+func (m *GetTokenBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTokenBalanceRequest.Unmarshal(m, b)
+}
+func (m *GetTokenBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTokenBalanceRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTokenBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTokenBalanceRequest.Merge(m, src)
+}
+func (m *GetTokenBalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTokenBalanceRequest.Size(m)
+}
+func (m *GetTokenBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTokenBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTokenBalanceRequest proto.InternalMessageInfo
+
+func (m *GetTokenBalanceRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *GetTokenBalanceRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *GetTokenBalanceRequest) GetByLongestChain() bool {
+	if m != nil {
+		return m.ByLongestChain
+	}
+	return false
+}
+
+func (m *GetTokenBalanceRequest) GetBlockNumber() int64 {
+	if m != nil {
+		return m.BlockNumber
+	}
+	return 0
+}
+
+func (m *GetTokenBalanceRequest) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+// The message defines get token721 balance response.
+type GetToken721BalanceResponse struct {
+	// token balance
+	Balance int64 `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	// balance information
+	TokenIDs             []string `protobuf:"bytes,2,rep,name=tokenIDs,proto3" json:"tokenIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetToken721BalanceResponse) Reset()         { *m = GetToken721BalanceResponse{} }
+func (m *GetToken721BalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*GetToken721BalanceResponse) ProtoMessage()    {}
+func (*GetToken721BalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{42}
+}
+
+func (m *GetToken721BalanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetToken721BalanceResponse.Unmarshal(m, b)
+}
+func (m *GetToken721BalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetToken721BalanceResponse.Marshal(b, m, deterministic)
+}
+func (m *GetToken721BalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetToken721BalanceResponse.Merge(m, src)
+}
+func (m *GetToken721BalanceResponse) XXX_Size() int {
+	return xxx_messageInfo_GetToken721BalanceResponse.Size(m)
+}
+func (m *GetToken721BalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetToken721BalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetToken721BalanceResponse proto.InternalMessageInfo
+
+func (m *GetToken721BalanceResponse) GetBalance() int64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+func (m *GetToken721BalanceResponse) GetTokenIDs() []string {
+	if m != nil {
+		return m.TokenIDs
+	}
+	return nil
+}
+
+// The message defines get token721 info request.
+type GetToken721InfoRequest struct {
+	// the token name
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// token id
+	TokenId string `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
+	// get data by longest chain's head block or last irreversible block
+	ByLongestChain       bool     `protobuf:"varint,3,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetToken721InfoRequest) Reset()         { *m = GetToken721InfoRequest{} }
+func (m *GetToken721InfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetToken721InfoRequest) ProtoMessage()    {}
+func (*GetToken721InfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{43}
+}
+
+func (m *GetToken721InfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetToken721InfoRequest.Unmarshal(m, b)
+}
+func (m *GetToken721InfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetToken721InfoRequest.Marshal(b, m, deterministic)
+}
+func (m *GetToken721InfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetToken721InfoRequest.Merge(m, src)
+}
+func (m *GetToken721InfoRequest) XXX_Size() int {
+	return xxx_messageInfo_GetToken721InfoRequest.Size(m)
+}
+func (m *GetToken721InfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetToken721InfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetToken721InfoRequest proto.InternalMessageInfo
+
+func (m *GetToken721InfoRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *GetToken721InfoRequest) GetTokenId() string {
+	if m != nil {
+		return m.TokenId
+	}
+	return ""
+}
+
+func (m *GetToken721InfoRequest) GetByLongestChain() bool {
+	if m != nil {
+		return m.ByLongestChain
+	}
+	return false
+}
+
+// The message defines get token721 metadata response.
+type GetToken721MetadataResponse struct {
+	// token metadata
+	Metadata             string   `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetToken721MetadataResponse) Reset()         { *m = GetToken721MetadataResponse{} }
+func (m *GetToken721MetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*GetToken721MetadataResponse) ProtoMessage()    {}
+func (*GetToken721MetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{44}
 }
 
-func (m *GetContractStorageResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetContractStorageResponse.Unmarshal(m, b)
+func (m *GetToken721MetadataResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetToken721MetadataResponse.Unmarshal(m, b)
 }
-func (m *GetContractStorageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetContractStorageResponse.Marshal(b, m, deterministic)
+func (m *GetToken721MetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetToken721MetadataResponse.Marshal(b, m, deterministic)
 }
-func (m *GetContractStorageResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetContractStorageResponse.Merge(m, src)
+func (m *GetToken721MetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetToken721MetadataResponse.Merge(m, src)
 }
-func (m *GetContractStorageResponse) XXX_Size() int {
-	return xxx_messageInfo_GetContractStorageResponse.Size(m)
+func (m *GetToken721MetadataResponse) XXX_Size() int {
+	return xxx_messageInfo_GetToken721MetadataResponse.Size(m)
 }
-func (m *GetContractStorageResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetContractStorageResponse.DiscardUnknown(m)
+func (m *GetToken721MetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetToken721MetadataResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetContractStorageResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetToken721MetadataResponse proto.InternalMessageInfo
 
-func (m *GetContractStorageResponse) GetData() string {
+func (m *GetToken721MetadataResponse) GetMetadata() string {
 	if m != nil {
-		return m.Data
+		return m.Metadata
 	}
 	return ""
 }
 
-// The message defines send transaction response.
-type SendTransactionResponse struct {
-	// the final transaction hash
-	Hash                 string   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+// The message defines get token721 owner response.
+type GetToken721OwnerResponse struct {
+	// token owner
+	Owner                string   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SendTransactionResponse) Reset()         { *m = SendTransactionResponse{} }
-func (m *SendTransactionResponse) String() string { return proto.CompactTextString(m) }
-func (*SendTransactionResponse) ProtoMessage()    {}
-func (*SendTransactionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{26}
+func (m *GetToken721OwnerResponse) Reset()         { *m = GetToken721OwnerResponse{} }
+func (m *GetToken721OwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*GetToken721OwnerResponse) ProtoMessage()    {}
+func (*GetToken721OwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{45}
 }
 
-func (m *SendTransactionResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SendTransactionResponse.Unmarshal(m, b)
+func (m *GetToken721OwnerResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetToken721OwnerResponse.Unmarshal(m, b)
 }
-func (m *SendTransactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SendTransactionResponse.Marshal(b, m, deterministic)
+func (m *GetToken721OwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetToken721OwnerResponse.Marshal(b, m, deterministic)
 }
-func (m *SendTransactionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SendTransactionResponse.Merge(m, src)
+func (m *GetToken721OwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetToken721OwnerResponse.Merge(m, src)
 }
-func (m *SendTransactionResponse) XXX_Size() int {
-	return xxx_messageInfo_SendTransactionResponse.Size(m)
+func (m *GetToken721OwnerResponse) XXX_Size() int {
+	return xxx_messageInfo_GetToken721OwnerResponse.Size(m)
 }
-func (m *SendTransactionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SendTransactionResponse.DiscardUnknown(m)
+func (m *GetToken721OwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetToken721OwnerResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SendTransactionResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetToken721OwnerResponse proto.InternalMessageInfo
 
-func (m *SendTransactionResponse) GetHash() string {
+func (m *GetToken721OwnerResponse) GetOwner() string {
 	if m != nil {
-		return m.Hash
+		return m.Owner
 	}
 	return ""
 }
 
-// The message defines get token balance response.
-type GetTokenBalanceResponse struct {
-	// token balance
-	Balance float64 `protobuf:"fixed64,1,opt,name=balance,proto3" json:"balance,omitempty"`
-	// frozen balance information
-	FrozenBalances       []*FrozenBalance `protobuf:"bytes,2,rep,name=frozen_balances,json=frozenBalances,proto3" json:"frozen_balances,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+// The message defines event struct.
+type Event struct {
+	// event topic
+	Topic Event_Topic `protobuf:"varint,1,opt,name=topic,proto3,enum=rpcpb.Event_Topic" json:"topic,omitempty"`
+	// event data
+	Data string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// event time
+	Time                 int64    `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetTokenBalanceResponse) Reset()         { *m = GetTokenBalanceResponse{} }
-func (m *GetTokenBalanceResponse) String() string { return proto.CompactTextString(m) }
-func (*GetTokenBalanceResponse) ProtoMessage()    {}
-func (*GetTokenBalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{27}
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{46}
 }
 
-func (m *GetTokenBalanceResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetTokenBalanceResponse.Unmarshal(m, b)
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Event.Unmarshal(m, b)
 }
-func (m *GetTokenBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetTokenBalanceResponse.Marshal(b, m, deterministic)
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Event.Marshal(b, m, deterministic)
 }
-func (m *GetTokenBalanceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTokenBalanceResponse.Merge(m, src)
+func (m *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(m, src)
 }
-func (m *GetTokenBalanceResponse) XXX_Size() int {
-	return xxx_messageInfo_GetTokenBalanceResponse.Size(m)
+func (m *Event) XXX_Size() int {
+	return xxx_messageInfo_Event.Size(m)
 }
-func (m *GetTokenBalanceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTokenBalanceResponse.DiscardUnknown(m)
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetTokenBalanceResponse proto.InternalMessageInfo
+var xxx_messageInfo_Event proto.InternalMessageInfo
 
-func (m *GetTokenBalanceResponse) GetBalance() float64 {
+func (m *Event) GetTopic() Event_Topic {
 	if m != nil {
-		return m.Balance
+		return m.Topic
 	}
-	return 0
+	return Event_CONTRACT_RECEIPT
 }
 
-func (m *GetTokenBalanceResponse) GetFrozenBalances() []*FrozenBalance {
+func (m *Event) GetData() string {
 	if m != nil {
-		return m.FrozenBalances
+		return m.Data
 	}
-	return nil
+	return ""
 }
 
-// The message defines get token balance request.
-type GetTokenBalanceRequest struct {
-	// account name
-	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	// the token name
-	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
-	// get data by longest chain's head block or last irreversible block
-	ByLongestChain       bool     `protobuf:"varint,3,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+func (m *Event) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+// The message defines subscribe request.
+type SubscribeRequest struct {
+	Topics []Event_Topic            `protobuf:"varint,1,rep,packed,name=topics,proto3,enum=rpcpb.Event_Topic" json:"topics,omitempty"`
+	Filter *SubscribeRequest_Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// replay the events of the blocks from this block number before the live events, no replay if 0.
+	// only CONTRACT_RECEIPT, NEW_BLOCK and IRREVERSIBLE_BLOCK can be replayed, the request with other topics is rejected.
+	// the number of blocks replayed back from the head is limited by rpc.maxreplayblocks of the node, 7200 by default
+	FromBlock            int64    `protobuf:"varint,3,opt,name=from_block,json=fromBlock,proto3" json:"from_block,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetTokenBalanceRequest) Reset()         { *m = GetTokenBalanceRequest{} }
-func (m *GetTokenBalanceRequest) String() string { return proto.CompactTextString(m) }
-func (*GetTokenBalanceRequest) ProtoMessage()    {}
-func (*GetTokenBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{28}
+func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
+func (m *SubscribeRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeRequest) ProtoMessage()    {}
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{47}
 }
 
-func (m *GetTokenBalanceRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetTokenBalanceRequest.Unmarshal(m, b)
+func (m *SubscribeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeRequest.Unmarshal(m, b)
 }
-func (m *GetTokenBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetTokenBalanceRequest.Marshal(b, m, deterministic)
+func (m *SubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeRequest.Marshal(b, m, deterministic)
 }
-func (m *GetTokenBalanceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTokenBalanceRequest.Merge(m, src)
+func (m *SubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeRequest.Merge(m, src)
 }
-func (m *GetTokenBalanceRequest) XXX_Size() int {
-	return xxx_messageInfo_GetTokenBalanceRequest.Size(m)
+func (m *SubscribeRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeRequest.Size(m)
 }
-func (m *GetTokenBalanceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTokenBalanceRequest.DiscardUnknown(m)
+func (m *SubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetTokenBalanceRequest proto.InternalMessageInfo
+var xxx_messageInfo_SubscribeRequest proto.InternalMessageInfo
+
+func (m *SubscribeRequest) GetTopics() []Event_Topic {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *SubscribeRequest) GetFilter() *SubscribeRequest_Filter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *SubscribeRequest) GetFromBlock() int64 {
+	if m != nil {
+		return m.FromBlock
+	}
+	return 0
+}
+
+type SubscribeRequest_Filter struct {
+	// contract id
+	ContractId string `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// action name
+	ActionName string `protobuf:"bytes,2,opt,name=action_name,json=actionName,proto3" json:"action_name,omitempty"`
+	// publisher of the transaction
+	Publisher string `protobuf:"bytes,3,opt,name=publisher,proto3" json:"publisher,omitempty"`
+	// status codes of the transaction, any status if empty
+	StatusCodes          []TxReceipt_StatusCode `protobuf:"varint,4,rep,packed,name=status_codes,json=statusCodes,proto3,enum=rpcpb.TxReceipt_StatusCode" json:"status_codes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *SubscribeRequest_Filter) Reset()         { *m = SubscribeRequest_Filter{} }
+func (m *SubscribeRequest_Filter) String() string { return proto.CompactTextString(m) }
+func (*SubscribeRequest_Filter) ProtoMessage()    {}
+func (*SubscribeRequest_Filter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{47, 0}
+}
+
+func (m *SubscribeRequest_Filter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeRequest_Filter.Unmarshal(m, b)
+}
+func (m *SubscribeRequest_Filter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeRequest_Filter.Marshal(b, m, deterministic)
+}
+func (m *SubscribeRequest_Filter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeRequest_Filter.Merge(m, src)
+}
+func (m *SubscribeRequest_Filter) XXX_Size() int {
+	return xxx_messageInfo_SubscribeRequest_Filter.Size(m)
+}
+func (m *SubscribeRequest_Filter) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeRequest_Filter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeRequest_Filter proto.InternalMessageInfo
+
+func (m *SubscribeRequest_Filter) GetContractId() string {
+	if m != nil {
+		return m.ContractId
+	}
+	return ""
+}
 
-func (m *GetTokenBalanceRequest) GetAccount() string {
+func (m *SubscribeRequest_Filter) GetActionName() string {
 	if m != nil {
-		return m.Account
+		return m.ActionName
 	}
 	return ""
 }
 
-func (m *GetTokenBalanceRequest) GetToken() string {
+func (m *SubscribeRequest_Filter) GetPublisher() string {
 	if m != nil {
-		return m.Token
+		return m.Publisher
 	}
 	return ""
 }
 
-func (m *GetTokenBalanceRequest) GetByLongestChain() bool {
+func (m *SubscribeRequest_Filter) GetStatusCodes() []TxReceipt_StatusCode {
 	if m != nil {
-		return m.ByLongestChain
+		return m.StatusCodes
 	}
-	return false
+	return nil
 }
 
-// The message defines get token721 balance response.
-type GetToken721BalanceResponse struct {
-	// token balance
-	Balance int64 `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"`
-	// balance information
-	TokenIDs             []string `protobuf:"bytes,2,rep,name=tokenIDs,proto3" json:"tokenIDs,omitempty"`
+// The message defines subscribe response.
+type SubscribeResponse struct {
+	Event *Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// number of events dropped before this one since the subscriber is too slow
+	Dropped              int64    `protobuf:"varint,2,opt,name=dropped,proto3" json:"dropped,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetToken721BalanceResponse) Reset()         { *m = GetToken721BalanceResponse{} }
-func (m *GetToken721BalanceResponse) String() string { return proto.CompactTextString(m) }
-func (*GetToken721BalanceResponse) ProtoMessage()    {}
-func (*GetToken721BalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{29}
+func (m *SubscribeResponse) Reset()         { *m = SubscribeResponse{} }
+func (m *SubscribeResponse) String() string { return proto.CompactTextString(m) }
+func (*SubscribeResponse) ProtoMessage()    {}
+func (*SubscribeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{48}
 }
 
-func (m *GetToken721BalanceResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetToken721BalanceResponse.Unmarshal(m, b)
+func (m *SubscribeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeResponse.Unmarshal(m, b)
 }
-func (m *GetToken721BalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetToken721BalanceResponse.Marshal(b, m, deterministic)
+func (m *SubscribeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeResponse.Marshal(b, m, deterministic)
 }
-func (m *GetToken721BalanceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetToken721BalanceResponse.Merge(m, src)
+func (m *SubscribeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeResponse.Merge(m, src)
 }
-func (m *GetToken721BalanceResponse) XXX_Size() int {
-	return xxx_messageInfo_GetToken721BalanceResponse.Size(m)
+func (m *SubscribeResponse) XXX_Size() int {
+	return xxx_messageInfo_SubscribeResponse.Size(m)
 }
-func (m *GetToken721BalanceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetToken721BalanceResponse.DiscardUnknown(m)
+func (m *SubscribeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetToken721BalanceResponse proto.InternalMessageInfo
+var xxx_messageInfo_SubscribeResponse proto.InternalMessageInfo
 
-func (m *GetToken721BalanceResponse) GetBalance() int64 {
+func (m *SubscribeResponse) GetEvent() *Event {
 	if m != nil {
-		return m.Balance
+		return m.Event
 	}
-	return 0
+	return nil
 }
 
-func (m *GetToken721BalanceResponse) GetTokenIDs() []string {
+func (m *SubscribeResponse) GetDropped() int64 {
 	if m != nil {
-		return m.TokenIDs
+		return m.Dropped
 	}
-	return nil
+	return 0
 }
 
-// The message defines get token721 info request.
-type GetToken721InfoRequest struct {
-	// the token name
-	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	// token id
-	TokenId string `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
-	// get data by longest chain's head block or last irreversible block
-	ByLongestChain       bool     `protobuf:"varint,3,opt,name=by_longest_chain,json=byLongestChain,proto3" json:"by_longest_chain,omitempty"`
+// The request message containing the filters and the page of pending transactions.
+type GetPendingTxsRequest struct {
+	// publisher of the transactions, any publisher if empty
+	Publisher string `protobuf:"bytes,1,opt,name=publisher,proto3" json:"publisher,omitempty"`
+	// contract called by the transactions, any contract if empty
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// min gas ratio of the transactions
+	MinGasRatio float64 `protobuf:"fixed64,3,opt,name=min_gas_ratio,json=minGasRatio,proto3" json:"min_gas_ratio,omitempty"`
+	// max gas ratio of the transactions, no limit if 0
+	MaxGasRatio float64 `protobuf:"fixed64,4,opt,name=max_gas_ratio,json=maxGasRatio,proto3" json:"max_gas_ratio,omitempty"`
+	// index of the first matched transaction
+	Offset int64 `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	// max count of transactions
+	Limit                int64    `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetToken721InfoRequest) Reset()         { *m = GetToken721InfoRequest{} }
-func (m *GetToken721InfoRequest) String() string { return proto.CompactTextString(m) }
-func (*GetToken721InfoRequest) ProtoMessage()    {}
-func (*GetToken721InfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{30}
+func (m *GetPendingTxsRequest) Reset()         { *m = GetPendingTxsRequest{} }
+func (m *GetPendingTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPendingTxsRequest) ProtoMessage()    {}
+func (*GetPendingTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{49}
 }
 
-func (m *GetToken721InfoRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetToken721InfoRequest.Unmarshal(m, b)
+func (m *GetPendingTxsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPendingTxsRequest.Unmarshal(m, b)
 }
-func (m *GetToken721InfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetToken721InfoRequest.Marshal(b, m, deterministic)
+func (m *GetPendingTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPendingTxsRequest.Marshal(b, m, deterministic)
 }
-func (m *GetToken721InfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetToken721InfoRequest.Merge(m, src)
+func (m *GetPendingTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPendingTxsRequest.Merge(m, src)
 }
-func (m *GetToken721InfoRequest) XXX_Size() int {
-	return xxx_messageInfo_GetToken721InfoRequest.Size(m)
+func (m *GetPendingTxsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPendingTxsRequest.Size(m)
 }
-func (m *GetToken721InfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetToken721InfoRequest.DiscardUnknown(m)
+func (m *GetPendingTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPendingTxsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetToken721InfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetPendingTxsRequest proto.InternalMessageInfo
 
-func (m *GetToken721InfoRequest) GetToken() string {
+func (m *GetPendingTxsRequest) GetPublisher() string {
 	if m != nil {
-		return m.Token
+		return m.Publisher
 	}
 	return ""
 }
 
-func (m *GetToken721InfoRequest) GetTokenId() string {
+func (m *GetPendingTxsRequest) GetContract() string {
 	if m != nil {
-		return m.TokenId
+		return m.Contract
 	}
 	return ""
 }
 
-func (m *GetToken721InfoRequest) GetByLongestChain() bool {
+func (m *GetPendingTxsRequest) GetMinGasRatio() float64 {
 	if m != nil {
-		return m.ByLongestChain
+		return m.MinGasRatio
 	}
-	return false
+	return 0
 }
 
-// The message defines get token721 metadata response.
-type GetToken721MetadataResponse struct {
-	// token metadata
-	Metadata             string   `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *GetPendingTxsRequest) GetMaxGasRatio() float64 {
+	if m != nil {
+		return m.MaxGasRatio
+	}
+	return 0
 }
 
-func (m *GetToken721MetadataResponse) Reset()         { *m = GetToken721MetadataResponse{} }
-func (m *GetToken721MetadataResponse) String() string { return proto.CompactTextString(m) }
-func (*GetToken721MetadataResponse) ProtoMessage()    {}
-func (*GetToken721MetadataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{31}
+func (m *GetPendingTxsRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
 }
 
-func (m *GetToken721MetadataResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetToken721MetadataResponse.Unmarshal(m, b)
+func (m *GetPendingTxsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
 }
-func (m *GetToken721MetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetToken721MetadataResponse.Marshal(b, m, deterministic)
+
+// The message containing a page of the pending transactions in order of gas ratio.
+type GetPendingTxsResponse struct {
+	// total count of matched transactions
+	Total int64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	// transactions of the page
+	Transactions         []*Transaction `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
-func (m *GetToken721MetadataResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetToken721MetadataResponse.Merge(m, src)
+
+func (m *GetPendingTxsResponse) Reset()         { *m = GetPendingTxsResponse{} }
+func (m *GetPendingTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPendingTxsResponse) ProtoMessage()    {}
+func (*GetPendingTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{50}
 }
-func (m *GetToken721MetadataResponse) XXX_Size() int {
-	return xxx_messageInfo_GetToken721MetadataResponse.Size(m)
+
+func (m *GetPendingTxsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPendingTxsResponse.Unmarshal(m, b)
 }
-func (m *GetToken721MetadataResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetToken721MetadataResponse.DiscardUnknown(m)
+func (m *GetPendingTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPendingTxsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPendingTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPendingTxsResponse.Merge(m, src)
+}
+func (m *GetPendingTxsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPendingTxsResponse.Size(m)
+}
+func (m *GetPendingTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPendingTxsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetToken721MetadataResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetPendingTxsResponse proto.InternalMessageInfo
 
-func (m *GetToken721MetadataResponse) GetMetadata() string {
+func (m *GetPendingTxsResponse) GetTotal() int64 {
 	if m != nil {
-		return m.Metadata
+		return m.Total
 	}
-	return ""
+	return 0
 }
 
-// The message defines get token721 owner response.
-type GetToken721OwnerResponse struct {
-	// token owner
-	Owner                string   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *GetPendingTxsResponse) GetTransactions() []*Transaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
 }
 
-func (m *GetToken721OwnerResponse) Reset()         { *m = GetToken721OwnerResponse{} }
-func (m *GetToken721OwnerResponse) String() string { return proto.CompactTextString(m) }
-func (*GetToken721OwnerResponse) ProtoMessage()    {}
-func (*GetToken721OwnerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{32}
+// The message containing the count of pending transactions of each publisher.
+type GetPendingTxCountsResponse struct {
+	// counts in descending order
+	Counts               []*GetPendingTxCountsResponse_PublisherCount `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
+	XXX_unrecognized     []byte                                       `json:"-"`
+	XXX_sizecache        int32                                        `json:"-"`
 }
 
-func (m *GetToken721OwnerResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetToken721OwnerResponse.Unmarshal(m, b)
+func (m *GetPendingTxCountsResponse) Reset()         { *m = GetPendingTxCountsResponse{} }
+func (m *GetPendingTxCountsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPendingTxCountsResponse) ProtoMessage()    {}
+func (*GetPendingTxCountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{51}
 }
-func (m *GetToken721OwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetToken721OwnerResponse.Marshal(b, m, deterministic)
+
+func (m *GetPendingTxCountsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPendingTxCountsResponse.Unmarshal(m, b)
 }
-func (m *GetToken721OwnerResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetToken721OwnerResponse.Merge(m, src)
+func (m *GetPendingTxCountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPendingTxCountsResponse.Marshal(b, m, deterministic)
 }
-func (m *GetToken721OwnerResponse) XXX_Size() int {
-	return xxx_messageInfo_GetToken721OwnerResponse.Size(m)
+func (m *GetPendingTxCountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPendingTxCountsResponse.Merge(m, src)
 }
-func (m *GetToken721OwnerResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetToken721OwnerResponse.DiscardUnknown(m)
+func (m *GetPendingTxCountsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPendingTxCountsResponse.Size(m)
+}
+func (m *GetPendingTxCountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPendingTxCountsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetToken721OwnerResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetPendingTxCountsResponse proto.InternalMessageInfo
 
-func (m *GetToken721OwnerResponse) GetOwner() string {
+func (m *GetPendingTxCountsResponse) GetCounts() []*GetPendingTxCountsResponse_PublisherCount {
 	if m != nil {
-		return m.Owner
+		return m.Counts
 	}
-	return ""
+	return nil
 }
 
-// The message defines event struct.
-type Event struct {
-	// event topic
-	Topic Event_Topic `protobuf:"varint,1,opt,name=topic,proto3,enum=rpcpb.Event_Topic" json:"topic,omitempty"`
-	// event data
-	Data string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	// event time
-	Time                 int64    `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+// The message defines the count of pending transactions of a publisher.
+type GetPendingTxCountsResponse_PublisherCount struct {
+	// publisher of the transactions
+	Publisher string `protobuf:"bytes,1,opt,name=publisher,proto3" json:"publisher,omitempty"`
+	// count of pending transactions
+	Count                int64    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Event) Reset()         { *m = Event{} }
-func (m *Event) String() string { return proto.CompactTextString(m) }
-func (*Event) ProtoMessage()    {}
-func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{33}
+func (m *GetPendingTxCountsResponse_PublisherCount) Reset() {
+	*m = GetPendingTxCountsResponse_PublisherCount{}
+}
+func (m *GetPendingTxCountsResponse_PublisherCount) String() string {
+	return proto.CompactTextString(m)
+}
+func (*GetPendingTxCountsResponse_PublisherCount) ProtoMessage() {}
+func (*GetPendingTxCountsResponse_PublisherCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{51, 0}
 }
 
-func (m *Event) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Event.Unmarshal(m, b)
+func (m *GetPendingTxCountsResponse_PublisherCount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPendingTxCountsResponse_PublisherCount.Unmarshal(m, b)
 }
-func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+func (m *GetPendingTxCountsResponse_PublisherCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPendingTxCountsResponse_PublisherCount.Marshal(b, m, deterministic)
 }
-func (m *Event) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Event.Merge(m, src)
+func (m *GetPendingTxCountsResponse_PublisherCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPendingTxCountsResponse_PublisherCount.Merge(m, src)
 }
-func (m *Event) XXX_Size() int {
-	return xxx_messageInfo_Event.Size(m)
+func (m *GetPendingTxCountsResponse_PublisherCount) XXX_Size() int {
+	return xxx_messageInfo_GetPendingTxCountsResponse_PublisherCount.Size(m)
 }
-func (m *Event) XXX_DiscardUnknown() {
-	xxx_messageInfo_Event.DiscardUnknown(m)
+func (m *GetPendingTxCountsResponse_PublisherCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPendingTxCountsResponse_PublisherCount.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Event proto.InternalMessageInfo
-
-func (m *Event) GetTopic() Event_Topic {
-	if m != nil {
-		return m.Topic
-	}
-	return Event_CONTRACT_RECEIPT
-}
+var xxx_messageInfo_GetPendingTxCountsResponse_PublisherCount proto.InternalMessageInfo
 
-func (m *Event) GetData() string {
+func (m *GetPendingTxCountsResponse_PublisherCount) GetPublisher() string {
 	if m != nil {
-		return m.Data
+		return m.Publisher
 	}
 	return ""
 }
 
-func (m *Event) GetTime() int64 {
+func (m *GetPendingTxCountsResponse_PublisherCount) GetCount() int64 {
 	if m != nil {
-		return m.Time
+		return m.Count
 	}
 	return 0
 }
 
-// The message defines subscribe request.
-type SubscribeRequest struct {
-	Topics               []Event_Topic            `protobuf:"varint,1,rep,packed,name=topics,proto3,enum=rpcpb.Event_Topic" json:"topics,omitempty"`
-	Filter               *SubscribeRequest_Filter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+// The request message containing the hashes of transactions to remove.
+type RemovePendingTxsRequest struct {
+	// transaction hashes
+	Hashes               []string `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
-func (m *SubscribeRequest) String() string { return proto.CompactTextString(m) }
-func (*SubscribeRequest) ProtoMessage()    {}
-func (*SubscribeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{34}
+func (m *RemovePendingTxsRequest) Reset()         { *m = RemovePendingTxsRequest{} }
+func (m *RemovePendingTxsRequest) String() string { return proto.CompactTextString(m) }
+func (*RemovePendingTxsRequest) ProtoMessage()    {}
+func (*RemovePendingTxsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{52}
 }
 
-func (m *SubscribeRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SubscribeRequest.Unmarshal(m, b)
+func (m *RemovePendingTxsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemovePendingTxsRequest.Unmarshal(m, b)
 }
-func (m *SubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SubscribeRequest.Marshal(b, m, deterministic)
+func (m *RemovePendingTxsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemovePendingTxsRequest.Marshal(b, m, deterministic)
 }
-func (m *SubscribeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubscribeRequest.Merge(m, src)
+func (m *RemovePendingTxsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovePendingTxsRequest.Merge(m, src)
 }
-func (m *SubscribeRequest) XXX_Size() int {
-	return xxx_messageInfo_SubscribeRequest.Size(m)
+func (m *RemovePendingTxsRequest) XXX_Size() int {
+	return xxx_messageInfo_RemovePendingTxsRequest.Size(m)
 }
-func (m *SubscribeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubscribeRequest.DiscardUnknown(m)
+func (m *RemovePendingTxsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovePendingTxsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SubscribeRequest proto.InternalMessageInfo
+var xxx_messageInfo_RemovePendingTxsRequest proto.InternalMessageInfo
 
-func (m *SubscribeRequest) GetTopics() []Event_Topic {
+func (m *RemovePendingTxsRequest) GetHashes() []string {
 	if m != nil {
-		return m.Topics
+		return m.Hashes
 	}
 	return nil
 }
 
-func (m *SubscribeRequest) GetFilter() *SubscribeRequest_Filter {
+// The message containing the hashes of removed transactions.
+type RemovePendingTxsResponse struct {
+	// hashes of the transactions removed from the pending pool
+	Hashes               []string `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemovePendingTxsResponse) Reset()         { *m = RemovePendingTxsResponse{} }
+func (m *RemovePendingTxsResponse) String() string { return proto.CompactTextString(m) }
+func (*RemovePendingTxsResponse) ProtoMessage()    {}
+func (*RemovePendingTxsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{53}
+}
+
+func (m *RemovePendingTxsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemovePendingTxsResponse.Unmarshal(m, b)
+}
+func (m *RemovePendingTxsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemovePendingTxsResponse.Marshal(b, m, deterministic)
+}
+func (m *RemovePendingTxsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovePendingTxsResponse.Merge(m, src)
+}
+func (m *RemovePendingTxsResponse) XXX_Size() int {
+	return xxx_messageInfo_RemovePendingTxsResponse.Size(m)
+}
+func (m *RemovePendingTxsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovePendingTxsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemovePendingTxsResponse proto.InternalMessageInfo
+
+func (m *RemovePendingTxsResponse) GetHashes() []string {
 	if m != nil {
-		return m.Filter
+		return m.Hashes
 	}
 	return nil
 }
 
-type SubscribeRequest_Filter struct {
-	// contract id
-	ContractId           string   `protobuf:"bytes,1,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+// The request message containing the duration to warp the time.
+type WarpTimeRequest struct {
+	// duration in nanoseconds
+	Duration             int64    `protobuf:"varint,1,opt,name=duration,proto3" json:"duration,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SubscribeRequest_Filter) Reset()         { *m = SubscribeRequest_Filter{} }
-func (m *SubscribeRequest_Filter) String() string { return proto.CompactTextString(m) }
-func (*SubscribeRequest_Filter) ProtoMessage()    {}
-func (*SubscribeRequest_Filter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{34, 0}
+func (m *WarpTimeRequest) Reset()         { *m = WarpTimeRequest{} }
+func (m *WarpTimeRequest) String() string { return proto.CompactTextString(m) }
+func (*WarpTimeRequest) ProtoMessage()    {}
+func (*WarpTimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{54}
 }
 
-func (m *SubscribeRequest_Filter) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SubscribeRequest_Filter.Unmarshal(m, b)
+func (m *WarpTimeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WarpTimeRequest.Unmarshal(m, b)
 }
-func (m *SubscribeRequest_Filter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SubscribeRequest_Filter.Marshal(b, m, deterministic)
+func (m *WarpTimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WarpTimeRequest.Marshal(b, m, deterministic)
 }
-func (m *SubscribeRequest_Filter) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubscribeRequest_Filter.Merge(m, src)
+func (m *WarpTimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WarpTimeRequest.Merge(m, src)
 }
-func (m *SubscribeRequest_Filter) XXX_Size() int {
-	return xxx_messageInfo_SubscribeRequest_Filter.Size(m)
+func (m *WarpTimeRequest) XXX_Size() int {
+	return xxx_messageInfo_WarpTimeRequest.Size(m)
 }
-func (m *SubscribeRequest_Filter) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubscribeRequest_Filter.DiscardUnknown(m)
+func (m *WarpTimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WarpTimeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SubscribeRequest_Filter proto.InternalMessageInfo
+var xxx_messageInfo_WarpTimeRequest proto.InternalMessageInfo
 
-func (m *SubscribeRequest_Filter) GetContractId() string {
+func (m *WarpTimeRequest) GetDuration() int64 {
 	if m != nil {
-		return m.ContractId
+		return m.Duration
 	}
-	return ""
+	return 0
 }
 
-// The message defines subscribe response.
-type SubscribeResponse struct {
-	Event                *Event   `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+// The message containing the node clock after warping.
+type WarpTimeResponse struct {
+	// how far the node clock is ahead of the local time, in nanoseconds
+	Offset int64 `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	// time of the node clock in nanoseconds, which new transactions should be stamped with
+	Time                 int64    `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SubscribeResponse) Reset()         { *m = SubscribeResponse{} }
-func (m *SubscribeResponse) String() string { return proto.CompactTextString(m) }
-func (*SubscribeResponse) ProtoMessage()    {}
-func (*SubscribeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1b773bf3e696f610, []int{35}
+func (m *WarpTimeResponse) Reset()         { *m = WarpTimeResponse{} }
+func (m *WarpTimeResponse) String() string { return proto.CompactTextString(m) }
+func (*WarpTimeResponse) ProtoMessage()    {}
+func (*WarpTimeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1b773bf3e696f610, []int{55}
 }
 
-func (m *SubscribeResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SubscribeResponse.Unmarshal(m, b)
+func (m *WarpTimeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WarpTimeResponse.Unmarshal(m, b)
 }
-func (m *SubscribeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SubscribeResponse.Marshal(b, m, deterministic)
+func (m *WarpTimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WarpTimeResponse.Marshal(b, m, deterministic)
 }
-func (m *SubscribeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubscribeResponse.Merge(m, src)
+func (m *WarpTimeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WarpTimeResponse.Merge(m, src)
 }
-func (m *SubscribeResponse) XXX_Size() int {
-	return xxx_messageInfo_SubscribeResponse.Size(m)
+func (m *WarpTimeResponse) XXX_Size() int {
+	return xxx_messageInfo_WarpTimeResponse.Size(m)
 }
-func (m *SubscribeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubscribeResponse.DiscardUnknown(m)
+func (m *WarpTimeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WarpTimeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SubscribeResponse proto.InternalMessageInfo
+var xxx_messageInfo_WarpTimeResponse proto.InternalMessageInfo
 
-func (m *SubscribeResponse) GetEvent() *Event {
+func (m *WarpTimeResponse) GetOffset() int64 {
 	if m != nil {
-		return m.Event
+		return m.Offset
 	}
-	return nil
+	return 0
+}
+
+func (m *WarpTimeResponse) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
 }
 
 func init() {
@@ -3035,6 +4822,7 @@ func init() {
 	proto.RegisterEnum("rpcpb.TransactionResponse_Status", TransactionResponse_Status_name, TransactionResponse_Status_value)
 	proto.RegisterEnum("rpcpb.Signature_Algorithm", Signature_Algorithm_name, Signature_Algorithm_value)
 	proto.RegisterEnum("rpcpb.BlockResponse_Status", BlockResponse_Status_name, BlockResponse_Status_value)
+	proto.RegisterEnum("rpcpb.TxStatusResponse_Status", TxStatusResponse_Status_name, TxStatusResponse_Status_value)
 	proto.RegisterEnum("rpcpb.Event_Topic", Event_Topic_name, Event_Topic_value)
 	proto.RegisterType((*EmptyRequest)(nil), "rpcpb.EmptyRequest")
 	proto.RegisterType((*PeerInfo)(nil), "rpcpb.PeerInfo")
@@ -3046,6 +4834,7 @@ func init() {
 	proto.RegisterType((*TxReceipt)(nil), "rpcpb.TxReceipt")
 	proto.RegisterMapType((map[string]int64)(nil), "rpcpb.TxReceipt.RamUsageEntry")
 	proto.RegisterType((*TxReceipt_Receipt)(nil), "rpcpb.TxReceipt.Receipt")
+	proto.RegisterType((*TxReceipt_ActionReceipt)(nil), "rpcpb.TxReceipt.ActionReceipt")
 	proto.RegisterType((*Transaction)(nil), "rpcpb.Transaction")
 	proto.RegisterType((*TransactionResponse)(nil), "rpcpb.TransactionResponse")
 	proto.RegisterType((*Signature)(nil), "rpcpb.Signature")
@@ -3053,12 +4842,28 @@ func init() {
 	proto.RegisterType((*Block)(nil), "rpcpb.Block")
 	proto.RegisterType((*Block_Info)(nil), "rpcpb.Block.Info")
 	proto.RegisterType((*BlockResponse)(nil), "rpcpb.BlockResponse")
+	proto.RegisterType((*MerkleProofResponse)(nil), "rpcpb.MerkleProofResponse")
 	proto.RegisterType((*ChainInfoResponse)(nil), "rpcpb.ChainInfoResponse")
 	proto.RegisterType((*TxHashRequest)(nil), "rpcpb.TxHashRequest")
+	proto.RegisterType((*TxStatusResponse)(nil), "rpcpb.TxStatusResponse")
 	proto.RegisterType((*GetBlockByHashRequest)(nil), "rpcpb.GetBlockByHashRequest")
 	proto.RegisterType((*GetBlockByNumberRequest)(nil), "rpcpb.GetBlockByNumberRequest")
+	proto.RegisterType((*GetBlocksByRangeRequest)(nil), "rpcpb.GetBlocksByRangeRequest")
+	proto.RegisterType((*GetTxsByBlockRequest)(nil), "rpcpb.GetTxsByBlockRequest")
+	proto.RegisterType((*GetTxsByBlockResponse)(nil), "rpcpb.GetTxsByBlockResponse")
+	proto.RegisterType((*GetAccountTransactionsRequest)(nil), "rpcpb.GetAccountTransactionsRequest")
+	proto.RegisterType((*GetAccountTransactionsResponse)(nil), "rpcpb.GetAccountTransactionsResponse")
+	proto.RegisterType((*GetScheduledTxsRequest)(nil), "rpcpb.GetScheduledTxsRequest")
+	proto.RegisterType((*GetScheduledTxsResponse)(nil), "rpcpb.GetScheduledTxsResponse")
+	proto.RegisterType((*GetScheduledTxsResponse_ScheduledTx)(nil), "rpcpb.GetScheduledTxsResponse.ScheduledTx")
 	proto.RegisterType((*FrozenBalance)(nil), "rpcpb.FrozenBalance")
 	proto.RegisterType((*GasRatioResponse)(nil), "rpcpb.GasRatioResponse")
+	proto.RegisterType((*GetDoubleSignEvidencesResponse)(nil), "rpcpb.GetDoubleSignEvidencesResponse")
+	proto.RegisterType((*GetDoubleSignEvidencesResponse_Evidence)(nil), "rpcpb.GetDoubleSignEvidencesResponse.Evidence")
+	proto.RegisterType((*GetWitnessScheduleRequest)(nil), "rpcpb.GetWitnessScheduleRequest")
+	proto.RegisterType((*GetWitnessScheduleResponse)(nil), "rpcpb.GetWitnessScheduleResponse")
+	proto.RegisterType((*GetWitnessScheduleResponse_Slot)(nil), "rpcpb.GetWitnessScheduleResponse.Slot")
+	proto.RegisterType((*GetWitnessScheduleResponse_ProducerStat)(nil), "rpcpb.GetWitnessScheduleResponse.ProducerStat")
 	proto.RegisterType((*Account)(nil), "rpcpb.Account")
 	proto.RegisterMapType((map[string]*Account_Group)(nil), "rpcpb.Account.GroupsEntry")
 	proto.RegisterMapType((map[string]*Account_Permission)(nil), "rpcpb.Account.PermissionsEntry")
@@ -3074,6 +4879,7 @@ func init() {
 	proto.RegisterType((*GetContractRequest)(nil), "rpcpb.GetContractRequest")
 	proto.RegisterType((*GetContractStorageRequest)(nil), "rpcpb.GetContractStorageRequest")
 	proto.RegisterType((*GetContractStorageResponse)(nil), "rpcpb.GetContractStorageResponse")
+	proto.RegisterType((*StateProofResponse)(nil), "rpcpb.StateProofResponse")
 	proto.RegisterType((*SendTransactionResponse)(nil), "rpcpb.SendTransactionResponse")
 	proto.RegisterType((*GetTokenBalanceResponse)(nil), "rpcpb.GetTokenBalanceResponse")
 	proto.RegisterType((*GetTokenBalanceRequest)(nil), "rpcpb.GetTokenBalanceRequest")
@@ -3085,208 +4891,314 @@ func init() {
 	proto.RegisterType((*SubscribeRequest)(nil), "rpcpb.SubscribeRequest")
 	proto.RegisterType((*SubscribeRequest_Filter)(nil), "rpcpb.SubscribeRequest.Filter")
 	proto.RegisterType((*SubscribeResponse)(nil), "rpcpb.SubscribeResponse")
+	proto.RegisterType((*GetPendingTxsRequest)(nil), "rpcpb.GetPendingTxsRequest")
+	proto.RegisterType((*GetPendingTxsResponse)(nil), "rpcpb.GetPendingTxsResponse")
+	proto.RegisterType((*GetPendingTxCountsResponse)(nil), "rpcpb.GetPendingTxCountsResponse")
+	proto.RegisterType((*GetPendingTxCountsResponse_PublisherCount)(nil), "rpcpb.GetPendingTxCountsResponse.PublisherCount")
+	proto.RegisterType((*RemovePendingTxsRequest)(nil), "rpcpb.RemovePendingTxsRequest")
+	proto.RegisterType((*RemovePendingTxsResponse)(nil), "rpcpb.RemovePendingTxsResponse")
+	proto.RegisterType((*WarpTimeRequest)(nil), "rpcpb.WarpTimeRequest")
+	proto.RegisterType((*WarpTimeResponse)(nil), "rpcpb.WarpTimeResponse")
 }
 
 func init() { proto.RegisterFile("rpc/pb/rpc.proto", fileDescriptor_1b773bf3e696f610) }
 
 var fileDescriptor_1b773bf3e696f610 = []byte{
-	// 3131 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x39, 0xcd, 0x92, 0xdb, 0xc6,
-	0xd1, 0x02, 0xb9, 0xfc, 0x41, 0x93, 0xcb, 0x85, 0x66, 0xd7, 0x12, 0x45, 0xfd, 0x58, 0x82, 0x65,
-	0xeb, 0xa7, 0xec, 0xa5, 0xb4, 0xb2, 0x2c, 0x4b, 0xb6, 0xbf, 0xcf, 0x5c, 0x8a, 0x5a, 0x6f, 0x49,
-	0xe2, 0xae, 0x41, 0xae, 0xfd, 0xb9, 0xea, 0xab, 0x42, 0x40, 0x72, 0x96, 0x8b, 0x88, 0x04, 0x18,
-	0x00, 0x94, 0xb8, 0xd9, 0xd2, 0x21, 0x39, 0xe4, 0x94, 0x8a, 0x2b, 0xe5, 0x4b, 0x2a, 0xc9, 0x25,
-	0xd7, 0xbc, 0x40, 0x52, 0x95, 0x5b, 0x5e, 0x21, 0x0f, 0x90, 0x43, 0xf2, 0x06, 0x7e, 0x81, 0xd4,
-	0xf4, 0xcc, 0x00, 0x20, 0x08, 0x4a, 0x9b, 0x13, 0xd1, 0x3d, 0x3d, 0xdd, 0x3d, 0x3d, 0xfd, 0x3b,
-	0x04, 0xcd, 0x9b, 0xf4, 0xeb, 0x93, 0x5e, 0xdd, 0x9b, 0xf4, 0x37, 0x27, 0x9e, 0x1b, 0xb8, 0x24,
-	0xe7, 0x4d, 0xfa, 0x93, 0x5e, 0xed, 0xd2, 0xd0, 0x75, 0x87, 0x23, 0x5a, 0xb7, 0x26, 0x76, 0xdd,
-	0x72, 0x1c, 0x37, 0xb0, 0x02, 0xdb, 0x75, 0x7c, 0x4e, 0xa4, 0x57, 0xa0, 0xdc, 0x1a, 0x4f, 0x82,
-	0x63, 0x83, 0xfe, 0x6c, 0x4a, 0xfd, 0x40, 0xdf, 0x84, 0xe2, 0x3e, 0xa5, 0xde, 0xae, 0x73, 0xe8,
-	0x92, 0x0a, 0x64, 0xec, 0x41, 0x55, 0xb9, 0xaa, 0xdc, 0x54, 0x8d, 0x8c, 0x3d, 0x20, 0x04, 0x56,
-	0xac, 0xc1, 0xc0, 0xab, 0x66, 0x10, 0x83, 0xdf, 0xfa, 0x4f, 0xa1, 0xd4, 0xa6, 0xc1, 0x2b, 0xd7,
-	0x7b, 0x91, 0xba, 0xe5, 0x32, 0xc0, 0x84, 0x52, 0xcf, 0xec, 0xbb, 0x53, 0x27, 0xc0, 0x8d, 0x39,
-	0x43, 0x65, 0x98, 0x26, 0x43, 0x90, 0x0f, 0x01, 0x01, 0xd3, 0x76, 0x0e, 0xdd, 0x6a, 0xf6, 0x6a,
-	0xf6, 0x66, 0x69, 0x6b, 0x6d, 0x13, 0xd5, 0xde, 0x94, 0x5a, 0x18, 0xc5, 0x89, 0xf8, 0xd2, 0xff,
-	0xac, 0xc0, 0x9a, 0xd1, 0x78, 0x8e, 0x58, 0xea, 0x4f, 0x5c, 0xc7, 0xa7, 0xe4, 0x02, 0x14, 0xa7,
-	0x3e, 0x1d, 0x98, 0x9e, 0x35, 0x46, 0xb1, 0x59, 0xa3, 0xc0, 0x60, 0xc3, 0x1a, 0x93, 0xf7, 0x60,
-	0xd5, 0x7a, 0x69, 0xd9, 0x23, 0xab, 0x37, 0xa2, 0xb8, 0x9e, 0xc1, 0xf5, 0x72, 0x88, 0x64, 0x44,
-	0x17, 0x41, 0x0d, 0xdc, 0xc0, 0x1a, 0x21, 0x41, 0x16, 0x09, 0x8a, 0x88, 0x60, 0x8b, 0x97, 0x01,
-	0x7c, 0x3a, 0x1a, 0x99, 0x13, 0xcf, 0xee, 0xd3, 0xea, 0xca, 0x55, 0xe5, 0xa6, 0x62, 0xa8, 0x0c,
-	0xb3, 0xcf, 0x10, 0x6c, 0x6f, 0x6f, 0x7a, 0x2c, 0x56, 0x73, 0xb8, 0x5a, 0xec, 0x4d, 0x8f, 0x71,
-	0x51, 0xff, 0x8d, 0x02, 0x5a, 0xdb, 0x1d, 0xd0, 0x39, 0x6d, 0x2f, 0x03, 0xf4, 0xa6, 0xf6, 0x68,
-	0x60, 0x06, 0xf6, 0x98, 0x0a, 0x33, 0xa9, 0x88, 0xe9, 0xda, 0x63, 0x3c, 0xcc, 0xd0, 0x0e, 0xcc,
-	0x23, 0xcb, 0x3f, 0x12, 0x46, 0x2e, 0x0c, 0xed, 0xe0, 0x2b, 0xcb, 0x3f, 0x62, 0xb6, 0x1f, 0xbb,
-	0x03, 0x8a, 0x2a, 0xaa, 0x06, 0x7e, 0x93, 0x0f, 0xa1, 0xe0, 0x70, 0xdb, 0xa3, 0x6e, 0xa5, 0x2d,
-	0x22, 0x6c, 0x17, 0xbb, 0x11, 0x43, 0x92, 0xe8, 0x0f, 0xa1, 0xd4, 0x18, 0x33, 0xab, 0x3f, 0xb3,
-	0xc7, 0x76, 0x40, 0x36, 0x20, 0x17, 0xb8, 0x2f, 0xa8, 0x23, 0xb4, 0xe0, 0x00, 0xc3, 0xbe, 0xb4,
-	0x46, 0x53, 0x2a, 0xc4, 0x73, 0x40, 0xff, 0x0e, 0xf2, 0x8d, 0x3e, 0xf3, 0x1a, 0x52, 0x83, 0x62,
-	0xdf, 0x75, 0x02, 0xcf, 0xea, 0x07, 0x62, 0x63, 0x08, 0x93, 0x77, 0xa1, 0x64, 0x21, 0x95, 0xe9,
-	0x58, 0x63, 0xc9, 0x01, 0x38, 0xaa, 0x6d, 0x8d, 0x29, 0x3b, 0xc3, 0xc0, 0x0a, 0x2c, 0x79, 0x06,
-	0xf6, 0xad, 0xff, 0x73, 0x05, 0xd4, 0xee, 0xcc, 0xa0, 0x7d, 0x6a, 0x4f, 0x02, 0x72, 0x1e, 0x0a,
-	0xc1, 0x8c, 0x9f, 0x9f, 0x73, 0xcf, 0x07, 0x33, 0x3c, 0xfe, 0x45, 0x50, 0x87, 0x96, 0x6f, 0x4e,
-	0x7d, 0x6b, 0xc8, 0x39, 0x2b, 0x46, 0x71, 0x68, 0xf9, 0x07, 0x0c, 0x26, 0x9f, 0x81, 0xea, 0x59,
-	0x63, 0xb1, 0xc8, 0xbd, 0xe8, 0x8a, 0xb0, 0x44, 0xc8, 0x7a, 0xd3, 0xb0, 0xc6, 0x48, 0xdd, 0x72,
-	0x02, 0xef, 0xd8, 0x28, 0x7a, 0x02, 0x24, 0x9f, 0x43, 0xc9, 0x0f, 0xac, 0x60, 0xea, 0x9b, 0x7d,
-	0x66, 0x5f, 0x66, 0xc8, 0xca, 0xd6, 0xc5, 0x85, 0xed, 0x1d, 0xa4, 0x69, 0xba, 0x03, 0x6a, 0x80,
-	0x1f, 0x7e, 0x93, 0x2a, 0x14, 0xc6, 0xd4, 0x47, 0xc1, 0x39, 0x7e, 0x61, 0x02, 0x64, 0x2b, 0x1e,
-	0x0d, 0xa6, 0x9e, 0xe3, 0x57, 0xf3, 0x57, 0xb3, 0x6c, 0x45, 0x80, 0xe4, 0x63, 0x28, 0x7a, 0x9c,
-	0xab, 0x5f, 0x2d, 0xa0, 0xb6, 0xd5, 0x45, 0x6d, 0xf9, 0xaf, 0x11, 0x52, 0xd6, 0x3e, 0x83, 0xd5,
-	0xb9, 0x23, 0x10, 0x0d, 0xb2, 0x2f, 0xe8, 0xb1, 0xb0, 0x13, 0xfb, 0x9c, 0xbf, 0xbc, 0xac, 0xb8,
-	0xbc, 0x47, 0x99, 0x4f, 0x95, 0xda, 0x97, 0x50, 0x90, 0x26, 0xbe, 0x08, 0xea, 0xe1, 0xd4, 0xe9,
-	0xf3, 0x3b, 0x12, 0x57, 0xc8, 0x10, 0x78, 0x43, 0x55, 0x28, 0xb0, 0xeb, 0xa4, 0x22, 0x56, 0x55,
-	0x43, 0x82, 0xfa, 0x5f, 0x14, 0x80, 0xc8, 0x06, 0xa4, 0x04, 0x85, 0xce, 0x41, 0xb3, 0xd9, 0xea,
-	0x74, 0xb4, 0x33, 0x64, 0x0d, 0x4a, 0x3b, 0x8d, 0x8e, 0x69, 0x1c, 0xb4, 0xcd, 0xbd, 0x83, 0xae,
-	0xa6, 0x90, 0x73, 0x40, 0xb6, 0x1b, 0xcf, 0x1a, 0xed, 0x66, 0xcb, 0x6c, 0xef, 0x75, 0xcd, 0x56,
-	0x7b, 0xef, 0x60, 0xe7, 0x2b, 0x2d, 0x43, 0xd6, 0x61, 0xed, 0x5b, 0x63, 0xaf, 0xbd, 0x63, 0xee,
-	0x37, 0x8c, 0xc6, 0xf3, 0x56, 0xb7, 0x65, 0x68, 0x59, 0x72, 0x16, 0x56, 0x8d, 0x83, 0x76, 0x77,
-	0xf7, 0x79, 0xcb, 0x6c, 0x19, 0xc6, 0x9e, 0xa1, 0xad, 0x30, 0xee, 0x0c, 0x66, 0xcc, 0x72, 0xd1,
-	0xa6, 0xee, 0xff, 0x99, 0x4f, 0xf6, 0x8c, 0xe7, 0x8d, 0xae, 0x96, 0x67, 0x12, 0x1e, 0x1f, 0xec,
-	0x3f, 0xdb, 0x6d, 0x36, 0xba, 0x2d, 0xb3, 0xd3, 0xea, 0x9a, 0xcd, 0xbd, 0xc7, 0x2d, 0xad, 0xc0,
-	0x98, 0x1d, 0xb4, 0x9f, 0xb6, 0xf7, 0xbe, 0x6d, 0x0b, 0x66, 0x45, 0xfd, 0xfb, 0x2c, 0x94, 0xba,
-	0x9e, 0xe5, 0xf8, 0xdc, 0x13, 0x99, 0x17, 0xc6, 0x1c, 0x0c, 0xbf, 0x19, 0x0e, 0x23, 0x92, 0x1b,
-	0x0e, 0xbf, 0xc9, 0x15, 0x00, 0x3a, 0x9b, 0xd8, 0x1e, 0xa6, 0x4b, 0x91, 0x1a, 0x62, 0x18, 0xe9,
-	0x92, 0x08, 0x89, 0xdc, 0xc0, 0x5c, 0xd2, 0x60, 0xb0, 0x5c, 0x1c, 0xb1, 0x50, 0x93, 0xa9, 0x61,
-	0x68, 0xf9, 0x61, 0xe8, 0x0d, 0xe8, 0xc8, 0x3a, 0xae, 0xe6, 0xf9, 0x3d, 0x21, 0x40, 0x6e, 0x40,
-	0x81, 0x6b, 0x28, 0xbd, 0x62, 0x55, 0x78, 0x05, 0x0f, 0x3d, 0x43, 0xae, 0xb2, 0x4b, 0xf2, 0xed,
-	0xa1, 0x43, 0x3d, 0xbf, 0x5a, 0xe4, 0x9e, 0x25, 0x40, 0x72, 0x09, 0xd4, 0xc9, 0xb4, 0x37, 0xb2,
-	0xfd, 0x23, 0xea, 0x55, 0x55, 0x9e, 0x5d, 0x42, 0x04, 0x8b, 0x4f, 0x8f, 0x1e, 0x52, 0xcf, 0xa3,
-	0x03, 0x33, 0x98, 0x55, 0x81, 0xc7, 0xa7, 0x44, 0x75, 0x67, 0xe4, 0x3e, 0x94, 0x2d, 0xcc, 0x10,
-	0x42, 0xef, 0x12, 0xaa, 0x21, 0x93, 0x4a, 0x2c, 0x79, 0x18, 0x25, 0x2b, 0x96, 0x49, 0xea, 0x00,
-	0xc1, 0xcc, 0x14, 0x8e, 0x5a, 0x2d, 0x63, 0x26, 0xd2, 0x92, 0x1e, 0x6d, 0xa8, 0x81, 0xfc, 0xd4,
-	0xff, 0xa6, 0xc0, 0x7a, 0xec, 0x46, 0xc2, 0xec, 0xf8, 0x10, 0xf2, 0x3c, 0xb4, 0xf0, 0x6e, 0x2a,
-	0x5b, 0xd7, 0x24, 0x93, 0x45, 0x5a, 0x11, 0x8f, 0x86, 0xd8, 0x40, 0x3e, 0x86, 0x52, 0x10, 0x51,
-	0xe1, 0x3d, 0x46, 0x9a, 0xc7, 0xf7, 0xc7, 0xc9, 0xf4, 0x7b, 0x90, 0xe7, 0x7c, 0x98, 0xc7, 0xed,
-	0xb7, 0xda, 0x8f, 0x77, 0xdb, 0x8f, 0xb5, 0x33, 0x04, 0x20, 0xbf, 0xdf, 0x68, 0x3e, 0x6d, 0x3d,
-	0xd6, 0x14, 0xa2, 0x41, 0x79, 0xd7, 0x30, 0x5a, 0xdf, 0xb4, 0x8c, 0xce, 0xee, 0xf6, 0xb3, 0x96,
-	0x96, 0xd1, 0xff, 0xaa, 0x80, 0xda, 0xb1, 0x87, 0x8e, 0x15, 0x4c, 0x3d, 0x4a, 0x3e, 0x05, 0xd5,
-	0x1a, 0x0d, 0x5d, 0xcf, 0x0e, 0x8e, 0xc6, 0x42, 0xed, 0x9a, 0x10, 0x1b, 0x12, 0x6d, 0x36, 0x24,
-	0x85, 0x11, 0x11, 0xb3, 0xcb, 0xf2, 0x25, 0x05, 0x2a, 0x5c, 0x36, 0x22, 0x04, 0x16, 0x4e, 0x76,
-	0x73, 0x7d, 0x93, 0x05, 0x79, 0x96, 0x2f, 0x73, 0xcc, 0x53, 0x7a, 0xac, 0x7f, 0x0c, 0x6a, 0xc8,
-	0x94, 0x29, 0x2f, 0x9c, 0x5e, 0x3b, 0x43, 0x56, 0x41, 0xed, 0xb4, 0x9a, 0xfb, 0x5b, 0xf7, 0x3f,
-	0x79, 0x7a, 0x57, 0x53, 0xd8, 0x5a, 0xeb, 0xf1, 0xd6, 0xfd, 0xfb, 0x77, 0x1f, 0x6a, 0x19, 0xfd,
-	0x0f, 0x59, 0x20, 0x73, 0xc6, 0xc4, 0x9a, 0x1f, 0x7a, 0xbf, 0xb2, 0xd4, 0xfb, 0x33, 0x6f, 0xf6,
-	0xfe, 0xec, 0x9b, 0xbc, 0x7f, 0x65, 0x99, 0xf7, 0xe7, 0x96, 0x78, 0x7f, 0xfe, 0x8d, 0xde, 0x9f,
-	0x74, 0xd2, 0xc2, 0xe9, 0x9c, 0x74, 0x79, 0xd0, 0xdc, 0x01, 0x08, 0xcd, 0xee, 0x57, 0x55, 0x64,
-	0xa7, 0x25, 0xaf, 0xd0, 0x88, 0xd1, 0xcc, 0x87, 0x19, 0x24, 0xc3, 0xec, 0x01, 0x54, 0x42, 0xc0,
-	0xf4, 0xed, 0xa1, 0x2f, 0xe2, 0x68, 0x91, 0xe7, 0x6a, 0x48, 0xd7, 0xb1, 0x87, 0xbe, 0xfe, 0xaf,
-	0x2c, 0xe4, 0xb6, 0x47, 0x6e, 0xff, 0x45, 0x6a, 0x8a, 0xaa, 0x42, 0xe1, 0x25, 0xf5, 0xfc, 0xe8,
-	0x36, 0x24, 0xc8, 0xe2, 0x7a, 0x62, 0x79, 0xd4, 0x11, 0x8d, 0x03, 0xaf, 0xae, 0xc0, 0x51, 0x58,
-	0x3c, 0xaf, 0x43, 0x25, 0x98, 0x99, 0x63, 0xea, 0xbd, 0x18, 0x51, 0x4e, 0xb3, 0x82, 0x34, 0xe5,
-	0x60, 0xf6, 0x1c, 0x91, 0x48, 0x75, 0x0f, 0xce, 0x45, 0x61, 0x3c, 0x47, 0xcd, 0x2b, 0xdb, 0x7a,
-	0x18, 0xc0, 0xb1, 0x4d, 0xe7, 0x20, 0xef, 0x4c, 0xc7, 0x3d, 0xea, 0x89, 0x5c, 0x26, 0x20, 0xa6,
-	0xed, 0x2b, 0x3b, 0x70, 0xa8, 0xcf, 0x92, 0x19, 0x16, 0x12, 0x01, 0x86, 0xce, 0x56, 0x8c, 0x39,
-	0xdb, 0x5c, 0x75, 0x57, 0x13, 0xd5, 0xfd, 0x02, 0x14, 0x83, 0x99, 0x68, 0x20, 0x81, 0x9f, 0x3c,
-	0x98, 0xf1, 0xf6, 0xf1, 0x7d, 0x58, 0xc1, 0xce, 0xb1, 0x84, 0xe1, 0x7e, 0x56, 0x18, 0x18, 0x6d,
-	0xb8, 0x89, 0xcd, 0x0f, 0x2e, 0x93, 0x4f, 0xa0, 0x1c, 0x8b, 0x7a, 0xbf, 0x5a, 0x9e, 0x73, 0x99,
-	0x78, 0x40, 0xcc, 0xd1, 0xd5, 0x3a, 0xb0, 0x82, 0x4d, 0xad, 0xec, 0xbd, 0x14, 0x6c, 0x5f, 0x79,
-	0xef, 0x75, 0x0e, 0xf2, 0xc1, 0x91, 0x47, 0xad, 0x81, 0x68, 0x6a, 0x05, 0xc4, 0x2e, 0xa3, 0x67,
-	0x05, 0xfd, 0x23, 0xd3, 0x76, 0x06, 0x74, 0x86, 0xdd, 0x48, 0xce, 0x00, 0x44, 0xed, 0x32, 0x8c,
-	0xfe, 0x5b, 0x05, 0x56, 0x51, 0xc3, 0x30, 0xed, 0xdd, 0x4b, 0xa4, 0xbd, 0x8b, 0xf1, 0x73, 0x2c,
-	0x4b, 0x78, 0x3a, 0xe4, 0x7a, 0x6c, 0x5d, 0xa4, 0xba, 0xf2, 0xdc, 0x1e, 0xbe, 0xa4, 0xdf, 0x48,
-	0x4f, 0x6f, 0xc9, 0x94, 0xa6, 0xe8, 0xbf, 0xca, 0xc0, 0xd9, 0xe6, 0x91, 0x65, 0x3b, 0xc9, 0xd6,
-	0xda, 0xa1, 0x41, 0xbc, 0x51, 0x60, 0xbd, 0x24, 0xf6, 0x09, 0xb7, 0x40, 0xc3, 0xf1, 0xa1, 0xef,
-	0x8e, 0xcc, 0xb8, 0x57, 0xaa, 0xc6, 0x9a, 0xc4, 0x7f, 0x23, 0xbc, 0xf3, 0x32, 0xc0, 0x11, 0xb5,
-	0x06, 0x26, 0xd7, 0x96, 0x97, 0x51, 0x95, 0x61, 0xb8, 0xab, 0x7f, 0x00, 0x6b, 0xd1, 0x72, 0xdc,
-	0x39, 0x57, 0x43, 0x1a, 0xd9, 0x00, 0x8e, 0xec, 0x9e, 0xe0, 0xc2, 0x33, 0x47, 0x71, 0x64, 0xf7,
-	0x38, 0x93, 0xeb, 0x50, 0x09, 0x17, 0x39, 0x8f, 0x3c, 0x77, 0x70, 0x49, 0x81, 0x2c, 0xae, 0x41,
-	0x59, 0x38, 0xa1, 0x39, 0xb2, 0x7d, 0x9e, 0x39, 0x54, 0xa3, 0x24, 0x70, 0xcf, 0x6c, 0x3f, 0xd0,
-	0xdf, 0x83, 0xd5, 0x2e, 0x36, 0x9c, 0xb1, 0xd4, 0x98, 0x8c, 0x44, 0x7d, 0x07, 0xde, 0xd9, 0xa1,
-	0x01, 0xf2, 0xdd, 0x3e, 0x7e, 0x0b, 0x31, 0x6f, 0x98, 0xc7, 0x93, 0x11, 0x0d, 0x78, 0x92, 0x2f,
-	0x1a, 0x21, 0xac, 0x3f, 0x87, 0xf3, 0x11, 0xa3, 0x36, 0x06, 0x8e, 0x64, 0x15, 0xc5, 0x95, 0x32,
-	0x17, 0x57, 0x6f, 0x62, 0xf7, 0x19, 0xac, 0x3e, 0xf1, 0xdc, 0x9f, 0x53, 0x67, 0xdb, 0x1a, 0x59,
-	0x4e, 0x1f, 0x7d, 0x94, 0xa7, 0x40, 0x64, 0xa2, 0x18, 0x02, 0x4a, 0xeb, 0x76, 0xf4, 0x43, 0xd0,
-	0x76, 0x44, 0xfa, 0x0e, 0x1d, 0xe0, 0x26, 0x68, 0x23, 0xf7, 0x15, 0xf5, 0x03, 0x33, 0x4a, 0xf5,
-	0x9c, 0x53, 0x85, 0xe3, 0xe5, 0x0e, 0x46, 0x39, 0xa6, 0x03, 0xdb, 0x72, 0x62, 0x94, 0xbc, 0x4b,
-	0xaf, 0x70, 0xbc, 0xa4, 0xd4, 0x7f, 0xaf, 0x42, 0xa1, 0xd1, 0xef, 0x4b, 0x3d, 0x62, 0xce, 0x85,
-	0xdf, 0x2c, 0x71, 0xf4, 0xb8, 0xfa, 0x82, 0x81, 0x04, 0xc9, 0x5d, 0x60, 0x39, 0x41, 0x8e, 0x8a,
-	0xcc, 0xe9, 0xcf, 0x85, 0x25, 0x02, 0xf9, 0x6d, 0xee, 0x58, 0x3e, 0x1f, 0x79, 0x86, 0xfc, 0x83,
-	0x6d, 0x61, 0x83, 0x01, 0x6e, 0x59, 0x49, 0xdd, 0x22, 0xc7, 0xc9, 0x82, 0x67, 0x8d, 0x71, 0x4b,
-	0x03, 0x4a, 0x13, 0xea, 0x8d, 0x6d, 0xdf, 0xc7, 0x54, 0x91, 0xc3, 0x54, 0xf1, 0x6e, 0x62, 0xd7,
-	0x7e, 0x44, 0xc1, 0xc7, 0x89, 0xf8, 0x1e, 0xb2, 0x05, 0xf9, 0xa1, 0xe7, 0x4e, 0x27, 0xb2, 0x92,
-	0xd5, 0x92, 0x6a, 0xe2, 0x22, 0xdf, 0x28, 0x28, 0xc9, 0x17, 0xb0, 0x76, 0x88, 0x77, 0x67, 0x8a,
-	0xe3, 0xca, 0x26, 0x70, 0x43, 0x6c, 0x9e, 0xbb, 0x59, 0xa3, 0x72, 0x18, 0x07, 0xfd, 0xda, 0xff,
-	0x00, 0xec, 0x8f, 0xe8, 0x60, 0x88, 0xd3, 0x26, 0xb3, 0xe1, 0x04, 0x21, 0x4f, 0xc6, 0xad, 0x00,
-	0x63, 0x1e, 0x91, 0x89, 0x7b, 0x44, 0xed, 0x47, 0x05, 0x0a, 0xc2, 0x7a, 0x6c, 0x6c, 0xee, 0x4f,
-	0x3d, 0xac, 0x27, 0x38, 0x08, 0x8b, 0x2b, 0x2f, 0x0b, 0x64, 0x97, 0xe1, 0x58, 0x02, 0xc0, 0x54,
-	0x79, 0x48, 0x3d, 0x1c, 0xaf, 0x87, 0x96, 0x2f, 0x58, 0xae, 0xc5, 0xf1, 0x3b, 0x96, 0x8f, 0x9d,
-	0x0c, 0x8a, 0x47, 0x22, 0xde, 0x2a, 0xa8, 0x1c, 0xc3, 0x96, 0xdf, 0x87, 0x8a, 0xed, 0xf4, 0x3d,
-	0x6a, 0xf9, 0xd4, 0xf4, 0x27, 0x94, 0x0e, 0x44, 0xc3, 0xb0, 0x2a, 0xb1, 0x1d, 0x86, 0x64, 0x5d,
-	0x43, 0xbc, 0x99, 0xe6, 0x00, 0xf9, 0x1c, 0xca, 0x9c, 0xd3, 0x80, 0x5f, 0x32, 0x37, 0xf8, 0x85,
-	0xe4, 0x75, 0x85, 0xa6, 0x31, 0x4a, 0x82, 0x9c, 0x01, 0xb5, 0x1b, 0x50, 0x10, 0xf7, 0xcf, 0x4a,
-	0x7a, 0xf8, 0x2c, 0x20, 0x42, 0x2e, 0x42, 0xd4, 0x1c, 0x58, 0xd9, 0x0d, 0xe8, 0x78, 0xe1, 0x75,
-	0xe3, 0x0a, 0x94, 0x6c, 0x9f, 0x35, 0x68, 0xe6, 0xc4, 0xb2, 0x3d, 0x11, 0x90, 0xaa, 0xed, 0x3f,
-	0xa5, 0xc7, 0xfb, 0x96, 0x8d, 0xe6, 0x7e, 0x45, 0xed, 0xe1, 0x51, 0x20, 0xf2, 0x9e, 0x80, 0x58,
-	0x73, 0x15, 0x39, 0x8c, 0xc8, 0x77, 0x31, 0x4c, 0xed, 0x09, 0xe4, 0xd0, 0x49, 0x52, 0x23, 0xe4,
-	0x16, 0xe4, 0xec, 0x80, 0x8e, 0x99, 0xbd, 0xd9, 0x61, 0xd7, 0x13, 0x87, 0x65, 0x8a, 0x1a, 0x9c,
-	0xa2, 0xf6, 0x0b, 0x05, 0x20, 0xf2, 0xd5, 0x54, 0x6e, 0xe7, 0x42, 0x67, 0xcd, 0x60, 0x3a, 0x94,
-	0x0e, 0x19, 0x4a, 0xc9, 0xbe, 0x4d, 0x0a, 0xb3, 0x1d, 0x2b, 0x7e, 0xfe, 0x91, 0x3b, 0xe2, 0x97,
-	0x97, 0x35, 0x22, 0x44, 0xed, 0x3b, 0xd0, 0x92, 0xe1, 0x92, 0x32, 0xba, 0xd6, 0xe3, 0xa3, 0x6b,
-	0xca, 0x0d, 0x86, 0x1c, 0xe2, 0x53, 0xed, 0x1e, 0x94, 0x62, 0xb1, 0x94, 0xc2, 0xf5, 0xf6, 0x3c,
-	0xd7, 0x8d, 0xb4, 0x40, 0x8c, 0x31, 0xd4, 0xbf, 0x86, 0xb3, 0x3b, 0x34, 0x10, 0xcb, 0xb1, 0xac,
-	0xbe, 0x60, 0xb5, 0x9b, 0xa0, 0xf5, 0x8e, 0xcd, 0x91, 0xeb, 0x0c, 0x59, 0x76, 0xec, 0xb3, 0xd2,
-	0x29, 0x6e, 0xbf, 0xd2, 0x3b, 0x7e, 0xc6, 0xd1, 0x58, 0x50, 0xf5, 0x1f, 0x15, 0x28, 0x36, 0xe5,
-	0x0b, 0x49, 0xca, 0x83, 0x1a, 0x3e, 0x3a, 0x88, 0x07, 0x35, 0xf6, 0xcd, 0x32, 0xfc, 0xc8, 0x72,
-	0x86, 0x53, 0xfe, 0x96, 0x81, 0xe3, 0xb9, 0x84, 0xe3, 0x3d, 0x20, 0x77, 0x9a, 0xb0, 0x07, 0xbc,
-	0x01, 0x2b, 0x56, 0xcf, 0x96, 0xf9, 0x4a, 0xde, 0x96, 0x14, 0xbc, 0xd9, 0xd8, 0xde, 0x35, 0x90,
-	0xa0, 0x36, 0x80, 0x6c, 0x63, 0x7b, 0x37, 0xf5, 0x50, 0x04, 0x56, 0x2c, 0x6f, 0x28, 0x1d, 0x01,
-	0xbf, 0x17, 0xba, 0xed, 0xec, 0xa9, 0xba, 0x6d, 0xbd, 0x0d, 0x64, 0x87, 0x06, 0x52, 0xbc, 0xb4,
-	0x64, 0xf2, 0xf8, 0xa7, 0xb7, 0xe2, 0x6b, 0xb8, 0x10, 0xe3, 0xd7, 0x09, 0x5c, 0xcf, 0x1a, 0xd2,
-	0x65, 0x6c, 0x85, 0x1f, 0x64, 0xe6, 0x1e, 0x46, 0x0e, 0x6d, 0x3a, 0x1a, 0x08, 0x83, 0x72, 0x20,
-	0x55, 0xfc, 0x4a, 0xaa, 0xf8, 0x3b, 0x50, 0x4b, 0x13, 0x2f, 0xca, 0xa4, 0x7c, 0xd6, 0x52, 0x62,
-	0xcf, 0x5a, 0x1f, 0xc1, 0xf9, 0x0e, 0x75, 0x06, 0x69, 0x53, 0x6e, 0x5a, 0x4b, 0xe1, 0x61, 0x27,
-	0xd0, 0x75, 0x5f, 0x44, 0x29, 0x5e, 0x92, 0xc7, 0x0a, 0xa2, 0x32, 0x5f, 0x10, 0x53, 0x6a, 0x46,
-	0xe6, 0xf4, 0x35, 0x43, 0xf7, 0xe0, 0xdc, 0x82, 0x4c, 0x6e, 0xd0, 0x2a, 0x9b, 0xc5, 0xfa, 0x61,
-	0xe3, 0xa0, 0x1a, 0x12, 0x8c, 0x1e, 0x0d, 0x33, 0xf1, 0x47, 0xc3, 0x34, 0x43, 0x66, 0x53, 0x0d,
-	0x69, 0xa0, 0x21, 0x51, 0xe6, 0x83, 0xad, 0xbb, 0x6f, 0x39, 0x6a, 0x36, 0x3a, 0x6a, 0x0d, 0x8a,
-	0x28, 0x6a, 0xf7, 0xb1, 0x74, 0xcf, 0x10, 0xd6, 0xfd, 0xe8, 0x1c, 0x0f, 0xb6, 0xee, 0xf2, 0x0e,
-	0x96, 0x9f, 0x23, 0xfd, 0x89, 0xf3, 0x82, 0xe0, 0x65, 0xda, 0x03, 0xf9, 0xc8, 0xc5, 0x79, 0x0d,
-	0xfe, 0x8b, 0x83, 0x3c, 0x84, 0x8b, 0x31, 0xa1, 0xcf, 0x69, 0x60, 0xb1, 0x6b, 0x0f, 0x4f, 0x52,
-	0x83, 0xe2, 0x58, 0xe0, 0xe4, 0x1b, 0x9b, 0x84, 0xf5, 0x3b, 0x50, 0x8d, 0x6d, 0xdd, 0x7b, 0xe5,
-	0xb0, 0xae, 0x4f, 0xec, 0xdb, 0x80, 0x9c, 0xcb, 0x10, 0x52, 0x63, 0x04, 0xf4, 0x5f, 0x2b, 0x90,
-	0x6b, 0xbd, 0xa4, 0x4e, 0x40, 0x6e, 0xb2, 0x13, 0x4d, 0xec, 0xbe, 0x98, 0x14, 0x64, 0x1c, 0xe2,
-	0xe2, 0x66, 0x97, 0xad, 0x18, 0x9c, 0x20, 0x74, 0xca, 0x4c, 0xe4, 0x94, 0x61, 0xdf, 0x97, 0x8d,
-	0xf5, 0x7d, 0x77, 0x21, 0x87, 0xfb, 0xc8, 0x06, 0x68, 0xcd, 0xbd, 0x76, 0xd7, 0x68, 0x34, 0xbb,
-	0xa6, 0xd1, 0x6a, 0xb6, 0x76, 0xf7, 0xbb, 0xda, 0x19, 0x42, 0xa0, 0x12, 0x62, 0x5b, 0xdf, 0xb4,
-	0xda, 0x5d, 0x4d, 0xd1, 0xff, 0xa4, 0x80, 0xd6, 0x99, 0xf6, 0xfc, 0xbe, 0x67, 0xf7, 0x42, 0x9f,
-	0xb9, 0x0d, 0x79, 0x14, 0xcc, 0x86, 0x98, 0xec, 0x12, 0xd5, 0x04, 0x05, 0xf9, 0x04, 0xf2, 0x87,
-	0xf6, 0x28, 0xa0, 0x9e, 0xc8, 0xcb, 0xf2, 0xb1, 0x36, 0xc9, 0x74, 0xf3, 0x09, 0x52, 0x19, 0x82,
-	0xba, 0x76, 0x0b, 0xf2, 0x1c, 0xc3, 0xa6, 0x2c, 0xf9, 0xec, 0x6c, 0x86, 0xb1, 0x0f, 0x12, 0xb5,
-	0x3b, 0xd0, 0x1f, 0xc0, 0xd9, 0x18, 0x37, 0x61, 0x5d, 0x1d, 0x72, 0x94, 0xa9, 0x83, 0xf4, 0xd1,
-	0xcc, 0x84, 0x2a, 0x1a, 0x7c, 0x69, 0xeb, 0xef, 0x6b, 0x00, 0x8d, 0x89, 0xdd, 0xa1, 0xde, 0x4b,
-	0xbb, 0x4f, 0xc9, 0xd7, 0x50, 0xda, 0xa1, 0x81, 0x7c, 0xc7, 0x27, 0x32, 0xb1, 0xc6, 0xff, 0x32,
-	0xa9, 0x9d, 0x97, 0xaf, 0xee, 0x89, 0xd7, 0x7e, 0x7d, 0xe3, 0x97, 0xff, 0xf8, 0xf7, 0x0f, 0x99,
-	0x0a, 0x29, 0xd7, 0x87, 0x31, 0x1e, 0x5d, 0x28, 0xb3, 0x64, 0x22, 0xc7, 0xad, 0x74, 0x9e, 0xf2,
-	0x45, 0x78, 0x61, 0x2a, 0xd3, 0xdf, 0x41, 0xa6, 0x6b, 0x64, 0x95, 0x31, 0x8d, 0xb8, 0xb4, 0x01,
-	0x76, 0x68, 0x20, 0xdb, 0x99, 0x54, 0x9e, 0xb2, 0xf7, 0x4d, 0xfc, 0x85, 0xa2, 0xaf, 0x23, 0xc7,
-	0x55, 0x52, 0x62, 0x1c, 0x25, 0x87, 0xff, 0xc7, 0x83, 0x77, 0x67, 0x7c, 0xc2, 0x21, 0x1b, 0xe1,
-	0x7b, 0x5e, 0x6c, 0xe0, 0xa9, 0xd5, 0x96, 0x3f, 0xd0, 0xe9, 0x17, 0x91, 0xeb, 0x3b, 0x64, 0x9d,
-	0x71, 0x95, 0x7c, 0xea, 0x27, 0x2c, 0xdd, 0xbd, 0x26, 0x03, 0xd8, 0x40, 0xee, 0xe2, 0x41, 0x61,
-	0xfb, 0x98, 0xf3, 0x5d, 0x22, 0x66, 0xe1, 0x31, 0x51, 0xbf, 0x8e, 0xcc, 0xaf, 0x90, 0x4b, 0x9c,
-	0x79, 0x82, 0x8d, 0x94, 0xe2, 0x42, 0x65, 0x7e, 0x50, 0x23, 0x97, 0x04, 0xa7, 0xd4, 0xf9, 0xad,
-	0xb6, 0x91, 0x36, 0x78, 0xeb, 0xb7, 0x50, 0xd6, 0x7b, 0xe4, 0x1a, 0x93, 0x15, 0xdb, 0x25, 0xa4,
-	0xd4, 0x4f, 0xe4, 0x00, 0xf6, 0x9a, 0xbc, 0x02, 0x2d, 0x39, 0xd0, 0x91, 0x2b, 0x0b, 0x22, 0xe7,
-	0x26, 0xbd, 0x25, 0x42, 0x3f, 0x42, 0xa1, 0x37, 0xc8, 0xfb, 0x31, 0xa1, 0x7c, 0x5f, 0xfd, 0x84,
-	0x8f, 0x82, 0x73, 0x82, 0x29, 0xde, 0xbe, 0x9c, 0xab, 0xaa, 0x91, 0xc8, 0xf9, 0x5e, 0xa6, 0x56,
-	0x99, 0xef, 0x80, 0xe6, 0xc5, 0x08, 0x64, 0xfd, 0x84, 0x75, 0x03, 0xaf, 0xeb, 0x27, 0xc9, 0x54,
-	0xf8, 0x9a, 0x7c, 0xaf, 0xc0, 0x5a, 0xa2, 0x66, 0x90, 0xcb, 0x91, 0xb0, 0x94, 0x5a, 0x52, 0xbb,
-	0xb2, 0x6c, 0x59, 0x1c, 0xf4, 0x0b, 0xd4, 0xe0, 0x01, 0xb9, 0x8f, 0x37, 0x19, 0xa3, 0xa8, 0x9f,
-	0x88, 0xa2, 0xf3, 0xba, 0x7e, 0x82, 0xf9, 0x39, 0x55, 0xa3, 0xdf, 0x29, 0xd8, 0x69, 0x24, 0x2a,
-	0xca, 0xdb, 0x94, 0xba, 0x96, 0x58, 0x5e, 0xac, 0x45, 0xfa, 0x97, 0xa8, 0xd7, 0x23, 0xf2, 0x69,
-	0xa8, 0x57, 0x44, 0x74, 0x3a, 0xd5, 0xfe, 0xa8, 0xc0, 0x7a, 0x4a, 0x8d, 0x58, 0xd0, 0x6d, 0xbe,
-	0x68, 0xd5, 0xf4, 0xc5, 0xe5, 0x64, 0x79, 0xd1, 0xb7, 0x51, 0xb9, 0xcf, 0xc9, 0xa3, 0xb8, 0x72,
-	0x92, 0x2a, 0xd2, 0x49, 0x96, 0xb9, 0x54, 0xf5, 0x7e, 0x50, 0xd0, 0x59, 0xe7, 0xea, 0xd0, 0xdb,
-	0x74, 0x7b, 0x77, 0x71, 0x79, 0xae, 0x7e, 0xe9, 0xff, 0x8b, 0x8a, 0x3d, 0x24, 0x0f, 0xe2, 0x8a,
-	0x21, 0xc9, 0x29, 0xb5, 0xe2, 0xf9, 0x36, 0x7c, 0x57, 0x78, 0x63, 0xbe, 0x4d, 0xbe, 0x57, 0xcc,
-	0xe7, 0xdb, 0x90, 0xc7, 0x10, 0x59, 0x86, 0x3d, 0xf8, 0x85, 0xe8, 0x0c, 0x89, 0xfe, 0xb4, 0xb6,
-	0x96, 0x68, 0x9b, 0xf5, 0x0f, 0x91, 0xe1, 0x07, 0xe4, 0x3a, 0xe6, 0x5a, 0x81, 0xad, 0x9f, 0x2c,
-	0xd1, 0xfd, 0x78, 0xae, 0xe9, 0x15, 0x5d, 0x22, 0xb9, 0xba, 0x28, 0x6f, 0xbe, 0x7f, 0x8d, 0x7b,
-	0xe3, 0x92, 0x16, 0x53, 0xbf, 0x82, 0x8a, 0x54, 0xf5, 0xf5, 0xb8, 0x22, 0x82, 0xe8, 0x91, 0x72,
-	0x9b, 0xfc, 0x04, 0xd6, 0x12, 0xed, 0x66, 0x78, 0xce, 0xc5, 0xf7, 0xfe, 0x30, 0x26, 0x97, 0x74,
-	0xa8, 0x3a, 0x41, 0x69, 0x65, 0xbd, 0x50, 0xf7, 0x19, 0xc5, 0x8c, 0x49, 0x30, 0x60, 0xad, 0x35,
-	0xa3, 0xfd, 0x53, 0x4a, 0x58, 0xcc, 0xd8, 0x11, 0x4f, 0xca, 0xd8, 0x20, 0xcf, 0x6f, 0x41, 0x0d,
-	0x8b, 0x34, 0x39, 0xbf, 0xa4, 0x09, 0x08, 0x4b, 0xe1, 0x42, 0x3d, 0x97, 0xa5, 0x50, 0x87, 0xba,
-	0x2f, 0xd7, 0x1e, 0x29, 0xb7, 0xef, 0x28, 0xbd, 0x3c, 0x3e, 0x42, 0xde, 0xfb, 0x4f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xee, 0xdf, 0x34, 0xdf, 0x14, 0x21, 0x00, 0x00,
+	// 4704 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x3a, 0x4d, 0x6f, 0x1b, 0x49,
+	0x76, 0x6e, 0x52, 0xa4, 0xc8, 0xc7, 0x0f, 0xd1, 0x65, 0x8d, 0x44, 0xb7, 0xfc, 0xd9, 0x33, 0xde,
+	0xf1, 0x18, 0x33, 0xe2, 0x58, 0xf3, 0xfd, 0xb9, 0xab, 0x0f, 0x0e, 0xad, 0xd8, 0xa6, 0xb4, 0x2d,
+	0x7a, 0x3c, 0x13, 0x04, 0xe8, 0x34, 0xc9, 0x52, 0xab, 0x63, 0xb2, 0x9b, 0xdb, 0xdd, 0xb4, 0xa9,
+	0x18, 0x5e, 0x20, 0x7b, 0x48, 0x90, 0x5c, 0x82, 0x60, 0x80, 0x20, 0x87, 0x9c, 0xf6, 0x90, 0x04,
+	0x41, 0x8e, 0x41, 0x3e, 0x10, 0x04, 0xc9, 0x29, 0xa7, 0x3d, 0x25, 0xf9, 0x09, 0xc9, 0x25, 0xd7,
+	0xbd, 0xec, 0x31, 0xa8, 0x57, 0x55, 0xfd, 0xc5, 0xa6, 0xa4, 0x9d, 0xe4, 0x44, 0xd6, 0xab, 0x57,
+	0xef, 0x55, 0xbd, 0x7a, 0xef, 0xd5, 0xfb, 0x68, 0x68, 0x78, 0x93, 0x41, 0x6b, 0xd2, 0x6f, 0x79,
+	0x93, 0xc1, 0xe6, 0xc4, 0x73, 0x03, 0x97, 0x14, 0xbc, 0xc9, 0x60, 0xd2, 0x57, 0xaf, 0x59, 0xae,
+	0x6b, 0x8d, 0x68, 0xcb, 0x9c, 0xd8, 0x2d, 0xd3, 0x71, 0xdc, 0xc0, 0x0c, 0x6c, 0xd7, 0xf1, 0x39,
+	0x92, 0x56, 0x87, 0x6a, 0x7b, 0x3c, 0x09, 0x4e, 0x75, 0xfa, 0x93, 0x29, 0xf5, 0x03, 0x6d, 0x13,
+	0x4a, 0x87, 0x94, 0x7a, 0xfb, 0xce, 0xb1, 0x4b, 0xea, 0x90, 0xb3, 0x87, 0x4d, 0xe5, 0x96, 0x72,
+	0xb7, 0xac, 0xe7, 0xec, 0x21, 0x21, 0xb0, 0x64, 0x0e, 0x87, 0x5e, 0x33, 0x87, 0x10, 0xfc, 0xaf,
+	0xfd, 0x0e, 0x54, 0xba, 0x34, 0x78, 0xe1, 0x7a, 0xcf, 0x32, 0x97, 0x5c, 0x07, 0x98, 0x50, 0xea,
+	0x19, 0x03, 0x77, 0xea, 0x04, 0xb8, 0xb0, 0xa0, 0x97, 0x19, 0x64, 0x97, 0x01, 0xc8, 0xdb, 0x80,
+	0x03, 0xc3, 0x76, 0x8e, 0xdd, 0x66, 0xfe, 0x56, 0xfe, 0x6e, 0x65, 0x6b, 0x65, 0x13, 0xb7, 0xbd,
+	0x29, 0x77, 0xa1, 0x97, 0x26, 0xe2, 0x9f, 0xf6, 0xd7, 0x0a, 0xac, 0xe8, 0xdb, 0x8f, 0x11, 0x4a,
+	0xfd, 0x89, 0xeb, 0xf8, 0x94, 0x5c, 0x85, 0xd2, 0xd4, 0xa7, 0x43, 0xc3, 0x33, 0xc7, 0xc8, 0x36,
+	0xaf, 0x2f, 0xb3, 0xb1, 0x6e, 0x8e, 0xc9, 0xeb, 0x50, 0x33, 0x9f, 0x9b, 0xf6, 0xc8, 0xec, 0x8f,
+	0x28, 0xce, 0xe7, 0x70, 0xbe, 0x1a, 0x02, 0x19, 0xd2, 0x06, 0x94, 0x03, 0x37, 0x30, 0x47, 0x88,
+	0x90, 0x47, 0x84, 0x12, 0x02, 0xd8, 0xe4, 0x75, 0x00, 0x9f, 0x8e, 0x46, 0xc6, 0xc4, 0xb3, 0x07,
+	0xb4, 0xb9, 0x74, 0x4b, 0xb9, 0xab, 0xe8, 0x65, 0x06, 0x39, 0x64, 0x00, 0xb6, 0xb6, 0x3f, 0x3d,
+	0x15, 0xb3, 0x05, 0x9c, 0x2d, 0xf5, 0xa7, 0xa7, 0x38, 0xa9, 0xfd, 0x5c, 0x81, 0x46, 0xd7, 0x1d,
+	0xd2, 0xc4, 0x6e, 0xaf, 0x03, 0xf4, 0xa7, 0xf6, 0x68, 0x68, 0x04, 0xf6, 0x98, 0x0a, 0x31, 0x95,
+	0x11, 0xd2, 0xb3, 0xc7, 0x78, 0x18, 0xcb, 0x0e, 0x8c, 0x13, 0xd3, 0x3f, 0x11, 0x42, 0x5e, 0xb6,
+	0xec, 0xe0, 0x81, 0xe9, 0x9f, 0x30, 0xd9, 0x8f, 0xdd, 0x21, 0xc5, 0x2d, 0x96, 0x75, 0xfc, 0x4f,
+	0xde, 0x86, 0x65, 0x87, 0xcb, 0x1e, 0xf7, 0x56, 0xd9, 0x22, 0x42, 0x76, 0xb1, 0x1b, 0xd1, 0x25,
+	0x0a, 0xa3, 0x80, 0x5c, 0x0b, 0x78, 0x48, 0xfc, 0xaf, 0x7d, 0x02, 0x95, 0xed, 0x31, 0xbb, 0x89,
+	0x47, 0xf6, 0xd8, 0x0e, 0xc8, 0x2a, 0x14, 0x02, 0xf7, 0x19, 0x75, 0xc4, 0xce, 0xf8, 0x80, 0x41,
+	0x9f, 0x9b, 0xa3, 0x29, 0x15, 0x5b, 0xe2, 0x03, 0xed, 0x5b, 0x28, 0x6e, 0x0f, 0x98, 0x26, 0x11,
+	0x15, 0x4a, 0x03, 0xd7, 0x09, 0x3c, 0x73, 0x10, 0x88, 0x85, 0xe1, 0x98, 0xdc, 0x84, 0x8a, 0x89,
+	0x58, 0x86, 0x63, 0x8e, 0x25, 0x05, 0xe0, 0xa0, 0xae, 0x39, 0xa6, 0x6c, 0x57, 0x43, 0x33, 0x30,
+	0xe5, 0xb9, 0xd8, 0x7f, 0xed, 0xdf, 0x8b, 0x50, 0xee, 0xcd, 0x74, 0x3a, 0xa0, 0xf6, 0x24, 0x20,
+	0xeb, 0xb0, 0x1c, 0xcc, 0xb8, 0x4c, 0x38, 0xf5, 0x62, 0x30, 0x43, 0x91, 0x6c, 0x40, 0xd9, 0x32,
+	0x7d, 0x63, 0xea, 0x9b, 0x16, 0xa7, 0xac, 0xe8, 0x25, 0xcb, 0xf4, 0x9f, 0xb0, 0x31, 0xf9, 0x0c,
+	0xca, 0x9e, 0x39, 0x16, 0x93, 0x5c, 0xb3, 0x6e, 0x08, 0xe9, 0x84, 0xa4, 0x37, 0x75, 0x73, 0x8c,
+	0xd8, 0x6d, 0x27, 0xf0, 0x4e, 0xf5, 0x92, 0x27, 0x86, 0xe4, 0x73, 0xa8, 0xf8, 0x81, 0x19, 0x4c,
+	0x7d, 0x63, 0xc0, 0x64, 0xce, 0x84, 0x5b, 0xdf, 0xda, 0x98, 0x5b, 0x7e, 0x84, 0x38, 0xbb, 0xee,
+	0x90, 0xea, 0xe0, 0x87, 0xff, 0x49, 0x13, 0x96, 0xc7, 0xd4, 0x47, 0xc6, 0x05, 0x7e, 0x89, 0x62,
+	0xc8, 0x66, 0x3c, 0x1a, 0x4c, 0x3d, 0xc7, 0x6f, 0x16, 0x6f, 0xe5, 0xd9, 0x8c, 0x18, 0x92, 0xf7,
+	0xa1, 0xe4, 0x71, 0xaa, 0x7e, 0x73, 0x19, 0x77, 0xdb, 0x9c, 0xdf, 0x2d, 0xff, 0xd5, 0x43, 0x4c,
+	0xd2, 0x81, 0x15, 0x21, 0xdd, 0x70, 0x71, 0x69, 0xc1, 0x51, 0xf9, 0x5d, 0x49, 0x12, 0x75, 0x33,
+	0x3e, 0xf4, 0xd5, 0xcf, 0xa0, 0x96, 0x90, 0x05, 0x69, 0x40, 0xfe, 0x19, 0x3d, 0x15, 0x02, 0x67,
+	0x7f, 0x93, 0x5a, 0x90, 0x17, 0x5a, 0xf0, 0x69, 0xee, 0x63, 0x45, 0xfd, 0x11, 0x2c, 0xcb, 0xbb,
+	0xda, 0x80, 0xf2, 0xf1, 0xd4, 0x19, 0xf0, 0xcb, 0x16, 0xba, 0xc0, 0x00, 0x78, 0xd5, 0x4d, 0x58,
+	0x66, 0x7a, 0x41, 0x85, 0x23, 0x28, 0xeb, 0x72, 0xa8, 0xfe, 0xa5, 0x02, 0xb5, 0xc4, 0x06, 0xd3,
+	0x37, 0xa0, 0x7c, 0xef, 0x1b, 0xc8, 0x25, 0x6f, 0xe0, 0x36, 0x54, 0xb9, 0xc8, 0x0d, 0x7e, 0x18,
+	0xae, 0x76, 0x15, 0x0e, 0xfb, 0x9a, 0x81, 0x92, 0x6a, 0xb5, 0x94, 0x54, 0x2b, 0xed, 0xef, 0x15,
+	0x80, 0x88, 0x29, 0xa9, 0xc0, 0xf2, 0xd1, 0x93, 0xdd, 0xdd, 0xf6, 0xd1, 0x51, 0xe3, 0x12, 0x59,
+	0x81, 0x4a, 0x67, 0xfb, 0xc8, 0xd0, 0x9f, 0x74, 0x8d, 0x83, 0x27, 0xbd, 0x86, 0x42, 0xd6, 0x80,
+	0xec, 0x6c, 0x3f, 0xda, 0xee, 0xee, 0xb6, 0x8d, 0xee, 0x41, 0xcf, 0x68, 0x77, 0x0f, 0x9e, 0x74,
+	0x1e, 0x34, 0x72, 0xe4, 0x0a, 0xac, 0x3c, 0xd5, 0x0f, 0xba, 0x1d, 0xe3, 0x70, 0x5b, 0xdf, 0x7e,
+	0xdc, 0xee, 0xb5, 0xf5, 0x46, 0x9e, 0x5c, 0x86, 0x9a, 0xfe, 0xa4, 0xdb, 0xdb, 0x7f, 0xdc, 0x36,
+	0xda, 0xba, 0x7e, 0xa0, 0x37, 0x96, 0x18, 0x75, 0x36, 0x66, 0xc4, 0x0a, 0xd1, 0xa2, 0xde, 0x37,
+	0xc6, 0x57, 0x07, 0xfa, 0xe3, 0xed, 0x5e, 0xa3, 0xc8, 0x38, 0xec, 0x3d, 0x39, 0x7c, 0xb4, 0xbf,
+	0xbb, 0xdd, 0x6b, 0x1b, 0x47, 0xed, 0x9e, 0xb1, 0x7b, 0xb0, 0xd7, 0x6e, 0x2c, 0x33, 0x62, 0x4f,
+	0xba, 0x0f, 0xbb, 0x07, 0x4f, 0xbb, 0x82, 0x58, 0x49, 0xfb, 0xef, 0x25, 0xa8, 0xf4, 0x3c, 0xd3,
+	0xf1, 0xf9, 0xd5, 0x33, 0xc3, 0x8b, 0xd9, 0x14, 0xfe, 0x0f, 0x5d, 0x44, 0x2e, 0x72, 0x11, 0xe4,
+	0x06, 0x00, 0x9d, 0x4d, 0x6c, 0x0f, 0x5f, 0x0d, 0xe1, 0x21, 0x63, 0x10, 0x29, 0x2e, 0x1c, 0xc5,
+	0xc4, 0xa5, 0xb3, 0xb1, 0x9c, 0x1c, 0x31, 0xef, 0x22, 0x3d, 0xa4, 0x65, 0xfa, 0xa1, 0xb7, 0x19,
+	0xd2, 0x91, 0x79, 0xda, 0x2c, 0x72, 0x8d, 0xc2, 0x01, 0x79, 0x13, 0x96, 0xf9, 0x0e, 0xa5, 0x21,
+	0xd4, 0xc4, 0xad, 0x0b, 0x05, 0x91, 0xb3, 0xec, 0x92, 0x7d, 0xdb, 0x72, 0xa8, 0xc7, 0x95, 0xbe,
+	0xac, 0xcb, 0x21, 0xb9, 0x06, 0xe5, 0xc9, 0xb4, 0x3f, 0xb2, 0xfd, 0x13, 0xea, 0x35, 0xcb, 0xdc,
+	0xc9, 0x86, 0x00, 0xe6, 0x92, 0x3c, 0x7a, 0x4c, 0x3d, 0x8f, 0x0e, 0x8d, 0x60, 0xd6, 0x04, 0x9c,
+	0x07, 0x09, 0xea, 0xcd, 0xc8, 0x07, 0x50, 0x35, 0xd1, 0x29, 0x8a, 0x7d, 0x57, 0x6e, 0xe5, 0x63,
+	0xbe, 0x35, 0xe6, 0x2f, 0xf5, 0x8a, 0x19, 0x0d, 0x48, 0x0b, 0x20, 0x98, 0x49, 0x43, 0x6c, 0x56,
+	0xd1, 0x21, 0x37, 0xd2, 0x1a, 0xab, 0x97, 0x03, 0xf9, 0x97, 0xf9, 0x4d, 0x9f, 0xbd, 0xba, 0xce,
+	0x80, 0x36, 0x6b, 0xfc, 0xe5, 0x91, 0x63, 0x3c, 0x1c, 0x7b, 0x32, 0x5c, 0xaf, 0x59, 0xe7, 0x1a,
+	0x2c, 0x86, 0xe4, 0x1e, 0x5c, 0x16, 0x7f, 0x8d, 0x48, 0xb4, 0x2b, 0x28, 0xda, 0x15, 0x31, 0xd1,
+	0x91, 0x12, 0x8e, 0xe1, 0x32, 0x67, 0xc8, 0x71, 0x1b, 0xc8, 0x4a, 0xe2, 0xea, 0xe6, 0x98, 0xe3,
+	0xde, 0x81, 0xba, 0x47, 0x07, 0x53, 0xf6, 0x16, 0x07, 0xd4, 0x7b, 0x6e, 0x8e, 0x9a, 0x97, 0x11,
+	0xb1, 0x86, 0xd0, 0x7d, 0x01, 0xe4, 0xd2, 0x1b, 0x4c, 0xe5, 0x8b, 0x4e, 0xb8, 0x3e, 0x20, 0x08,
+	0x9f, 0x74, 0xed, 0x9f, 0x14, 0xb8, 0x12, 0xd3, 0xb3, 0xf0, 0xe9, 0xfb, 0x04, 0x8a, 0xdc, 0x42,
+	0x85, 0x31, 0xdf, 0x96, 0xa2, 0x99, 0xc7, 0x15, 0x66, 0xad, 0x8b, 0x05, 0xe4, 0x7d, 0xa8, 0x04,
+	0x11, 0x16, 0x6a, 0x67, 0x74, 0x1f, 0xf1, 0xf5, 0x71, 0x34, 0xed, 0x3d, 0x28, 0x72, 0x3a, 0xcc,
+	0x8e, 0x0e, 0xdb, 0xdd, 0xbd, 0xfd, 0xee, 0x5e, 0xe3, 0x12, 0x01, 0x28, 0x1e, 0x6e, 0xef, 0x3e,
+	0x6c, 0xef, 0x35, 0x14, 0xd2, 0x80, 0xea, 0xbe, 0xae, 0xb7, 0xbf, 0x6e, 0xeb, 0x47, 0xfb, 0x3b,
+	0x8f, 0xda, 0x8d, 0x9c, 0xf6, 0x0f, 0x0a, 0x94, 0x8f, 0x6c, 0xcb, 0x31, 0x83, 0xa9, 0x47, 0xc9,
+	0xc7, 0x50, 0x36, 0x47, 0x96, 0xeb, 0xd9, 0xc1, 0xc9, 0x58, 0x6c, 0x5b, 0x15, 0x6c, 0x43, 0xa4,
+	0xcd, 0x6d, 0x89, 0xa1, 0x47, 0xc8, 0x4c, 0x05, 0x7d, 0x89, 0x81, 0x1b, 0xae, 0xea, 0x11, 0x00,
+	0xa3, 0x22, 0xa6, 0x8f, 0x03, 0x83, 0x39, 0xd9, 0x3c, 0x9f, 0xe6, 0x90, 0x87, 0xf4, 0x54, 0x7b,
+	0x1f, 0xca, 0x21, 0x51, 0xb6, 0x79, 0x61, 0xca, 0x8d, 0x4b, 0xa4, 0x06, 0xe5, 0xa3, 0xf6, 0xee,
+	0xe1, 0xd6, 0x07, 0x1f, 0x3e, 0xbc, 0xdf, 0x50, 0xd8, 0x5c, 0x7b, 0x6f, 0xeb, 0x83, 0x0f, 0xee,
+	0x7f, 0xd2, 0xc8, 0x69, 0x7f, 0x51, 0x00, 0x92, 0x10, 0x26, 0x06, 0x74, 0xa1, 0x4d, 0x2b, 0x0b,
+	0x6d, 0x3a, 0x77, 0xb6, 0x4d, 0xe7, 0xcf, 0xb2, 0xe9, 0xa5, 0x45, 0x36, 0x5d, 0x58, 0x60, 0xd3,
+	0xc5, 0x33, 0x6d, 0x3a, 0x6d, 0x7a, 0xcb, 0x17, 0x33, 0xbd, 0xc5, 0xae, 0xe0, 0x5d, 0x80, 0x50,
+	0xec, 0x7e, 0xb3, 0x7c, 0x2b, 0x1f, 0x33, 0xca, 0xf0, 0x0a, 0xf5, 0x18, 0x4e, 0xd2, 0x79, 0x40,
+	0xda, 0x79, 0x7c, 0x04, 0xf5, 0x70, 0x60, 0xf8, 0xb6, 0xe5, 0x37, 0x2b, 0x0b, 0x68, 0xd6, 0x42,
+	0xbc, 0x23, 0xdb, 0xf2, 0x13, 0xc6, 0x5e, 0x5d, 0x6c, 0xec, 0xb5, 0x0b, 0x18, 0x7b, 0xfd, 0xd7,
+	0x30, 0xf6, 0x95, 0x6c, 0x63, 0x7f, 0x0f, 0xaa, 0x12, 0x17, 0x0f, 0xd1, 0x58, 0x70, 0x88, 0x8a,
+	0xc0, 0xc2, 0x23, 0xfc, 0x7f, 0x79, 0x88, 0x9f, 0x2d, 0x41, 0x61, 0x67, 0xe4, 0x0e, 0x9e, 0x65,
+	0xbe, 0x41, 0x4d, 0x58, 0x7e, 0x4e, 0x3d, 0x3f, 0x52, 0x4c, 0x39, 0x64, 0x84, 0x27, 0xa6, 0x47,
+	0x1d, 0x11, 0x20, 0xf3, 0xa7, 0x1b, 0x38, 0x08, 0x03, 0xc2, 0x37, 0xa0, 0x1e, 0xcc, 0x8c, 0x31,
+	0xf5, 0x9e, 0x8d, 0x28, 0xc7, 0x59, 0x42, 0x9c, 0x6a, 0x30, 0x7b, 0x8c, 0x40, 0xc4, 0x7a, 0x0f,
+	0xd6, 0x22, 0x3f, 0x9d, 0xc0, 0xe6, 0xd1, 0xda, 0x95, 0xd0, 0x43, 0xc7, 0x16, 0xad, 0x41, 0xd1,
+	0x99, 0x8e, 0xfb, 0xd4, 0x13, 0x8f, 0x95, 0x18, 0xb1, 0xdd, 0xbe, 0xb0, 0x03, 0x87, 0xfa, 0xec,
+	0xb5, 0xc2, 0xab, 0x13, 0xc3, 0xd0, 0xee, 0x4a, 0x31, 0xbb, 0x4b, 0x84, 0x16, 0xe5, 0x54, 0xc4,
+	0x7a, 0x15, 0x4a, 0xc1, 0x4c, 0x08, 0x0d, 0xf8, 0xc9, 0x83, 0x19, 0x4f, 0x93, 0xee, 0xc0, 0x12,
+	0x66, 0x48, 0x15, 0xf4, 0x7c, 0x97, 0xc5, 0x35, 0xa1, 0x0c, 0x37, 0x31, 0xc8, 0xc7, 0x69, 0xf2,
+	0x21, 0x54, 0x63, 0x0e, 0xd0, 0x6f, 0x56, 0x13, 0xd6, 0x13, 0xf7, 0x0d, 0x09, 0x3c, 0x4c, 0x73,
+	0x02, 0x33, 0xa0, 0x86, 0xe7, 0xba, 0x81, 0x50, 0xc1, 0x32, 0x42, 0x74, 0xd7, 0x0d, 0xd4, 0x23,
+	0x58, 0x62, 0x4c, 0xc2, 0x14, 0x44, 0xc1, 0x2c, 0x0e, 0xff, 0x33, 0xb9, 0x04, 0x27, 0x1e, 0x35,
+	0x87, 0x22, 0xb7, 0x13, 0x23, 0x76, 0x57, 0x7d, 0x33, 0x18, 0x9c, 0x18, 0xb6, 0x33, 0xa4, 0x33,
+	0x0c, 0xc0, 0x0b, 0x3a, 0x20, 0x68, 0x9f, 0x41, 0xb4, 0x3f, 0x51, 0xa0, 0x86, 0x07, 0x08, 0x1f,
+	0x88, 0xf7, 0x52, 0x0f, 0xc4, 0x46, 0xfc, 0x98, 0x8b, 0x9e, 0x06, 0x0d, 0x0a, 0x7d, 0x36, 0x2f,
+	0x1e, 0x85, 0x6a, 0x62, 0x0d, 0x9f, 0xd2, 0xde, 0xcc, 0x7e, 0x08, 0xd2, 0xce, 0x5f, 0xd1, 0xfe,
+	0x54, 0x81, 0x2b, 0xfc, 0xce, 0x0f, 0x3d, 0xd7, 0x3d, 0x0e, 0x77, 0xb6, 0x01, 0xe5, 0x11, 0x35,
+	0x8f, 0xe3, 0x39, 0x48, 0x89, 0x01, 0x50, 0x33, 0x6e, 0x42, 0x45, 0xe8, 0xd0, 0xc4, 0x0c, 0x58,
+	0xda, 0xc6, 0xfc, 0x0f, 0x70, 0xd0, 0xa1, 0x19, 0x9c, 0x44, 0x5b, 0xcc, 0x2f, 0xdc, 0x22, 0xe6,
+	0x85, 0xec, 0x8f, 0x71, 0xc2, 0x44, 0xb9, 0x24, 0xf2, 0x42, 0x06, 0x79, 0x40, 0xcd, 0xa1, 0xf6,
+	0xfb, 0x39, 0xb8, 0xbc, 0x7b, 0x62, 0xda, 0x4e, 0x3a, 0xf5, 0x75, 0x68, 0x10, 0x8f, 0xb5, 0x59,
+	0xae, 0x87, 0xa1, 0xf6, 0x5b, 0xd0, 0xc0, 0xf4, 0x7e, 0xe0, 0x8e, 0x8c, 0xb8, 0x35, 0x95, 0xf5,
+	0x15, 0x09, 0xff, 0x9a, 0x83, 0x19, 0x6b, 0xc6, 0xd4, 0x88, 0xf6, 0x98, 0xd7, 0xcb, 0x0c, 0xc2,
+	0x4d, 0xf4, 0x07, 0xb0, 0x12, 0x4d, 0xc7, 0x8d, 0xaa, 0x16, 0xe2, 0xc8, 0x64, 0x6c, 0x64, 0xf7,
+	0x05, 0x15, 0xee, 0xfc, 0x4b, 0x23, 0xbb, 0xcf, 0x89, 0xbc, 0x01, 0xf5, 0x70, 0x92, 0xd3, 0x28,
+	0x72, 0xc3, 0x94, 0x18, 0x48, 0xe2, 0x36, 0x54, 0x85, 0xf1, 0x18, 0x23, 0xdb, 0xe7, 0xce, 0xbf,
+	0xac, 0x57, 0x04, 0xec, 0x91, 0xed, 0x07, 0xda, 0xeb, 0x50, 0xeb, 0x61, 0xf2, 0x17, 0x7b, 0xdd,
+	0xd2, 0x1e, 0x44, 0xfb, 0x85, 0x02, 0x8d, 0xde, 0x4c, 0x28, 0x8a, 0x14, 0xd6, 0x87, 0x29, 0xed,
+	0x8a, 0x32, 0xa4, 0x24, 0x62, 0x5a, 0xc1, 0xd6, 0xa0, 0xe8, 0x51, 0xd3, 0x0f, 0xe5, 0x27, 0x46,
+	0xda, 0x49, 0x5c, 0xa9, 0xa2, 0x07, 0x3a, 0xd4, 0xb0, 0x4e, 0x43, 0x89, 0x85, 0x1a, 0xb9, 0x39,
+	0x6d, 0xcb, 0xe3, 0xe3, 0xfd, 0xcd, 0xe1, 0xbe, 0xde, 0xde, 0x6b, 0x2c, 0x91, 0x2a, 0x94, 0xf4,
+	0xf6, 0x6f, 0xb4, 0x77, 0x7b, 0xed, 0xbd, 0x46, 0x81, 0x4d, 0xed, 0xe9, 0x07, 0x87, 0x87, 0xed,
+	0xbd, 0x46, 0x51, 0xeb, 0xc0, 0x6b, 0x1d, 0x1a, 0xa0, 0x98, 0x76, 0x4e, 0xcf, 0x39, 0x3b, 0xcf,
+	0xc5, 0xc7, 0x93, 0x11, 0x0d, 0x78, 0xd8, 0x51, 0xd2, 0xc3, 0xb1, 0xf6, 0x18, 0xd6, 0x23, 0x42,
+	0x5d, 0xf4, 0x5f, 0x92, 0x54, 0xe4, 0xde, 0x94, 0x84, 0x7b, 0x3b, 0x8b, 0xdc, 0x8b, 0x88, 0x9c,
+	0xbf, 0x73, 0xaa, 0x9b, 0x8e, 0x45, 0x25, 0xb9, 0xdb, 0x50, 0xf5, 0x03, 0xd3, 0x0b, 0x8c, 0x04,
+	0xd1, 0x0a, 0xc2, 0x38, 0x63, 0xa6, 0x76, 0xd4, 0x19, 0x4a, 0x04, 0xee, 0xe9, 0xcb, 0xd4, 0x19,
+	0x76, 0xe7, 0x19, 0xe7, 0x53, 0x8c, 0x27, 0xb0, 0xda, 0xa1, 0x41, 0x6f, 0xe6, 0xef, 0x9c, 0x0a,
+	0xdf, 0x70, 0xf6, 0x21, 0xa4, 0x9c, 0x72, 0x31, 0x39, 0xad, 0x41, 0xd1, 0x3d, 0x3e, 0xf6, 0x69,
+	0x20, 0x34, 0x5e, 0x8c, 0x58, 0xfc, 0x12, 0x05, 0x36, 0x79, 0x9d, 0x0f, 0xb4, 0x7f, 0x54, 0xe0,
+	0xb5, 0x14, 0xcb, 0xff, 0x8b, 0xd3, 0x8a, 0xac, 0x3d, 0xda, 0x96, 0xb0, 0x76, 0xb6, 0x37, 0xac,
+	0xc2, 0x04, 0xe6, 0x48, 0x6c, 0x8d, 0x0f, 0xe6, 0x9c, 0xfb, 0xd2, 0xc5, 0x9c, 0xbb, 0x66, 0xc2,
+	0xf5, 0x0e, 0x0d, 0xb6, 0x07, 0xf8, 0xb0, 0xc4, 0xd0, 0xfc, 0x98, 0x1a, 0xc5, 0x5c, 0x08, 0xfe,
+	0x8f, 0x89, 0x27, 0x97, 0x2d, 0x9e, 0x7c, 0x5c, 0x3c, 0xdf, 0xc0, 0x8d, 0x45, 0x2c, 0x42, 0xeb,
+	0x4b, 0x6e, 0x5e, 0xb9, 0xe0, 0xe6, 0xdf, 0x86, 0xb5, 0x0e, 0x0d, 0x8e, 0x06, 0x27, 0x74, 0x38,
+	0x1d, 0xb1, 0xe4, 0xec, 0xac, 0x5d, 0x6b, 0xff, 0xa3, 0xc0, 0xfa, 0x1c, 0xba, 0xd8, 0xc1, 0x01,
+	0xd4, 0x7c, 0x09, 0x37, 0x82, 0x99, 0xdc, 0xc2, 0x3d, 0xb1, 0x85, 0x05, 0xcb, 0x36, 0x63, 0x40,
+	0xbd, 0xea, 0xc7, 0x30, 0xd4, 0x9f, 0x42, 0x25, 0x36, 0x99, 0xce, 0x51, 0x94, 0x0b, 0xe5, 0x28,
+	0xcc, 0x6b, 0x3a, 0x74, 0x16, 0x18, 0xb1, 0xac, 0xbb, 0xc4, 0x00, 0x58, 0x0d, 0xbc, 0x06, 0x65,
+	0x8f, 0x8e, 0x4d, 0xdb, 0xb1, 0x1d, 0x4b, 0x3a, 0xe6, 0x10, 0xa0, 0x7d, 0x06, 0xb5, 0xaf, 0x3c,
+	0xf7, 0x77, 0xa9, 0xb3, 0x63, 0x8e, 0x4c, 0x16, 0x45, 0xae, 0x41, 0x91, 0xc7, 0xc4, 0xc8, 0x5c,
+	0xd1, 0xc5, 0x28, 0x2b, 0xa9, 0xd7, 0x8e, 0xa1, 0xd1, 0x11, 0xf1, 0x7c, 0x28, 0xa1, 0xbb, 0xd0,
+	0x18, 0xb9, 0x2f, 0xa8, 0x1f, 0x18, 0x51, 0xec, 0xcf, 0x29, 0xd5, 0x39, 0x5c, 0xae, 0x60, 0x98,
+	0x63, 0x3a, 0xb4, 0x4d, 0x27, 0x86, 0xc9, 0xeb, 0x6f, 0x75, 0x0e, 0x97, 0x98, 0xda, 0xaf, 0x14,
+	0x54, 0x8d, 0x3d, 0x77, 0xda, 0x1f, 0x51, 0x16, 0x56, 0xb6, 0x9f, 0xdb, 0x43, 0x16, 0xf3, 0x46,
+	0x17, 0xf3, 0x08, 0xca, 0x54, 0x02, 0xc5, 0xa5, 0x6c, 0x46, 0x97, 0x72, 0xc6, 0xca, 0x4d, 0x09,
+	0xd1, 0x23, 0x02, 0xea, 0x1f, 0x28, 0x50, 0x92, 0xf0, 0x78, 0x70, 0xa6, 0x24, 0x83, 0xb3, 0xc8,
+	0x55, 0xe4, 0xd2, 0xae, 0xc2, 0x1f, 0xb9, 0x52, 0xbd, 0xf1, 0x3f, 0x73, 0x66, 0x91, 0xb5, 0x52,
+	0x6e, 0x78, 0x65, 0xbd, 0x12, 0xda, 0x2b, 0xf5, 0xc3, 0x22, 0x66, 0x21, 0x56, 0xc4, 0xbc, 0x0f,
+	0x57, 0x3b, 0x34, 0x78, 0xca, 0x19, 0x4a, 0x4d, 0x91, 0xda, 0xbb, 0x0a, 0x05, 0x46, 0xdb, 0x17,
+	0x9e, 0x8a, 0x0f, 0xb4, 0x9f, 0xe7, 0x41, 0xcd, 0x5a, 0x23, 0x24, 0x75, 0x1b, 0xaa, 0x83, 0xa9,
+	0x87, 0x01, 0x30, 0x6e, 0x52, 0x78, 0x55, 0x01, 0x3b, 0x62, 0x7b, 0xfd, 0x5c, 0xd2, 0xcd, 0xa1,
+	0x20, 0x7f, 0x10, 0x09, 0x72, 0x01, 0xd1, 0x4d, 0xb6, 0x4c, 0xf0, 0x67, 0x57, 0x31, 0xf1, 0xdc,
+	0xe1, 0x74, 0xc0, 0x12, 0xa9, 0x7c, 0xfa, 0x2a, 0x16, 0x51, 0x38, 0x14, 0x4b, 0x98, 0x93, 0xd3,
+	0x23, 0x02, 0xea, 0x03, 0x58, 0xc2, 0x3d, 0x49, 0x99, 0x2a, 0x31, 0x99, 0xc6, 0x6e, 0x26, 0x97,
+	0x1d, 0x36, 0xe7, 0x23, 0x6d, 0x55, 0xff, 0x48, 0x81, 0x6a, 0x9c, 0xcb, 0x19, 0x17, 0xab, 0x42,
+	0x49, 0xec, 0x60, 0x28, 0xed, 0x49, 0x8e, 0xd9, 0xa5, 0x8f, 0x6d, 0xdf, 0xa7, 0x43, 0xe9, 0xf3,
+	0xf9, 0x88, 0xbc, 0x0d, 0x64, 0x64, 0xfa, 0x81, 0x21, 0x11, 0xb9, 0x35, 0xf2, 0x07, 0xa0, 0xc1,
+	0x66, 0x04, 0x6f, 0xac, 0xd1, 0x6b, 0x7f, 0x57, 0x86, 0x65, 0xe1, 0xea, 0x32, 0x5d, 0x67, 0x13,
+	0x96, 0xfb, 0xdc, 0x22, 0x85, 0x4d, 0xc8, 0x21, 0xb9, 0x0f, 0x2c, 0xd8, 0x97, 0xbd, 0x0e, 0xe6,
+	0x1f, 0xd6, 0xc2, 0x34, 0x18, 0xe9, 0x6d, 0x76, 0x4c, 0x9f, 0xd7, 0xec, 0x2d, 0xfe, 0x87, 0x2d,
+	0x61, 0xb9, 0x1c, 0x2e, 0x59, 0xca, 0x5c, 0x22, 0xfb, 0x21, 0xcb, 0x9e, 0x39, 0xc6, 0x25, 0xdb,
+	0x50, 0x99, 0x50, 0x8f, 0x1d, 0x0d, 0x3d, 0x6d, 0x01, 0xaf, 0xf1, 0x66, 0x6a, 0xd5, 0x61, 0x84,
+	0xc1, 0x6b, 0xdf, 0xf1, 0x35, 0x64, 0x0b, 0x8a, 0x96, 0xe7, 0x4e, 0x27, 0x32, 0x5b, 0x57, 0xd3,
+	0xdb, 0xc4, 0x49, 0xbe, 0x50, 0x60, 0x92, 0x2f, 0x60, 0xe5, 0x18, 0xdd, 0x91, 0x21, 0x8e, 0x2b,
+	0xcb, 0x77, 0xab, 0x62, 0x71, 0xc2, 0x59, 0xe9, 0xf5, 0xe3, 0xf8, 0x30, 0x99, 0x1e, 0x97, 0x92,
+	0xe9, 0xb1, 0xfa, 0x25, 0xc0, 0xe1, 0x88, 0x0e, 0x2d, 0x6c, 0xa5, 0x30, 0xf9, 0x4e, 0x70, 0xe4,
+	0xc9, 0xbb, 0x17, 0xc3, 0x98, 0x03, 0xcc, 0xc5, 0x1d, 0xa0, 0xfa, 0x4b, 0x05, 0x96, 0x85, 0x64,
+	0x59, 0x4f, 0x48, 0xda, 0x10, 0x7f, 0x63, 0xb9, 0x87, 0x93, 0x86, 0xd5, 0x63, 0x30, 0x16, 0x3d,
+	0xa3, 0x93, 0x3e, 0xa6, 0x1e, 0xf6, 0x8e, 0x2c, 0xd3, 0x17, 0x24, 0x57, 0xe2, 0xf0, 0x8e, 0x89,
+	0x4f, 0x39, 0x67, 0x8f, 0x48, 0xbc, 0x54, 0x52, 0xe6, 0x10, 0x36, 0x7d, 0x07, 0xea, 0xb6, 0x33,
+	0x60, 0x21, 0x23, 0x35, 0xfc, 0x09, 0xa5, 0x43, 0x51, 0x30, 0xa9, 0x49, 0xe8, 0x11, 0x03, 0x46,
+	0xcf, 0x2a, 0x2f, 0x91, 0xf2, 0x01, 0xf9, 0x1c, 0xaa, 0x9c, 0xd2, 0x90, 0x2b, 0x00, 0xbf, 0x8c,
+	0xab, 0xe9, 0xab, 0x0c, 0x45, 0xa3, 0x57, 0x04, 0x3a, 0x1b, 0xa8, 0x6f, 0xc2, 0xb2, 0xd0, 0x0d,
+	0xf6, 0x90, 0x84, 0x3d, 0x2f, 0x61, 0x86, 0x11, 0x40, 0x75, 0x60, 0x69, 0x3f, 0xa0, 0xe3, 0xb9,
+	0xd6, 0xdd, 0x0d, 0xa8, 0xd8, 0x3e, 0x2b, 0x50, 0x19, 0x13, 0xd3, 0xf6, 0x44, 0xf8, 0x57, 0xb6,
+	0xfd, 0x87, 0xf4, 0xf4, 0xd0, 0xb4, 0x51, 0xdc, 0x2f, 0xa8, 0x6d, 0x9d, 0x84, 0x21, 0x14, 0x1f,
+	0xb1, 0xe2, 0x52, 0xa4, 0x4c, 0x22, 0x59, 0x88, 0x41, 0xd4, 0xaf, 0xa0, 0x80, 0x0a, 0x94, 0x69,
+	0x3d, 0x6f, 0x41, 0xc1, 0x0e, 0xe8, 0x58, 0x3a, 0xb0, 0x2b, 0xa9, 0xc3, 0xb2, 0x8d, 0xea, 0x1c,
+	0x43, 0xfd, 0x3d, 0x05, 0x20, 0xd2, 0xe3, 0x45, 0x61, 0x8c, 0x50, 0x64, 0x9e, 0x96, 0x89, 0x51,
+	0xc4, 0x25, 0x7f, 0x1e, 0x17, 0x26, 0x3b, 0x96, 0xd2, 0xfa, 0x27, 0xee, 0x68, 0x28, 0x7c, 0x42,
+	0x04, 0x50, 0xbf, 0x85, 0x46, 0xda, 0x94, 0x32, 0x5a, 0x27, 0xad, 0x78, 0xeb, 0x24, 0xe3, 0x06,
+	0x43, 0x0a, 0xf1, 0xae, 0xca, 0x01, 0x54, 0x62, 0x76, 0x96, 0x41, 0xf5, 0x5e, 0x92, 0xea, 0x6a,
+	0x96, 0x91, 0xc6, 0x08, 0x6a, 0xdf, 0x29, 0x70, 0x39, 0x0a, 0xd3, 0xce, 0x8a, 0xfe, 0xee, 0x42,
+	0xa3, 0x7f, 0x6a, 0x8c, 0x5c, 0xc7, 0x62, 0xd1, 0xc0, 0x80, 0x25, 0x9e, 0xe2, 0xfa, 0xeb, 0xfd,
+	0xd3, 0x47, 0x1c, 0x8c, 0xe9, 0x68, 0xf4, 0x36, 0x8a, 0xd7, 0x94, 0x6b, 0x02, 0x7f, 0x1b, 0xa3,
+	0x40, 0x7f, 0x2e, 0x77, 0x8c, 0x82, 0x5d, 0xed, 0x97, 0x0a, 0x94, 0x76, 0x65, 0xb7, 0x30, 0xa3,
+	0xe1, 0x8c, 0xed, 0x1f, 0x11, 0xb9, 0xb3, 0xff, 0xcc, 0x53, 0x8c, 0x4c, 0xc7, 0x9a, 0xf2, 0xbe,
+	0x1e, 0xcf, 0xc5, 0xc5, 0x38, 0x5e, 0x3b, 0xe2, 0x8c, 0xe4, 0x90, 0xbc, 0x09, 0x4b, 0x66, 0xdf,
+	0x96, 0xee, 0x50, 0x5e, 0xb8, 0x64, 0xbc, 0xb9, 0xbd, 0xb3, 0xaf, 0x23, 0x82, 0x3a, 0x84, 0xfc,
+	0xf6, 0xce, 0x7e, 0xa6, 0x58, 0x58, 0xfb, 0xdb, 0xb3, 0xa4, 0x2e, 0xe1, 0xff, 0xb9, 0x82, 0x65,
+	0xfe, 0x42, 0x05, 0x4b, 0xad, 0x0b, 0xa4, 0x43, 0x03, 0xc9, 0x5e, 0xde, 0x45, 0xfa, 0xf8, 0x17,
+	0xbe, 0x07, 0xed, 0x5f, 0x14, 0xb8, 0x1a, 0x23, 0x78, 0x14, 0xb8, 0x9e, 0x69, 0xd1, 0x45, 0x74,
+	0x85, 0x2e, 0xe5, 0x12, 0xcd, 0xbd, 0x63, 0x9b, 0x8e, 0x86, 0x42, 0xa2, 0x7c, 0x90, 0xc9, 0x7f,
+	0xe9, 0x42, 0x7a, 0x50, 0x38, 0x4f, 0x0f, 0x8a, 0x69, 0x3d, 0x78, 0x17, 0xd4, 0xac, 0x03, 0x88,
+	0xd0, 0x47, 0x06, 0x58, 0x4a, 0x2c, 0xc0, 0xfa, 0x85, 0x02, 0x84, 0x45, 0x03, 0xa9, 0x62, 0xcd,
+	0x39, 0x9d, 0x4b, 0xd9, 0xbf, 0x66, 0x50, 0x3a, 0x63, 0x65, 0x06, 0x9e, 0x5e, 0xf2, 0x01, 0xbe,
+	0x43, 0x76, 0x7f, 0x64, 0x3b, 0x96, 0x0c, 0xf4, 0xc2, 0x31, 0xab, 0xb7, 0x60, 0x19, 0x88, 0x91,
+	0x17, 0x8d, 0x5d, 0x36, 0x7e, 0x48, 0x4f, 0xd9, 0xe1, 0x70, 0x8a, 0xf3, 0x11, 0x87, 0x63, 0x10,
+	0xde, 0x52, 0x0c, 0x4b, 0x40, 0xcb, 0x8b, 0xab, 0x54, 0xef, 0xc0, 0xfa, 0x11, 0x75, 0x86, 0x59,
+	0xad, 0x93, 0xac, 0x22, 0x87, 0x87, 0xa9, 0x4e, 0xcf, 0x7d, 0x16, 0x3e, 0xa2, 0x21, 0x7a, 0x2c,
+	0x02, 0x51, 0x92, 0x11, 0x48, 0xc6, 0x23, 0x9d, 0xbb, 0xf8, 0x23, 0xad, 0xfd, 0xad, 0x02, 0x6b,
+	0x73, 0x4c, 0xb9, 0x8a, 0x35, 0x59, 0x85, 0x7f, 0x10, 0x66, 0x1f, 0x65, 0x5d, 0x0e, 0xa3, 0x6f,
+	0x0a, 0x72, 0xf1, 0x6f, 0x0a, 0xb2, 0x54, 0x2b, 0x7f, 0x21, 0xd5, 0x5a, 0x3a, 0x4f, 0xb5, 0x0a,
+	0x69, 0xd5, 0xd2, 0x41, 0x95, 0xbb, 0xfe, 0x68, 0xeb, 0xfe, 0x39, 0xd2, 0xca, 0x47, 0xd2, 0x52,
+	0xa1, 0x84, 0x9b, 0xdd, 0xdf, 0x93, 0x36, 0x1f, 0x8e, 0x35, 0x3f, 0x92, 0xc4, 0x47, 0x5b, 0xf7,
+	0x79, 0x59, 0x2e, 0x0c, 0xed, 0x33, 0xbe, 0xa1, 0xb8, 0x2a, 0x68, 0x19, 0xf6, 0x50, 0x46, 0xbc,
+	0x9c, 0xd6, 0xf0, 0xe2, 0xa2, 0xd0, 0x3e, 0x81, 0x8d, 0x18, 0xd3, 0xc7, 0x34, 0x30, 0x99, 0x21,
+	0x84, 0x27, 0x51, 0xa1, 0x34, 0x16, 0x30, 0x59, 0xa5, 0x94, 0x63, 0xed, 0x5d, 0x68, 0xc6, 0x96,
+	0x1e, 0xbc, 0x70, 0xa8, 0x17, 0xae, 0x5b, 0x85, 0x82, 0xcb, 0x00, 0x72, 0xc7, 0x38, 0xd0, 0xfe,
+	0x53, 0x81, 0x42, 0xfb, 0x39, 0x75, 0x02, 0x72, 0x97, 0x9d, 0x68, 0x62, 0x0f, 0x44, 0x89, 0x43,
+	0x3a, 0x37, 0x9c, 0xdc, 0xec, 0xb1, 0x19, 0x9d, 0x23, 0x84, 0x66, 0x9a, 0x8b, 0xcc, 0x34, 0x2b,
+	0xa0, 0xd7, 0x4e, 0xa1, 0x80, 0xeb, 0xc8, 0x2a, 0x34, 0x76, 0x0f, 0xba, 0x3d, 0x7d, 0x7b, 0xb7,
+	0x67, 0xe8, 0xed, 0xdd, 0xf6, 0xfe, 0x61, 0xaf, 0x71, 0x89, 0x10, 0xa8, 0x87, 0xd0, 0xf6, 0xd7,
+	0xed, 0x2e, 0xeb, 0xa5, 0xd7, 0xa0, 0xdc, 0x6d, 0x3f, 0x35, 0x76, 0x1e, 0x1d, 0xec, 0x3e, 0x6c,
+	0xe4, 0x58, 0xe3, 0x3b, 0x5e, 0x4e, 0x13, 0xf0, 0x3c, 0xeb, 0xc1, 0xef, 0x3e, 0xd8, 0xde, 0xef,
+	0x1a, 0x7a, 0xfb, 0x40, 0xef, 0x34, 0x96, 0x48, 0x1d, 0xa0, 0xf7, 0x8d, 0x21, 0xab, 0x69, 0x05,
+	0xed, 0x5f, 0x73, 0xd0, 0x38, 0x9a, 0xf6, 0xfd, 0x81, 0x67, 0xf7, 0x43, 0xed, 0xbd, 0x07, 0x45,
+	0x3c, 0x00, 0x4f, 0x40, 0xb3, 0x8f, 0x28, 0x30, 0x58, 0x21, 0xf1, 0xd8, 0x1e, 0x05, 0x22, 0x75,
+	0x8c, 0x3e, 0xb5, 0x48, 0x13, 0xdd, 0xfc, 0x0a, 0xb1, 0x74, 0x81, 0xcd, 0x94, 0xf4, 0xd8, 0x73,
+	0xc7, 0xc9, 0x3a, 0x2b, 0x83, 0xa0, 0x17, 0x50, 0xff, 0x4a, 0x81, 0x22, 0x5f, 0xc1, 0x2a, 0xca,
+	0xf2, 0xfb, 0x19, 0x23, 0xf4, 0xdb, 0x20, 0x41, 0xfb, 0xc3, 0xf3, 0x3f, 0xaa, 0x49, 0xf4, 0xb0,
+	0xf2, 0xe9, 0x1e, 0xd6, 0x97, 0x50, 0x8d, 0x7d, 0x5b, 0xc1, 0xfd, 0xdc, 0x39, 0x1f, 0x57, 0x54,
+	0xa2, 0x8f, 0x2b, 0x7c, 0xed, 0xc7, 0x70, 0x39, 0x76, 0x58, 0xa1, 0x44, 0x1a, 0x14, 0x28, 0x93,
+	0x56, 0x53, 0x49, 0xb8, 0x38, 0x94, 0xa0, 0xce, 0xa7, 0x98, 0xa9, 0x0d, 0x3d, 0x77, 0x32, 0x09,
+	0x73, 0x33, 0x39, 0xd4, 0xfe, 0x4d, 0xc1, 0x9a, 0xde, 0x21, 0x75, 0x86, 0xb6, 0x63, 0xc5, 0xca,
+	0x3c, 0x89, 0x93, 0x28, 0xe9, 0x93, 0xc4, 0xbf, 0x3c, 0xca, 0xa5, 0xbe, 0x3c, 0xd2, 0xa0, 0x36,
+	0xb6, 0xe3, 0x15, 0x0a, 0x1e, 0x9c, 0x57, 0xc6, 0x76, 0x58, 0x9e, 0x40, 0x1c, 0x73, 0x66, 0xa4,
+	0xbf, 0x5f, 0xa8, 0x8c, 0xcd, 0x59, 0x88, 0x13, 0x95, 0xc2, 0x0a, 0xd9, 0xa5, 0xb0, 0x62, 0xbc,
+	0x14, 0x46, 0xe1, 0xb5, 0xd4, 0x39, 0x22, 0x23, 0x8b, 0x12, 0x8e, 0x85, 0x45, 0xbd, 0xdc, 0x05,
+	0xeb, 0x62, 0x7f, 0xa3, 0x80, 0x1a, 0xe7, 0x83, 0x6d, 0xa2, 0x88, 0xd9, 0x03, 0x28, 0xa2, 0xf3,
+	0x95, 0x05, 0x95, 0x77, 0xa3, 0x2c, 0x7e, 0xc1, 0x92, 0xcd, 0x43, 0x29, 0x54, 0x84, 0xeb, 0x62,
+	0xbd, 0xba, 0x07, 0xf5, 0xe4, 0xcc, 0x39, 0x37, 0xb2, 0x0a, 0x85, 0xe8, 0x53, 0xbf, 0xbc, 0xce,
+	0x07, 0xda, 0x7d, 0x58, 0xd7, 0xe9, 0xd8, 0x7d, 0x4e, 0xe7, 0x2f, 0x78, 0x0d, 0x8a, 0xa2, 0xae,
+	0xa2, 0xf0, 0x10, 0x9d, 0x8f, 0xb4, 0x2d, 0x68, 0xce, 0x2f, 0x11, 0xc7, 0x5b, 0xb4, 0xe6, 0x1d,
+	0x58, 0x79, 0x6a, 0x7a, 0x13, 0x96, 0xa6, 0x4b, 0xf2, 0x2a, 0x94, 0x86, 0x53, 0xd1, 0xe7, 0xe6,
+	0x92, 0x0f, 0xc7, 0xda, 0x97, 0xd0, 0x88, 0xd0, 0x23, 0xd2, 0xe2, 0xb6, 0x95, 0xc4, 0x6d, 0x67,
+	0x14, 0xd1, 0xb6, 0x7e, 0xd5, 0x04, 0xd8, 0x9e, 0xd8, 0x47, 0xd4, 0x7b, 0x6e, 0x0f, 0x28, 0xf9,
+	0x31, 0x54, 0x3a, 0x34, 0x90, 0x9f, 0xfc, 0x11, 0x19, 0x63, 0xc6, 0xbf, 0xae, 0x54, 0xd7, 0x05,
+	0x30, 0xfd, 0x61, 0xa0, 0xb6, 0xfa, 0xb3, 0xff, 0xf8, 0xaf, 0xef, 0x72, 0x75, 0x52, 0x6d, 0x59,
+	0x31, 0x1a, 0x3d, 0xa8, 0x76, 0x28, 0x77, 0xfe, 0x8b, 0x69, 0xca, 0x0f, 0xc5, 0xe6, 0x1a, 0x44,
+	0xda, 0x6b, 0x48, 0x74, 0x85, 0xd4, 0x18, 0xd1, 0x88, 0x4a, 0x17, 0xa0, 0x43, 0x03, 0x99, 0x1c,
+	0x66, 0xd2, 0x94, 0x55, 0x86, 0xd4, 0xd7, 0x96, 0xda, 0x15, 0xa4, 0x58, 0x23, 0x15, 0x46, 0x51,
+	0x52, 0xf8, 0x2d, 0x3c, 0x78, 0x6f, 0xc6, 0xbb, 0x13, 0x64, 0x35, 0x74, 0x24, 0xb1, 0x66, 0x85,
+	0xaa, 0x2e, 0xfe, 0xdc, 0x43, 0xdb, 0x40, 0xaa, 0xaf, 0x91, 0x2b, 0x2d, 0x2b, 0xa2, 0xd3, 0x7a,
+	0xc9, 0x6e, 0xf5, 0x15, 0xf9, 0x56, 0x50, 0x17, 0xdd, 0x96, 0x6c, 0xea, 0xeb, 0x0b, 0xba, 0x39,
+	0x69, 0xd2, 0x7c, 0x56, 0x92, 0x1e, 0xc4, 0x1c, 0xd9, 0xf7, 0x65, 0x70, 0x1d, 0x19, 0xac, 0x6b,
+	0xa4, 0xe5, 0xa7, 0x49, 0x7d, 0xaa, 0xdc, 0x7b, 0x57, 0x21, 0x43, 0xd1, 0xad, 0x10, 0x5e, 0x75,
+	0xe7, 0x94, 0x13, 0x5e, 0xc0, 0x67, 0xee, 0x83, 0x21, 0xed, 0x0d, 0x64, 0x70, 0x83, 0x5c, 0xe3,
+	0x27, 0x48, 0x91, 0x91, 0x47, 0xf9, 0x4d, 0xbc, 0xd3, 0xde, 0x0c, 0x63, 0xe1, 0x73, 0xae, 0x20,
+	0xa3, 0xc5, 0xa9, 0xa9, 0xc8, 0x65, 0x95, 0x10, 0xce, 0x05, 0x27, 0x25, 0xed, 0x13, 0xcc, 0x1b,
+	0x43, 0xd6, 0xdf, 0x97, 0xc5, 0x6d, 0x64, 0xb1, 0x41, 0xae, 0x26, 0x0e, 0x92, 0xe0, 0xe4, 0x42,
+	0x3d, 0xd9, 0xea, 0x22, 0xd7, 0x22, 0xcf, 0x35, 0xdf, 0x01, 0x53, 0x57, 0xb3, 0xba, 0x2d, 0xda,
+	0x5b, 0xc8, 0xe8, 0x75, 0x72, 0x9b, 0x31, 0x8a, 0xad, 0x12, 0x5c, 0x5a, 0x2f, 0x65, 0x27, 0xe9,
+	0x15, 0x79, 0x01, 0x8d, 0x74, 0x4b, 0x8c, 0xdc, 0x98, 0x63, 0x99, 0xe8, 0x95, 0x2d, 0x60, 0xfa,
+	0x0e, 0x32, 0x7d, 0x93, 0xdc, 0x69, 0x59, 0xa9, 0x75, 0xad, 0x97, 0x3c, 0x72, 0x4d, 0x30, 0x3e,
+	0x81, 0x46, 0xba, 0x79, 0x36, 0xc7, 0x38, 0xd5, 0x55, 0x5b, 0xc0, 0xf8, 0x1a, 0x32, 0x5e, 0xd3,
+	0x2e, 0xb7, 0xac, 0xd4, 0x3a, 0xae, 0x7f, 0x14, 0x6a, 0x89, 0xd6, 0x15, 0xd9, 0x88, 0xd8, 0xcc,
+	0xf5, 0xd0, 0xd4, 0x6b, 0xd9, 0x93, 0x82, 0xd7, 0x55, 0xe4, 0x75, 0x45, 0xab, 0xb7, 0xac, 0xf8,
+	0xfc, 0xa7, 0xca, 0x3d, 0x42, 0x01, 0xa2, 0xe2, 0x02, 0x69, 0x46, 0x64, 0x92, 0xf5, 0x06, 0xb5,
+	0x9e, 0x2c, 0x53, 0x24, 0xe5, 0x26, 0x80, 0xad, 0x97, 0x2c, 0xae, 0x79, 0xd5, 0x7a, 0x99, 0x8e,
+	0x8b, 0x5f, 0x91, 0x3f, 0xe4, 0x29, 0x48, 0x46, 0xaf, 0x89, 0xbc, 0x31, 0xc7, 0x33, 0xa3, 0xdb,
+	0xa5, 0xde, 0x39, 0x07, 0x4b, 0x9c, 0x54, 0xc3, 0x6d, 0x5d, 0xd3, 0xd6, 0x5b, 0x56, 0x26, 0x22,
+	0x3b, 0xf2, 0x4f, 0x60, 0x25, 0xd5, 0x36, 0x22, 0xd7, 0x17, 0xb5, 0x93, 0x38, 0xf3, 0x1b, 0x67,
+	0x77, 0x9b, 0xb4, 0x9b, 0xc8, 0xf5, 0x2a, 0x41, 0xae, 0x71, 0x0c, 0x21, 0x11, 0xf2, 0xc7, 0x0a,
+	0xac, 0xc8, 0x38, 0x5e, 0xf6, 0x7d, 0x62, 0x3c, 0x33, 0x32, 0x33, 0xf5, 0xc6, 0xa2, 0x69, 0xc1,
+	0xf3, 0x0b, 0xe4, 0xf9, 0x11, 0xf9, 0xa0, 0x65, 0x25, 0x31, 0x5a, 0x2f, 0x45, 0x0a, 0xf7, 0xaa,
+	0xf5, 0x12, 0x73, 0x95, 0xcc, 0x0b, 0xf9, 0x33, 0x05, 0x4b, 0x19, 0xa9, 0xec, 0xea, 0xbc, 0x4d,
+	0xdd, 0x4e, 0x4d, 0xcf, 0xe7, 0x65, 0xda, 0x8f, 0x70, 0x5f, 0x9f, 0x92, 0x8f, 0x5b, 0xd6, 0x1c,
+	0xd2, 0xc5, 0xb6, 0xf6, 0xe7, 0x0a, 0x5c, 0xc9, 0xc8, 0x97, 0xe6, 0xf6, 0x96, 0x4c, 0xe0, 0x54,
+	0x6d, 0x7e, 0x3a, 0x9d, 0x6a, 0x69, 0x3b, 0xb8, 0xb9, 0xcf, 0xc9, 0xa7, 0x2d, 0x6b, 0x1e, 0x2b,
+	0xda, 0x93, 0x4c, 0xf9, 0x32, 0xb7, 0xf7, 0x9d, 0x82, 0x3e, 0x20, 0x91, 0x93, 0x9d, 0xb7, 0xb7,
+	0x9b, 0xf3, 0xd3, 0x89, 0x5c, 0x4e, 0xfb, 0x21, 0x6e, 0xec, 0x13, 0xf2, 0x51, 0xcb, 0x4a, 0xa1,
+	0x5c, 0x70, 0x57, 0x3c, 0x8a, 0x09, 0xa3, 0xdf, 0x33, 0xa3, 0x98, 0x74, 0x0b, 0x31, 0x19, 0xc5,
+	0x84, 0x34, 0xa6, 0xb0, 0x96, 0xdd, 0xc9, 0xcb, 0xa6, 0x7e, 0xe7, 0x42, 0xdd, 0xbf, 0xa4, 0xad,
+	0x64, 0x11, 0xff, 0x29, 0x90, 0xf9, 0xae, 0x15, 0xb9, 0x75, 0x46, 0x43, 0x6b, 0x4e, 0x37, 0x17,
+	0xb4, 0xbc, 0xb4, 0xd7, 0x91, 0xf7, 0x75, 0xb2, 0xd1, 0xb2, 0xe6, 0x90, 0x5a, 0x2f, 0xb1, 0x99,
+	0xf6, 0x8a, 0x58, 0x50, 0x89, 0x55, 0xb4, 0xc8, 0xd5, 0x88, 0x6c, 0xaa, 0xee, 0xa7, 0xae, 0xa4,
+	0xca, 0x91, 0xda, 0xdb, 0x48, 0xff, 0x07, 0xe4, 0x0d, 0x0c, 0xdc, 0x04, 0xb4, 0xf5, 0x72, 0xc1,
+	0x95, 0x9d, 0x02, 0x99, 0x2f, 0x9d, 0xc5, 0x0f, 0x9a, 0x5d, 0x16, 0x54, 0x6f, 0x9f, 0x81, 0x21,
+	0x0e, 0x7a, 0x03, 0x37, 0xd2, 0xd4, 0xae, 0xb4, 0xac, 0x39, 0x24, 0xe6, 0x02, 0x2d, 0x7c, 0x5c,
+	0xa2, 0x2a, 0xdc, 0x05, 0xb8, 0x4a, 0x39, 0xcc, 0x97, 0xee, 0x92, 0xcf, 0x4b, 0x34, 0xcf, 0x18,
+	0xfd, 0x36, 0xac, 0xa4, 0xaa, 0x63, 0xa1, 0x40, 0xe7, 0xbf, 0x79, 0x0d, 0x7d, 0xde, 0x82, 0x82,
+	0x9a, 0x46, 0x90, 0x51, 0x55, 0x5b, 0x6e, 0xf9, 0x0c, 0x63, 0xc6, 0x38, 0xe8, 0xb0, 0xd2, 0x9e,
+	0xd1, 0xc1, 0x05, 0x39, 0xcc, 0xc7, 0x69, 0x11, 0x4d, 0xca, 0xc8, 0x20, 0xcd, 0xa7, 0x50, 0x0e,
+	0x03, 0x4c, 0xb2, 0xbe, 0xa0, 0x50, 0xa0, 0x36, 0xe7, 0x27, 0x92, 0x01, 0xbc, 0x06, 0x51, 0x70,
+	0x89, 0x8f, 0xfa, 0xd6, 0x3f, 0xe7, 0xa1, 0xba, 0x3d, 0x1c, 0xdb, 0x8e, 0x4c, 0x3e, 0x1e, 0xe1,
+	0x45, 0x44, 0xb9, 0x52, 0xfc, 0x95, 0x9f, 0x4b, 0xba, 0xd4, 0x6b, 0xd9, 0x93, 0x82, 0xeb, 0x25,
+	0xc2, 0xcb, 0xd3, 0xa9, 0x54, 0x31, 0xdb, 0x5a, 0x6f, 0x9f, 0x9b, 0x5a, 0x6a, 0x97, 0xc8, 0x0f,
+	0x31, 0x8f, 0xd9, 0xe3, 0xc9, 0x7e, 0x6f, 0xf6, 0xeb, 0xc6, 0xd8, 0x97, 0xc8, 0x13, 0x68, 0xa4,
+	0xb3, 0xc1, 0x30, 0x5c, 0x5a, 0x90, 0x59, 0xaa, 0x37, 0x17, 0xce, 0x87, 0x64, 0x3f, 0x86, 0xf2,
+	0x11, 0x35, 0x47, 0x3c, 0x2e, 0xca, 0x3c, 0x5e, 0x76, 0xcc, 0x75, 0x89, 0x7c, 0x01, 0x25, 0x99,
+	0x3b, 0x12, 0x99, 0x2c, 0xa5, 0x72, 0x4f, 0x75, 0x7d, 0x0e, 0x2e, 0x97, 0xf7, 0x8b, 0xf8, 0x15,
+	0xde, 0x7b, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xc1, 0xb9, 0x22, 0xb3, 0xb5, 0x37, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3309,14 +5221,30 @@ type ApiServiceClient interface {
 	GetRAMInfo(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*RAMInfoResponse, error)
 	// get transaction by hash
 	GetTxByHash(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*TransactionResponse, error)
+	// get transaction status by transaction hash
+	GetTxStatus(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*TxStatusResponse, error)
+	// subscribe the status transitions of a transaction until it's final
+	SubscribeTxStatus(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (ApiService_SubscribeTxStatusClient, error)
 	// get transaction receipt by transaction hash
 	GetTxReceiptByTxHash(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*TxReceipt, error)
+	// get the merkle proof of a transaction in its block
+	GetTxProof(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*MerkleProofResponse, error)
+	// get the merkle proof of a transaction receipt in its block
+	GetTxReceiptProof(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*MerkleProofResponse, error)
 	// get block by hash
 	GetBlockByHash(ctx context.Context, in *GetBlockByHashRequest, opts ...grpc.CallOption) (*BlockResponse, error)
 	// get block by number
 	GetBlockByNumber(ctx context.Context, in *GetBlockByNumberRequest, opts ...grpc.CallOption) (*BlockResponse, error)
+	// get blocks in the range of number
+	GetBlocksByRange(ctx context.Context, in *GetBlocksByRangeRequest, opts ...grpc.CallOption) (ApiService_GetBlocksByRangeClient, error)
+	// get transactions of a block by page
+	GetTxsByBlock(ctx context.Context, in *GetTxsByBlockRequest, opts ...grpc.CallOption) (*GetTxsByBlockResponse, error)
 	// get account
 	GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*Account, error)
+	// get transactions of an account by page
+	GetAccountTransactions(ctx context.Context, in *GetAccountTransactionsRequest, opts ...grpc.CallOption) (*GetAccountTransactionsResponse, error)
+	// get scheduled delay transactions of an account
+	GetScheduledTxs(ctx context.Context, in *GetScheduledTxsRequest, opts ...grpc.CallOption) (*GetScheduledTxsResponse, error)
 	// get token balance
 	GetTokenBalance(ctx context.Context, in *GetTokenBalanceRequest, opts ...grpc.CallOption) (*GetTokenBalanceResponse, error)
 	// get token721 balance
@@ -3327,10 +5255,16 @@ type ApiServiceClient interface {
 	GetToken721Owner(ctx context.Context, in *GetToken721InfoRequest, opts ...grpc.CallOption) (*GetToken721OwnerResponse, error)
 	// get gas ratio infomation
 	GetGasRatio(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GasRatioResponse, error)
+	// get the evidences of double signing received by the node
+	GetDoubleSignEvidences(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetDoubleSignEvidencesResponse, error)
+	// get the witnesses scheduled in the next slots and the production statistics of the witnesses
+	GetWitnessSchedule(ctx context.Context, in *GetWitnessScheduleRequest, opts ...grpc.CallOption) (*GetWitnessScheduleResponse, error)
 	// get contract
 	GetContract(ctx context.Context, in *GetContractRequest, opts ...grpc.CallOption) (*Contract, error)
 	// get contract storage
 	GetContractStorage(ctx context.Context, in *GetContractStorageRequest, opts ...grpc.CallOption) (*GetContractStorageResponse, error)
+	// get the merkle proof of a contract storage in the state trie
+	GetStateProof(ctx context.Context, in *GetContractStorageRequest, opts ...grpc.CallOption) (*StateProofResponse, error)
 	// send transaction
 	SendTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error)
 	// execute transaction
@@ -3383,6 +5317,47 @@ func (c *apiServiceClient) GetTxByHash(ctx context.Context, in *TxHashRequest, o
 	return out, nil
 }
 
+func (c *apiServiceClient) GetTxStatus(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*TxStatusResponse, error) {
+	out := new(TxStatusResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetTxStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) SubscribeTxStatus(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (ApiService_SubscribeTxStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ApiService_serviceDesc.Streams[0], "/rpcpb.ApiService/SubscribeTxStatus", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiServiceSubscribeTxStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiService_SubscribeTxStatusClient interface {
+	Recv() (*TxStatusResponse, error)
+	grpc.ClientStream
+}
+
+type apiServiceSubscribeTxStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiServiceSubscribeTxStatusClient) Recv() (*TxStatusResponse, error) {
+	m := new(TxStatusResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *apiServiceClient) GetTxReceiptByTxHash(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*TxReceipt, error) {
 	out := new(TxReceipt)
 	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetTxReceiptByTxHash", in, out, opts...)
@@ -3392,6 +5367,24 @@ func (c *apiServiceClient) GetTxReceiptByTxHash(ctx context.Context, in *TxHashR
 	return out, nil
 }
 
+func (c *apiServiceClient) GetTxProof(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*MerkleProofResponse, error) {
+	out := new(MerkleProofResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetTxProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetTxReceiptProof(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*MerkleProofResponse, error) {
+	out := new(MerkleProofResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetTxReceiptProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiServiceClient) GetBlockByHash(ctx context.Context, in *GetBlockByHashRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
 	out := new(BlockResponse)
 	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetBlockByHash", in, out, opts...)
@@ -3410,6 +5403,47 @@ func (c *apiServiceClient) GetBlockByNumber(ctx context.Context, in *GetBlockByN
 	return out, nil
 }
 
+func (c *apiServiceClient) GetBlocksByRange(ctx context.Context, in *GetBlocksByRangeRequest, opts ...grpc.CallOption) (ApiService_GetBlocksByRangeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ApiService_serviceDesc.Streams[1], "/rpcpb.ApiService/GetBlocksByRange", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiServiceGetBlocksByRangeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiService_GetBlocksByRangeClient interface {
+	Recv() (*BlockResponse, error)
+	grpc.ClientStream
+}
+
+type apiServiceGetBlocksByRangeClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiServiceGetBlocksByRangeClient) Recv() (*BlockResponse, error) {
+	m := new(BlockResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiServiceClient) GetTxsByBlock(ctx context.Context, in *GetTxsByBlockRequest, opts ...grpc.CallOption) (*GetTxsByBlockResponse, error) {
+	out := new(GetTxsByBlockResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetTxsByBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiServiceClient) GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*Account, error) {
 	out := new(Account)
 	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetAccount", in, out, opts...)
@@ -3419,6 +5453,24 @@ func (c *apiServiceClient) GetAccount(ctx context.Context, in *GetAccountRequest
 	return out, nil
 }
 
+func (c *apiServiceClient) GetAccountTransactions(ctx context.Context, in *GetAccountTransactionsRequest, opts ...grpc.CallOption) (*GetAccountTransactionsResponse, error) {
+	out := new(GetAccountTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetAccountTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetScheduledTxs(ctx context.Context, in *GetScheduledTxsRequest, opts ...grpc.CallOption) (*GetScheduledTxsResponse, error) {
+	out := new(GetScheduledTxsResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetScheduledTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiServiceClient) GetTokenBalance(ctx context.Context, in *GetTokenBalanceRequest, opts ...grpc.CallOption) (*GetTokenBalanceResponse, error) {
 	out := new(GetTokenBalanceResponse)
 	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetTokenBalance", in, out, opts...)
@@ -3464,6 +5516,24 @@ func (c *apiServiceClient) GetGasRatio(ctx context.Context, in *EmptyRequest, op
 	return out, nil
 }
 
+func (c *apiServiceClient) GetDoubleSignEvidences(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetDoubleSignEvidencesResponse, error) {
+	out := new(GetDoubleSignEvidencesResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetDoubleSignEvidences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiServiceClient) GetWitnessSchedule(ctx context.Context, in *GetWitnessScheduleRequest, opts ...grpc.CallOption) (*GetWitnessScheduleResponse, error) {
+	out := new(GetWitnessScheduleResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetWitnessSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiServiceClient) GetContract(ctx context.Context, in *GetContractRequest, opts ...grpc.CallOption) (*Contract, error) {
 	out := new(Contract)
 	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetContract", in, out, opts...)
@@ -3482,6 +5552,15 @@ func (c *apiServiceClient) GetContractStorage(ctx context.Context, in *GetContra
 	return out, nil
 }
 
+func (c *apiServiceClient) GetStateProof(ctx context.Context, in *GetContractStorageRequest, opts ...grpc.CallOption) (*StateProofResponse, error) {
+	out := new(StateProofResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/GetStateProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiServiceClient) SendTransaction(ctx context.Context, in *TransactionRequest, opts ...grpc.CallOption) (*SendTransactionResponse, error) {
 	out := new(SendTransactionResponse)
 	err := c.cc.Invoke(ctx, "/rpcpb.ApiService/SendTransaction", in, out, opts...)
@@ -3501,7 +5580,7 @@ func (c *apiServiceClient) ExecTransaction(ctx context.Context, in *TransactionR
 }
 
 func (c *apiServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (ApiService_SubscribeClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ApiService_serviceDesc.Streams[0], "/rpcpb.ApiService/Subscribe", opts...)
+	stream, err := c.cc.NewStream(ctx, &_ApiService_serviceDesc.Streams[2], "/rpcpb.ApiService/Subscribe", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -3542,14 +5621,30 @@ type ApiServiceServer interface {
 	GetRAMInfo(context.Context, *EmptyRequest) (*RAMInfoResponse, error)
 	// get transaction by hash
 	GetTxByHash(context.Context, *TxHashRequest) (*TransactionResponse, error)
+	// get transaction status by transaction hash
+	GetTxStatus(context.Context, *TxHashRequest) (*TxStatusResponse, error)
+	// subscribe the status transitions of a transaction until it's final
+	SubscribeTxStatus(*TxHashRequest, ApiService_SubscribeTxStatusServer) error
 	// get transaction receipt by transaction hash
 	GetTxReceiptByTxHash(context.Context, *TxHashRequest) (*TxReceipt, error)
+	// get the merkle proof of a transaction in its block
+	GetTxProof(context.Context, *TxHashRequest) (*MerkleProofResponse, error)
+	// get the merkle proof of a transaction receipt in its block
+	GetTxReceiptProof(context.Context, *TxHashRequest) (*MerkleProofResponse, error)
 	// get block by hash
 	GetBlockByHash(context.Context, *GetBlockByHashRequest) (*BlockResponse, error)
 	// get block by number
 	GetBlockByNumber(context.Context, *GetBlockByNumberRequest) (*BlockResponse, error)
+	// get blocks in the range of number
+	GetBlocksByRange(*GetBlocksByRangeRequest, ApiService_GetBlocksByRangeServer) error
+	// get transactions of a block by page
+	GetTxsByBlock(context.Context, *GetTxsByBlockRequest) (*GetTxsByBlockResponse, error)
 	// get account
 	GetAccount(context.Context, *GetAccountRequest) (*Account, error)
+	// get transactions of an account by page
+	GetAccountTransactions(context.Context, *GetAccountTransactionsRequest) (*GetAccountTransactionsResponse, error)
+	// get scheduled delay transactions of an account
+	GetScheduledTxs(context.Context, *GetScheduledTxsRequest) (*GetScheduledTxsResponse, error)
 	// get token balance
 	GetTokenBalance(context.Context, *GetTokenBalanceRequest) (*GetTokenBalanceResponse, error)
 	// get token721 balance
@@ -3560,10 +5655,16 @@ type ApiServiceServer interface {
 	GetToken721Owner(context.Context, *GetToken721InfoRequest) (*GetToken721OwnerResponse, error)
 	// get gas ratio infomation
 	GetGasRatio(context.Context, *EmptyRequest) (*GasRatioResponse, error)
+	// get the evidences of double signing received by the node
+	GetDoubleSignEvidences(context.Context, *EmptyRequest) (*GetDoubleSignEvidencesResponse, error)
+	// get the witnesses scheduled in the next slots and the production statistics of the witnesses
+	GetWitnessSchedule(context.Context, *GetWitnessScheduleRequest) (*GetWitnessScheduleResponse, error)
 	// get contract
 	GetContract(context.Context, *GetContractRequest) (*Contract, error)
 	// get contract storage
 	GetContractStorage(context.Context, *GetContractStorageRequest) (*GetContractStorageResponse, error)
+	// get the merkle proof of a contract storage in the state trie
+	GetStateProof(context.Context, *GetContractStorageRequest) (*StateProofResponse, error)
 	// send transaction
 	SendTransaction(context.Context, *TransactionRequest) (*SendTransactionResponse, error)
 	// execute transaction
@@ -3636,86 +5737,236 @@ func _ApiService_GetTxByHash_Handler(srv interface{}, ctx context.Context, dec f
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetTxByHash(ctx, in)
+		return srv.(ApiServiceServer).GetTxByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetTxByHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetTxByHash(ctx, req.(*TxHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetTxStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetTxStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetTxStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetTxStatus(ctx, req.(*TxHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_SubscribeTxStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TxHashRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiServiceServer).SubscribeTxStatus(m, &apiServiceSubscribeTxStatusServer{stream})
+}
+
+type ApiService_SubscribeTxStatusServer interface {
+	Send(*TxStatusResponse) error
+	grpc.ServerStream
+}
+
+type apiServiceSubscribeTxStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiServiceSubscribeTxStatusServer) Send(m *TxStatusResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApiService_GetTxReceiptByTxHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetTxReceiptByTxHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetTxReceiptByTxHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetTxReceiptByTxHash(ctx, req.(*TxHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetTxProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetTxProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetTxProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetTxProof(ctx, req.(*TxHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetTxReceiptProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetTxReceiptProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetTxReceiptProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetTxReceiptProof(ctx, req.(*TxHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetBlockByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetBlockByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetBlockByHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetBlockByHash(ctx, req.(*GetBlockByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetBlockByNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockByNumberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetBlockByNumber(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/GetTxByHash",
+		FullMethod: "/rpcpb.ApiService/GetBlockByNumber",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetTxByHash(ctx, req.(*TxHashRequest))
+		return srv.(ApiServiceServer).GetBlockByNumber(ctx, req.(*GetBlockByNumberRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetTxReceiptByTxHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TxHashRequest)
+func _ApiService_GetBlocksByRange_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetBlocksByRangeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiServiceServer).GetBlocksByRange(m, &apiServiceGetBlocksByRangeServer{stream})
+}
+
+type ApiService_GetBlocksByRangeServer interface {
+	Send(*BlockResponse) error
+	grpc.ServerStream
+}
+
+type apiServiceGetBlocksByRangeServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiServiceGetBlocksByRangeServer) Send(m *BlockResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApiService_GetTxsByBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTxsByBlockRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetTxReceiptByTxHash(ctx, in)
+		return srv.(ApiServiceServer).GetTxsByBlock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/GetTxReceiptByTxHash",
+		FullMethod: "/rpcpb.ApiService/GetTxsByBlock",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetTxReceiptByTxHash(ctx, req.(*TxHashRequest))
+		return srv.(ApiServiceServer).GetTxsByBlock(ctx, req.(*GetTxsByBlockRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetBlockByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBlockByHashRequest)
+func _ApiService_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetBlockByHash(ctx, in)
+		return srv.(ApiServiceServer).GetAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/GetBlockByHash",
+		FullMethod: "/rpcpb.ApiService/GetAccount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetBlockByHash(ctx, req.(*GetBlockByHashRequest))
+		return srv.(ApiServiceServer).GetAccount(ctx, req.(*GetAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetBlockByNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBlockByNumberRequest)
+func _ApiService_GetAccountTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountTransactionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetBlockByNumber(ctx, in)
+		return srv.(ApiServiceServer).GetAccountTransactions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/GetBlockByNumber",
+		FullMethod: "/rpcpb.ApiService/GetAccountTransactions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetBlockByNumber(ctx, req.(*GetBlockByNumberRequest))
+		return srv.(ApiServiceServer).GetAccountTransactions(ctx, req.(*GetAccountTransactionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ApiService_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAccountRequest)
+func _ApiService_GetScheduledTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduledTxsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ApiServiceServer).GetAccount(ctx, in)
+		return srv.(ApiServiceServer).GetScheduledTxs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.ApiService/GetAccount",
+		FullMethod: "/rpcpb.ApiService/GetScheduledTxs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ApiServiceServer).GetAccount(ctx, req.(*GetAccountRequest))
+		return srv.(ApiServiceServer).GetScheduledTxs(ctx, req.(*GetScheduledTxsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -3810,6 +6061,42 @@ func _ApiService_GetGasRatio_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_GetDoubleSignEvidences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetDoubleSignEvidences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetDoubleSignEvidences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetDoubleSignEvidences(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiService_GetWitnessSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWitnessScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetWitnessSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetWitnessSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetWitnessSchedule(ctx, req.(*GetWitnessScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_GetContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetContractRequest)
 	if err := dec(in); err != nil {
@@ -3846,6 +6133,24 @@ func _ApiService_GetContractStorage_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiService_GetStateProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContractStorageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServiceServer).GetStateProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.ApiService/GetStateProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServiceServer).GetStateProof(ctx, req.(*GetContractStorageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiService_SendTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TransactionRequest)
 	if err := dec(in); err != nil {
@@ -3923,10 +6228,22 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetTxByHash",
 			Handler:    _ApiService_GetTxByHash_Handler,
 		},
+		{
+			MethodName: "GetTxStatus",
+			Handler:    _ApiService_GetTxStatus_Handler,
+		},
 		{
 			MethodName: "GetTxReceiptByTxHash",
 			Handler:    _ApiService_GetTxReceiptByTxHash_Handler,
 		},
+		{
+			MethodName: "GetTxProof",
+			Handler:    _ApiService_GetTxProof_Handler,
+		},
+		{
+			MethodName: "GetTxReceiptProof",
+			Handler:    _ApiService_GetTxReceiptProof_Handler,
+		},
 		{
 			MethodName: "GetBlockByHash",
 			Handler:    _ApiService_GetBlockByHash_Handler,
@@ -3935,10 +6252,22 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetBlockByNumber",
 			Handler:    _ApiService_GetBlockByNumber_Handler,
 		},
+		{
+			MethodName: "GetTxsByBlock",
+			Handler:    _ApiService_GetTxsByBlock_Handler,
+		},
 		{
 			MethodName: "GetAccount",
 			Handler:    _ApiService_GetAccount_Handler,
 		},
+		{
+			MethodName: "GetAccountTransactions",
+			Handler:    _ApiService_GetAccountTransactions_Handler,
+		},
+		{
+			MethodName: "GetScheduledTxs",
+			Handler:    _ApiService_GetScheduledTxs_Handler,
+		},
 		{
 			MethodName: "GetTokenBalance",
 			Handler:    _ApiService_GetTokenBalance_Handler,
@@ -3959,6 +6288,14 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetGasRatio",
 			Handler:    _ApiService_GetGasRatio_Handler,
 		},
+		{
+			MethodName: "GetDoubleSignEvidences",
+			Handler:    _ApiService_GetDoubleSignEvidences_Handler,
+		},
+		{
+			MethodName: "GetWitnessSchedule",
+			Handler:    _ApiService_GetWitnessSchedule_Handler,
+		},
 		{
 			MethodName: "GetContract",
 			Handler:    _ApiService_GetContract_Handler,
@@ -3967,6 +6304,10 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetContractStorage",
 			Handler:    _ApiService_GetContractStorage_Handler,
 		},
+		{
+			MethodName: "GetStateProof",
+			Handler:    _ApiService_GetStateProof_Handler,
+		},
 		{
 			MethodName: "SendTransaction",
 			Handler:    _ApiService_SendTransaction_Handler,
@@ -3977,6 +6318,16 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeTxStatus",
+			Handler:       _ApiService_SubscribeTxStatus_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetBlocksByRange",
+			Handler:       _ApiService_GetBlocksByRange_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Subscribe",
 			Handler:       _ApiService_Subscribe_Handler,
@@ -3985,3 +6336,244 @@ var _ApiService_serviceDesc = grpc.ServiceDesc{
 	},
 	Metadata: "rpc/pb/rpc.proto",
 }
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AdminServiceClient interface {
+	// get the pending transactions in the transaction pool by page
+	GetPendingTxs(ctx context.Context, in *GetPendingTxsRequest, opts ...grpc.CallOption) (*GetPendingTxsResponse, error)
+	// get the count of pending transactions of each publisher
+	GetPendingTxCounts(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetPendingTxCountsResponse, error)
+	// get the reason why a transaction was dropped by the transaction pool
+	GetDroppedTx(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*TxStatusResponse, error)
+	// remove transactions from the transaction pool
+	RemovePendingTxs(ctx context.Context, in *RemovePendingTxsRequest, opts ...grpc.CallOption) (*RemovePendingTxsResponse, error)
+	// seal a block with the pending transactions at once, only in the dev consensus
+	SealBlock(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*BlockResponse, error)
+	// move the block time forward to test the delay transactions, only in the dev consensus
+	WarpTime(ctx context.Context, in *WarpTimeRequest, opts ...grpc.CallOption) (*WarpTimeResponse, error)
+}
+
+type adminServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAdminServiceClient(cc *grpc.ClientConn) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) GetPendingTxs(ctx context.Context, in *GetPendingTxsRequest, opts ...grpc.CallOption) (*GetPendingTxsResponse, error) {
+	out := new(GetPendingTxsResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.AdminService/GetPendingTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetPendingTxCounts(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GetPendingTxCountsResponse, error) {
+	out := new(GetPendingTxCountsResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.AdminService/GetPendingTxCounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetDroppedTx(ctx context.Context, in *TxHashRequest, opts ...grpc.CallOption) (*TxStatusResponse, error) {
+	out := new(TxStatusResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.AdminService/GetDroppedTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RemovePendingTxs(ctx context.Context, in *RemovePendingTxsRequest, opts ...grpc.CallOption) (*RemovePendingTxsResponse, error) {
+	out := new(RemovePendingTxsResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.AdminService/RemovePendingTxs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SealBlock(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*BlockResponse, error) {
+	out := new(BlockResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.AdminService/SealBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) WarpTime(ctx context.Context, in *WarpTimeRequest, opts ...grpc.CallOption) (*WarpTimeResponse, error) {
+	out := new(WarpTimeResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.AdminService/WarpTime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+type AdminServiceServer interface {
+	// get the pending transactions in the transaction pool by page
+	GetPendingTxs(context.Context, *GetPendingTxsRequest) (*GetPendingTxsResponse, error)
+	// get the count of pending transactions of each publisher
+	GetPendingTxCounts(context.Context, *EmptyRequest) (*GetPendingTxCountsResponse, error)
+	// get the reason why a transaction was dropped by the transaction pool
+	GetDroppedTx(context.Context, *TxHashRequest) (*TxStatusResponse, error)
+	// remove transactions from the transaction pool
+	RemovePendingTxs(context.Context, *RemovePendingTxsRequest) (*RemovePendingTxsResponse, error)
+	// seal a block with the pending transactions at once, only in the dev consensus
+	SealBlock(context.Context, *EmptyRequest) (*BlockResponse, error)
+	// move the block time forward to test the delay transactions, only in the dev consensus
+	WarpTime(context.Context, *WarpTimeRequest) (*WarpTimeResponse, error)
+}
+
+func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
+	s.RegisterService(&_AdminService_serviceDesc, srv)
+}
+
+func _AdminService_GetPendingTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPendingTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetPendingTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/GetPendingTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetPendingTxs(ctx, req.(*GetPendingTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetPendingTxCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetPendingTxCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/GetPendingTxCounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetPendingTxCounts(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetDroppedTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetDroppedTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/GetDroppedTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetDroppedTx(ctx, req.(*TxHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RemovePendingTxs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePendingTxsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RemovePendingTxs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/RemovePendingTxs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RemovePendingTxs(ctx, req.(*RemovePendingTxsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SealBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SealBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/SealBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SealBlock(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_WarpTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WarpTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).WarpTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/WarpTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).WarpTime(ctx, req.(*WarpTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AdminService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcpb.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPendingTxs",
+			Handler:    _AdminService_GetPendingTxs_Handler,
+		},
+		{
+			MethodName: "GetPendingTxCounts",
+			Handler:    _AdminService_GetPendingTxCounts_Handler,
+		},
+		{
+			MethodName: "GetDroppedTx",
+			Handler:    _AdminService_GetDroppedTx_Handler,
+		},
+		{
+			MethodName: "RemovePendingTxs",
+			Handler:    _AdminService_RemovePendingTxs_Handler,
+		},
+		{
+			MethodName: "SealBlock",
+			Handler:    _AdminService_SealBlock_Handler,
+		},
+		{
+			MethodName: "WarpTime",
+			Handler:    _AdminService_WarpTime_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "rpc/pb/rpc.proto",
+}
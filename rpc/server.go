@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/iost-official/go-iost/consensus"
 	"github.com/iost-official/go-iost/core/blockcache"
 	"github.com/iost-official/go-iost/core/global"
 	"github.com/iost-official/go-iost/core/txpool"
@@ -37,6 +38,9 @@ type Server struct {
 	gatewayServer *http.Server
 	allowOrigins  []string
 
+	adminAddr   string
+	adminServer *grpc.Server
+
 	quitCh chan struct{}
 }
 
@@ -45,14 +49,26 @@ func p(pp interface{}) error {
 }
 
 // New returns a new rpc server instance.
-func New(tp txpool.TxPool, bc blockcache.BlockCache, bv global.BaseVariable, p2pService p2p.Service) *Server {
+func New(tp txpool.TxPool, bc blockcache.BlockCache, bv global.BaseVariable, p2pService p2p.Service, cons consensus.Consensus) *Server {
 	s := &Server{
 		grpcAddr:     bv.Config().RPC.GRPCAddr,
 		gatewayAddr:  bv.Config().RPC.GatewayAddr,
 		allowOrigins: bv.Config().RPC.AllowOrigins,
+		adminAddr:    bv.Config().RPC.AdminAddr,
 		quitCh:       make(chan struct{}),
 	}
-	s.grpcServer = grpc.NewServer(
+	s.grpcServer = newGrpcServer()
+	apiService := NewAPIService(tp, bc, bv, p2pService, cons, s.quitCh)
+	rpcpb.RegisterApiServiceServer(s.grpcServer, apiService)
+	if s.adminAddr != "" {
+		s.adminServer = newGrpcServer()
+		rpcpb.RegisterAdminServiceServer(s.adminServer, NewAdminService(tp, cons))
+	}
+	return s
+}
+
+func newGrpcServer() *grpc.Server {
+	return grpc.NewServer(
 		grpc.UnaryInterceptor(
 			grpc_middleware.ChainUnaryServer(
 				metricsUnaryMiddleware,
@@ -66,9 +82,6 @@ func New(tp txpool.TxPool, bc blockcache.BlockCache, bv global.BaseVariable, p2p
 			),
 		),
 		grpc.MaxConcurrentStreams(maxConcurrentStreams))
-	apiService := NewAPIService(tp, bc, bv, p2pService, s.quitCh)
-	rpcpb.RegisterApiServiceServer(s.grpcServer, apiService)
-	return s
 }
 
 // Start starts the rpc server.
@@ -76,6 +89,9 @@ func (s *Server) Start() error {
 	if err := s.startGrpc(); err != nil {
 		return err
 	}
+	if err := s.startAdmin(); err != nil {
+		return err
+	}
 	return s.startGateway()
 }
 
@@ -93,6 +109,23 @@ func (s *Server) startGrpc() error {
 	return nil
 }
 
+// startAdmin starts the grpc server of admin service, which should listen on a private address.
+func (s *Server) startAdmin() error {
+	if s.adminServer == nil {
+		return nil
+	}
+	lis, err := net.Listen("tcp", s.adminAddr)
+	if err != nil {
+		return err
+	}
+	go func() {
+		if err := s.adminServer.Serve(lis); err != nil {
+			ilog.Fatalf("start admin grpc failed. err=%v", err)
+		}
+	}()
+	return nil
+}
+
 func (s *Server) startGateway() error {
 	mux := runtime.NewServeMux(
 		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{OrigName: true, EmitDefaults: true}),
@@ -134,4 +167,7 @@ func (s *Server) Stop() {
 	ctx, _ := context.WithTimeout(context.Background(), time.Second) // nolint
 	s.gatewayServer.Shutdown(ctx)
 	s.grpcServer.GracefulStop()
+	if s.adminServer != nil {
+		s.adminServer.GracefulStop()
+	}
 }
@@ -43,6 +43,8 @@ type Provider interface {
 	Tx() *tx.Tx
 	Return(*tx.Tx)
 	Drop(t *tx.Tx, err error)
+	Hold(t *tx.Tx)
+	Release(publisher string)
 	Close()
 }
 
@@ -66,6 +68,7 @@ func (m *batcherImpl) Batch(bh *block.BlockHead, db database.IMultiValue, provid
 	)
 
 	bvr := database.NewBatchVisitorRoot(10000, db)
+	limits := database.NewVisitor(0, db).TxLimits()
 	for i := 0; i < thread; i++ {
 		i2 := i
 		t := provider.Tx()
@@ -83,7 +86,7 @@ func (m *batcherImpl) Batch(bh *block.BlockHead, db database.IMultiValue, provid
 			provider.Return(t)
 			continue
 		}
-		if t.IsExpired(bh.Time) && !t.IsDefer() {
+		if t.IsExpired(bh.Time, limits) && !t.IsDefer() {
 			ilog.Errorf(
 				"Tx is expired, tx %v time is %v, blk time is %v",
 				t.String(),
@@ -11,6 +11,7 @@ type ProviderImpl struct {
 	pool     *txpool.SortedTxMap
 	iter     *txpool.Iterator
 	droplist map[*tx.Tx]error
+	held     map[string][]*tx.Tx
 }
 
 // NewProvider ...
@@ -18,6 +19,7 @@ func NewProvider(pool *txpool.SortedTxMap) *ProviderImpl {
 	return &ProviderImpl{
 		cache:    make([]*tx.Tx, 0),
 		droplist: make(map[*tx.Tx]error),
+		held:     make(map[string][]*tx.Tx),
 		pool:     pool,
 		iter:     pool.Iter(),
 	}
@@ -47,6 +49,17 @@ func (p *ProviderImpl) Drop(t *tx.Tx, err error) {
 	p.droplist[t] = err
 }
 
+// Hold holds the tx whose sequence is ahead, until a sequenced tx of the same publisher is executed
+func (p *ProviderImpl) Hold(t *tx.Tx) {
+	p.held[t.Publisher] = append(p.held[t.Publisher], t)
+}
+
+// Release sends the held txs of publisher to pool
+func (p *ProviderImpl) Release(publisher string) {
+	p.cache = append(p.cache, p.held[publisher]...)
+	delete(p.held, publisher)
+}
+
 // List list tx and errors of drop txs
 func (p *ProviderImpl) List() (a []*tx.Tx, b []error) {
 	a = make([]*tx.Tx, 0)
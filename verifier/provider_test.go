@@ -0,0 +1,45 @@
+package verifier
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/core/txpool"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProviderHoldRelease(t *testing.T) {
+	newTx := func(publisher string, sequence int64) *tx.Tx {
+		actions := []*tx.Action{tx.NewAction("contract1", "actionname1", "[]")}
+		t := tx.NewTx(actions, nil, 100000, 100, time.Now().Add(time.Minute).UnixNano()+sequence, 0)
+		t.Publisher = publisher
+		t.Sequence = sequence
+		return t
+	}
+	pool := txpool.NewSortedTxMap()
+	p := NewProvider(pool)
+	seq2, seq3, seq4 := newTx("alice", 2), newTx("alice", 3), newTx("alice", 4)
+	other := newTx("bob", 2)
+
+	// the txs ahead of the next sequence arrive out of order and are held
+	p.Hold(seq4)
+	p.Hold(seq3)
+	p.Hold(other)
+	assert.Nil(t, p.Tx())
+
+	// the execution of a sequenced tx only releases the txs of its publisher
+	p.Return(seq2)
+	assert.Equal(t, seq2, p.Tx())
+	p.Release("alice")
+	released := []*tx.Tx{p.Tx(), p.Tx()}
+	assert.ElementsMatch(t, []*tx.Tx{seq3, seq4}, released)
+	assert.Nil(t, p.Tx())
+
+	// a released tx still ahead of the next sequence is held again until the gap is filled
+	p.Hold(released[0])
+	p.Release("alice")
+	assert.Equal(t, released[0], p.Tx())
+	assert.Nil(t, p.Tx())
+	assert.Equal(t, []*tx.Tx{other}, p.held["bob"])
+}
@@ -1,6 +1,7 @@
 package verifier
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -103,18 +104,22 @@ func (v *Verifier) Gen(blk *block.Block, parent *block.Block, db database.IMulti
 	switch c.Mode {
 	case 0:
 		err = baseGen(blk, db, pi, isolator, c)
-		droplist, errs = pi.List()
-		pi.Close()
-		return
 	case 1:
 		batcher := NewBatcher()
 		err = batchGen(blk, db, pi, batcher, c)
-		droplist, errs = pi.List()
+	default:
 		pi.Close()
-		return
+		return []*tx.Tx{}, []error{}, fmt.Errorf("mode unexpected: %v", c.Mode)
 	}
+	droplist, errs = pi.List()
 	pi.Close()
-	return []*tx.Tx{}, []error{}, fmt.Errorf("mode unexpected: %v", c.Mode)
+	if err != nil {
+		return
+	}
+	if blk.Head.Version >= block.V1 {
+		blk.Head.StateRoot, err = db.StateRoot()
+	}
+	return
 }
 
 func blockBaseExec(blk *block.Block, db database.IMultiValue, isolator *vm.Isolator, t *tx.Tx, c *Config) (tr *tx.TxReceipt, err error) {
@@ -172,7 +177,7 @@ L:
 			)
 			continue L
 		}
-		if t.IsExpired(blk.Head.Time) && !t.IsDefer() {
+		if t.IsExpired(blk.Head.Time, isolator.TxLimits()) && !t.IsDefer() {
 			ilog.Errorf(
 				"Tx %v is expired, tx time is %v, blk time is %v",
 				common.Base58Encode(t.Hash()),
@@ -183,6 +188,10 @@ L:
 			continue L
 		}
 		err := isolator.PrepareTx(t, limit)
+		if err == vm.ErrSequenceGap {
+			provider.Hold(t)
+			continue L
+		}
 		if err != nil {
 			ilog.Errorf("PrepareTx failed. tx %v limit %v err %v", t.String(), limit, err)
 			provider.Drop(t, err)
@@ -214,6 +223,9 @@ L:
 		isolator.Commit()
 		blk.Txs = append(blk.Txs, t)
 		blk.Receipts = append(blk.Receipts, r)
+		if t.Sequence != 0 {
+			provider.Release(t.Publisher)
+		}
 	}
 	buf, err := json.Marshal(info)
 	if err != nil {
@@ -278,11 +290,28 @@ func (v *Verifier) Verify(blk *block.Block, parent *block.Block, db database.IMu
 		var l ilog.Logger
 		l.Stop()
 		isolator.Prepare(blk.Head, vi, &l)
-		return baseVerify(isolator, c, blk.Txs[1:], blk.Receipts[1:], blk)
+		err = baseVerify(isolator, c, blk.Txs[1:], blk.Receipts[1:], blk)
 	case 1:
 		bs := batches(blk, info)
 		var batcher Batcher
-		return batchVerify(batcher, blk.Head, c, db, bs, blk)
+		err = batchVerify(batcher, blk.Head, c, db, bs, blk)
+	}
+	if err != nil {
+		return err
+	}
+	return verifyStateRoot(blk, db)
+}
+
+func verifyStateRoot(blk *block.Block, db database.IMultiValue) error {
+	if blk.Head.Version < block.V1 {
+		return nil
+	}
+	root, err := db.StateRoot()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(root, blk.Head.StateRoot) {
+		return fmt.Errorf("state root not match: %v != %v", common.Base58Encode(root), common.Base58Encode(blk.Head.StateRoot))
 	}
 	return nil
 }
@@ -337,7 +366,7 @@ func verify(isolator vm.Isolator, t *tx.Tx, r *tx.TxReceipt, timeout time.Durati
 	if !t.IsCreatedBefore(blk.Head.Time) {
 		return ErrNotArrivedTx
 	}
-	if t.IsExpired(blk.Head.Time) && !t.IsDefer() {
+	if t.IsExpired(blk.Head.Time, isolator.TxLimits()) && !t.IsDefer() {
 		return ErrExpiredTx
 	}
 	isolator.ClearTx()
@@ -404,6 +433,14 @@ func checkReceiptEqual(r *tx.TxReceipt, receipt *tx.TxReceipt) error {
 			return fmt.Errorf("receipt not match, returns not same: %v != %v \n%v\n%v", br, receipt.Returns[i], r, receipt)
 		}
 	}
+	if len(r.ActionReceipts) != len(receipt.ActionReceipts) {
+		return fmt.Errorf("receipt not match, action receipts length not same: %v != %v \n%v\n%v", len(r.ActionReceipts), len(receipt.ActionReceipts), r, receipt)
+	}
+	for i, ar := range r.ActionReceipts {
+		if !bytes.Equal(ar.ToBytes(), receipt.ActionReceipts[i].ToBytes()) {
+			return fmt.Errorf("receipt not match, action receipt %v not same: %v != %v \n%v\n%v", i, ar, receipt.ActionReceipts[i], r, receipt)
+		}
+	}
 	return nil
 }
 
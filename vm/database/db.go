@@ -9,6 +9,8 @@ type Visitor struct {
 	Token721Handler
 	RollbackHandler
 	DelaytxHandler
+	SequenceHandler
+	TxLimitsHandler
 	GasHandler
 	RAMHandler
 }
@@ -26,8 +28,10 @@ func NewVisitor(cacheLength int, cb IMultiValue) *Visitor {
 		Token721Handler: Token721Handler{cachedDB},
 		RAMHandler:      RAMHandler{cachedDB},
 		DelaytxHandler:  DelaytxHandler{cachedDB},
+		SequenceHandler: SequenceHandler{cachedDB},
 	}
 	v.GasHandler = GasHandler{v.BasicHandler, v.MapHandler}
+	v.TxLimitsHandler = TxLimitsHandler{v.MapHandler}
 	v.RollbackHandler = newRollbackHandler(lruDB, cachedDB)
 	return v
 }
@@ -56,8 +60,10 @@ func NewBatchVisitor(lruDB *LRU) (*Visitor, Mapper) {
 		Token721Handler: Token721Handler{watcher},
 		RAMHandler:      RAMHandler{watcher},
 		DelaytxHandler:  DelaytxHandler{cachedDB},
+		SequenceHandler: SequenceHandler{watcher},
 	}
 	v.GasHandler = GasHandler{v.BasicHandler, v.MapHandler}
+	v.TxLimitsHandler = TxLimitsHandler{v.MapHandler}
 	v.RollbackHandler = newRollbackHandler(lruDB, cachedDB)
 	return v, watcher
 }
@@ -1,9 +1,22 @@
 package database
 
+import "encoding/json"
+
 const (
-	delaytxPrefix = "t-"
+	delaytxPrefix           = "t-"
+	delaytxRecurrencePrefix = "tr-"
 )
 
+// DelaytxRecurrenceSize is the ram size paid for the recurrence of a recurring delay tx.
+const DelaytxRecurrenceSize = 24
+
+// DelaytxRecurrence is the execution progress of a recurring delay tx.
+type DelaytxRecurrence struct {
+	Next      int64 `json:"next"`
+	Interval  int64 `json:"interval"`
+	Remaining int64 `json:"remaining"`
+}
+
 // DelaytxHandler handler of delay tx
 type DelaytxHandler struct {
 	db database
@@ -32,3 +45,34 @@ func (m *DelaytxHandler) HasDelaytx(txHash string) bool {
 func (m *DelaytxHandler) DelDelaytx(txHash string) {
 	m.db.Del(m.delaytxKey(txHash))
 }
+
+func (m *DelaytxHandler) delaytxRecurrenceKey(txHash string) string {
+	return delaytxRecurrencePrefix + txHash
+}
+
+// StoreDelaytxRecurrence stores the recurrence of the recurring delay tx.
+func (m *DelaytxHandler) StoreDelaytxRecurrence(txHash string, r *DelaytxRecurrence) {
+	b, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+	m.db.Put(m.delaytxRecurrenceKey(txHash), MustMarshal(string(b)))
+}
+
+// GetDelaytxRecurrence gets the recurrence of the delay tx, nil if it's not recurring.
+func (m *DelaytxHandler) GetDelaytxRecurrence(txHash string) *DelaytxRecurrence {
+	s, ok := Unmarshal(m.db.Get(m.delaytxRecurrenceKey(txHash))).(string)
+	if !ok {
+		return nil
+	}
+	var r DelaytxRecurrence
+	if err := json.Unmarshal([]byte(s), &r); err != nil {
+		return nil
+	}
+	return &r
+}
+
+// DelDelaytxRecurrence deletes the recurrence of the delay tx.
+func (m *DelaytxHandler) DelDelaytxRecurrence(txHash string) {
+	m.db.Del(m.delaytxRecurrenceKey(txHash))
+}
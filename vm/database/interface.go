@@ -8,6 +8,7 @@ type IMultiValue interface {
 	Put(table string, key string, value string) error
 	Del(table string, key string) error
 	Has(table string, key string) (bool, error)
-	Commit()
+	Commit() error
 	Rollback()
+	StateRoot() ([]byte, error)
 }
@@ -33,8 +33,10 @@ func (m *MockIMultiValue) EXPECT() *MockIMultiValueMockRecorder {
 }
 
 // Commit mocks base method
-func (m *MockIMultiValue) Commit() {
-	m.ctrl.Call(m, "Commit")
+func (m *MockIMultiValue) Commit() error {
+	ret := m.ctrl.Call(m, "Commit")
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
 // Commit indicates an expected call of Commit
@@ -114,3 +116,16 @@ func (m *MockIMultiValue) Rollback() {
 func (mr *MockIMultiValueMockRecorder) Rollback() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rollback", reflect.TypeOf((*MockIMultiValue)(nil).Rollback))
 }
+
+// StateRoot mocks base method
+func (m *MockIMultiValue) StateRoot() ([]byte, error) {
+	ret := m.ctrl.Call(m, "StateRoot")
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StateRoot indicates an expected call of StateRoot
+func (mr *MockIMultiValueMockRecorder) StateRoot() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateRoot", reflect.TypeOf((*MockIMultiValue)(nil).StateRoot))
+}
@@ -0,0 +1,28 @@
+package database
+
+const (
+	sequencePrefix = "q-"
+)
+
+// SequenceHandler handler of the sequence of publisher
+type SequenceHandler struct {
+	db database
+}
+
+func (m *SequenceHandler) sequenceKey(publisher string) string {
+	return sequencePrefix + publisher
+}
+
+// Sequence gets the sequence of the last sequenced tx of publisher, 0 if there isn't any.
+func (m *SequenceHandler) Sequence(publisher string) int64 {
+	seq, ok := Unmarshal(m.db.Get(m.sequenceKey(publisher))).(int64)
+	if !ok {
+		return 0
+	}
+	return seq
+}
+
+// SetSequence sets the sequence of publisher.
+func (m *SequenceHandler) SetSequence(publisher string, seq int64) {
+	m.db.Put(m.sequenceKey(publisher), MustMarshal(seq))
+}
@@ -230,11 +230,16 @@ func (d *SimpleDB) AddSystem(path string) {
 }
 
 // Commit do nothing
-func (d *SimpleDB) Commit() {
-
+func (d *SimpleDB) Commit() error {
+	return nil
 }
 
 // Rollback do nothing
 func (d *SimpleDB) Rollback() {
 
 }
+
+// StateRoot returns nil, SimpleDB doesn't maintain a state trie
+func (d *SimpleDB) StateRoot() ([]byte, error) {
+	return nil, nil
+}
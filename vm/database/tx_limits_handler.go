@@ -0,0 +1,31 @@
+package database
+
+import (
+	"encoding/json"
+
+	"github.com/iost-official/go-iost/core/tx"
+)
+
+// TxLimitsKey is the map key and field of the tx limits set by system.iost.
+const (
+	TxLimitsKey   = "system.iost" + Separator + "settings"
+	TxLimitsField = "tx"
+)
+
+// TxLimitsHandler handler of the tx limits
+type TxLimitsHandler struct {
+	MapHandler
+}
+
+// TxLimits gets the tx limits set on chain, the fields not set are the default ones.
+func (m *TxLimitsHandler) TxLimits() *tx.Limits {
+	l := tx.DefaultLimits()
+	s, ok := Unmarshal(m.MGet(TxLimitsKey, TxLimitsField)).(string)
+	if !ok {
+		return l
+	}
+	if err := json.Unmarshal([]byte(s), l); err != nil {
+		return tx.DefaultLimits()
+	}
+	return l
+}
@@ -17,7 +17,7 @@ func TestRequireAuth_ByKey(t *testing.T) {
 
 	db, host := myinit(t, ctx)
 
-	db.EXPECT().Commit().Return()
+	db.EXPECT().Commit().Return(nil)
 	db.EXPECT().Get("state", "m-auth.iost-auth-a").DoAndReturn(func(a, b string) (string, error) {
 		ac := account.NewAccount("a")
 		ac.Permissions["pa"] = &account.Permission{
@@ -63,7 +63,7 @@ func TestAuthority_ByUser(t *testing.T) {
 
 	db, host := myinit(t, ctx)
 
-	db.EXPECT().Commit().Return()
+	db.EXPECT().Commit().Return(nil)
 	db.EXPECT().Get("state", "m-auth.iost-auth-a").DoAndReturn(func(a, b string) (string, error) {
 		ac := account.NewAccount("a")
 		ac.Permissions["pa"] = &account.Permission{
@@ -129,7 +129,7 @@ func TestAuthority_Active(t *testing.T) {
 
 	db, host := myinit(t, ctx)
 
-	db.EXPECT().Commit().Return()
+	db.EXPECT().Commit().Return(nil)
 	db.EXPECT().Get("state", "m-auth.iost-auth-a").DoAndReturn(func(a, b string) (string, error) {
 		ac := account.NewAccount("a")
 		ac.Permissions["active"] = &account.Permission{
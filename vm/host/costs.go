@@ -16,6 +16,7 @@ var (
 		"CodePrice":    contract.NewCost(0, 0, 1),
 		"OpPrice":      contract.NewCost(0, 0, 1),
 		"ErrPrice":     contract.NewCost(0, 0, 1),
+		"VerifyCost":   contract.NewCost(0, 0, 2000),
 	}
 )
 
@@ -44,6 +45,13 @@ func CommonOpCost(layer int) contract.Cost {
 	return Costs["OpPrice"].Multiply(int64(layer * 10))
 }
 
+// CryptoCost returns cost of hashing the data of size and verifying the count of signatures
+func CryptoCost(size int, count int) contract.Cost {
+	cost := Costs["OpPrice"].Multiply(int64(size))
+	cost.AddAssign(Costs["VerifyCost"].Multiply(int64(count)))
+	return cost
+}
+
 // DelayTxCost returns cost of a delay transaction.
 func DelayTxCost(dataLen int, payer string) contract.Cost {
 	cost := Costs["PutCost"]
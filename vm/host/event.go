@@ -3,6 +3,7 @@ package host
 import (
 	"github.com/iost-official/go-iost/core/contract"
 	"github.com/iost-official/go-iost/core/event"
+	"github.com/iost-official/go-iost/core/tx"
 )
 
 // EventPoster the event handler in host
@@ -10,6 +11,11 @@ type EventPoster struct {
 	h *Host
 }
 
+type heldEvent struct {
+	e    *event.Event
+	meta *event.Meta
+}
+
 // NewEventPoster returns a new EventPoster instance.
 func NewEventPoster(h *Host) EventPoster {
 	return EventPoster{h: h}
@@ -17,8 +23,38 @@ func NewEventPoster(h *Host) EventPoster {
 
 // PostEvent post the event
 func (p *EventPoster) PostEvent(data string) contract.Cost {
-	e := event.NewEvent(event.ContractEvent, data)
-	event.GetCollector().Post(e,
-		&event.Meta{ContractID: p.h.Context().Value("contract_name").(string)})
+	p.post(event.NewEvent(event.ContractEvent, data))
 	return EventCost(len(data))
 }
+
+// HoldEvents holds the events posted afterwards until PostHeldEvents, so that they carry the status of tx.
+func (p *EventPoster) HoldEvents() {
+	p.h.ctx.GSet("events", make([]*heldEvent, 0))
+}
+
+// PostHeldEvents posts the held events with the status code of tx.
+func (p *EventPoster) PostHeldEvents(code tx.StatusCode) {
+	events, ok := p.h.ctx.GValue("events").([]*heldEvent)
+	if !ok {
+		return
+	}
+	for _, he := range events {
+		he.meta.StatusCode = int32(code)
+		event.GetCollector().Post(he.e, he.meta)
+	}
+	p.h.ctx.GSet("events", nil)
+}
+
+func (p *EventPoster) post(e *event.Event) {
+	meta := &event.Meta{
+		ContractID: p.h.Context().Value("contract_name").(string),
+	}
+	meta.ActionName, _ = p.h.Context().Value("abi_name").(string)
+	meta.Publisher, _ = p.h.Context().Value("publisher").(string)
+	meta.TxHash, _ = p.h.Context().Value("tx_hash").(string)
+	if events, ok := p.h.ctx.GValue("events").([]*heldEvent); ok {
+		p.h.ctx.GSet("events", append(events, &heldEvent{e, meta}))
+		return
+	}
+	event.GetCollector().Post(e, meta)
+}
@@ -8,6 +8,7 @@ import (
 
 	"encoding/json"
 
+	"github.com/iost-official/go-iost/account"
 	"github.com/iost-official/go-iost/common"
 	"github.com/iost-official/go-iost/core/contract"
 	"github.com/iost-official/go-iost/core/tx"
@@ -140,6 +141,27 @@ func (h *Host) CheckPublisher(t *tx.Tx) error {
 		return fmt.Errorf("unauthorized publisher: %v", t.Publisher)
 	}
 	h.PayCost(c, t.Publisher)
+	return h.checkSponsor(t)
+}
+
+// checkSponsor checks the active permission of the sponsor with its own signatures only,
+// so signing as a sponsor never grants any permission to the actions of tx.
+func (h *Host) checkSponsor(t *tx.Tx) error {
+	if t.Sponsor == "" {
+		return nil
+	}
+	if h.IsContract(t.Sponsor) {
+		return fmt.Errorf("illegal sponsor: %v", t.Sponsor)
+	}
+	authList := make(map[string]int)
+	for _, sig := range t.SponsorSigns {
+		authList[account.GetIDByPubkey(sig.Pubkey)] = 2
+	}
+	b, c := Auth(h.db, t.Sponsor, "active", authList, make(map[string]int))
+	if !b {
+		return fmt.Errorf("unauthorized sponsor: %v", t.Sponsor)
+	}
+	h.PayCost(c, t.Sponsor)
 	return nil
 }
 
@@ -336,8 +358,13 @@ func (h *Host) CancelDelaytx(txHash string) (contract.Cost, error) {
 		return cost, ErrCancelDelayForbid
 	}
 
+	dataLen := len(hashString) + len(publisher)
+	if h.db.GetDelaytxRecurrence(hashString) != nil {
+		h.db.DelDelaytxRecurrence(hashString)
+		dataLen += database.DelaytxRecurrenceSize
+	}
 	h.db.DelDelaytx(hashString)
-	cost.AddAssign(DelDelayTxCost(len(hashString)+len(publisher), publisher))
+	cost.AddAssign(DelDelayTxCost(dataLen, publisher))
 	return cost, nil
 }
 
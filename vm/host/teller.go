@@ -36,7 +36,7 @@ func (t *Teller) GasPayed(publishers ...string) int64 {
 	if len(publishers) > 0 {
 		publisher = publishers[0]
 	} else {
-		publisher = t.h.Context().Value("publisher").(string)
+		publisher = t.h.Context().Value("gas_payer").(string)
 	}
 	v, ok := t.cost[publisher]
 	if !ok {
@@ -82,14 +82,24 @@ func (t *Teller) ClearCacheCost() {
 	t.cacheCost = contract.Cost0()
 }
 
+// sponsored returns the payer charged instead of who, the costs of publisher are charged to the sponsor if any.
+func (t *Teller) sponsored(who string) string {
+	payer, _ := t.h.Context().Value("gas_payer").(string)
+	if payer != "" && who == t.h.Context().Value("publisher") {
+		return payer
+	}
+	return who
+}
+
 // PayCost ...
 func (t *Teller) PayCost(c contract.Cost, who string) {
 	//fmt.Printf("paycost [%v] %v(%v)\n", who, c, c.ToGas())
 	costMap := make(map[string]contract.Cost)
 	if c.CPU > 0 || c.Net > 0 {
-		costMap[who] = contract.Cost{CPU: c.CPU, Net: c.Net}
+		costMap[t.sponsored(who)] = contract.Cost{CPU: c.CPU, Net: c.Net}
 	}
 	for _, item := range c.DataList {
+		item.Payer = t.sponsored(item.Payer)
 		if oc, ok := costMap[item.Payer]; ok {
 			oc.AddAssign(contract.Cost{Data: item.Val, DataList: []contract.DataItem{item}})
 			costMap[item.Payer] = oc
@@ -138,7 +148,7 @@ func (t *Teller) DoPay(witness string, gasRatio int64) (payedGas *common.Fixed,
 			}
 		}
 
-		if payer == t.h.Context().Value("publisher").(string) {
+		if payer == t.h.Context().Value("gas_payer").(string) {
 			payedGas = gas
 		}
 		// contracts in "iost" domain will not pay for ram
@@ -2,6 +2,7 @@ package vm
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -26,10 +27,18 @@ type Isolator struct {
 	blockBaseCtx  *host.Context
 	genesisMode   bool
 	blockBaseMode bool
+	actionReceipt bool
+	limits        *tx.Limits
 }
 
 var staticMonitor = NewMonitor()
 
+// errors of tx sequence
+var (
+	ErrSequenceUsed = errors.New("sequence of publisher is used")
+	ErrSequenceGap  = errors.New("sequence of publisher is ahead of the next one")
+)
+
 // TriggerBlockBaseMode start blockbase mode
 func (i *Isolator) TriggerBlockBaseMode() {
 	i.blockBaseMode = true
@@ -45,11 +54,13 @@ func (i *Isolator) Prepare(bh *block.BlockHead, db *database.Visitor, logger *il
 	} else {
 		i.genesisMode = false
 	}
+	i.actionReceipt = common.IsActionReceiptFork(bh.Number)
 
 	i.blockBaseCtx = host.NewContext(nil)
 	i.blockBaseCtx = loadBlkInfo(i.blockBaseCtx, bh)
 	i.h = host.NewHost(i.blockBaseCtx, db, staticMonitor, logger)
 	i.h.ReadSettings()
+	i.limits = db.TxLimits()
 	return nil
 }
 
@@ -59,17 +70,17 @@ func (i *Isolator) PrepareTx(t *tx.Tx, limit time.Duration) error {
 	i.h.SetDeadline(time.Now().Add(limit))
 	i.publisherID = t.Publisher
 	l := len(t.Encode())
-	i.h.PayCost(contract.NewCost(0, int64(l), 0), t.Publisher)
+	i.h.PayCost(contract.NewCost(0, int64(l), 0), t.Payer())
 
 	if !i.genesisMode && !i.blockBaseMode {
-		err := checkTxParams(t)
+		err := checkTxParams(t, i.limits)
 		if err != nil {
 			return err
 		}
-		if i.h.GasPayed(t.Publisher)*t.GasRatio >= t.GasLimit {
+		if i.h.GasPayed(t.Payer())*t.GasRatio >= t.GasLimit {
 			return fmt.Errorf("gas limit should be larger")
 		}
-		gas := i.h.TotalGas(i.publisherID)
+		gas := i.h.TotalGas(t.Payer())
 		err = CheckTxGasLimitValid(t, gas, i.h.DB())
 		if err != nil {
 			return err
@@ -81,6 +92,25 @@ func (i *Isolator) PrepareTx(t *tx.Tx, limit time.Duration) error {
 		if err != nil {
 			return err
 		}
+		err = i.checkSequence(t)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkSequence checks that the sequenced tx is the next one of its publisher.
+func (i *Isolator) checkSequence(t *tx.Tx) error {
+	if t.Sequence == 0 {
+		return nil
+	}
+	next := i.h.DB().Sequence(t.Publisher) + 1
+	switch {
+	case t.Sequence < next:
+		return ErrSequenceUsed
+	case t.Sequence > next:
+		return ErrSequenceGap
 	}
 	return nil
 }
@@ -164,17 +194,27 @@ func (i *Isolator) Run() (*tx.TxReceipt, error) { // nolint
 	}
 	i.h.Context().GSet("gas_limit", vmGasLimit)
 	i.h.Context().GSet("receipts", make([]*tx.Receipt, 0))
+	i.h.HoldEvents()
 
 	i.tr = tx.NewTxReceipt(i.t.Hash())
 
 	if i.t.Delay > 0 {
 		txHash := string(i.t.Hash())
 		i.h.DB().StoreDelaytx(txHash, i.publisherID)
+		dataLen := len(txHash) + len(i.publisherID)
+		if i.t.Occurrences() > 1 {
+			i.h.DB().StoreDelaytxRecurrence(txHash, &database.DelaytxRecurrence{
+				Next:      i.t.DeferTime(0),
+				Interval:  i.t.RecurInterval,
+				Remaining: i.t.Occurrences(),
+			})
+			dataLen += database.DelaytxRecurrenceSize
+		}
 		i.tr.Status = &tx.Status{
 			Code:    tx.Success,
 			Message: "",
 		}
-		cost := host.DelayTxCost(len(txHash)+len(i.publisherID), i.publisherID)
+		cost := host.DelayTxCost(dataLen, i.publisherID)
 		i.h.PayCost(cost, i.publisherID)
 		return i.tr, nil
 	}
@@ -184,16 +224,32 @@ func (i *Isolator) Run() (*tx.TxReceipt, error) { // nolint
 		if !i.h.DB().HasDelaytx(refTxHash) {
 			return nil, fmt.Errorf("delay tx not found, hash=%v", i.t.ReferredTx)
 		}
+		recurrence := i.h.DB().GetDelaytxRecurrence(refTxHash)
+		if recurrence != nil && recurrence.Next != i.t.Time {
+			return nil, fmt.Errorf("delay tx occurrence not matched, hash=%v, next=%v", i.t.ReferredTx, recurrence.Next)
+		}
 
 		// the delaytx should be deleted even the tx is excuted failed.
 		// use defer func so the delete operation would not be reverted by i.h.DB().Rollback().
+		// the recurring one is kept until its last occurrence.
 		defer func() {
+			if recurrence != nil && recurrence.Remaining > 1 {
+				recurrence.Next += recurrence.Interval
+				recurrence.Remaining--
+				i.h.DB().StoreDelaytxRecurrence(refTxHash, recurrence)
+				return
+			}
+			dataLen := len(refTxHash) + len(i.publisherID)
+			if recurrence != nil {
+				i.h.DB().DelDelaytxRecurrence(refTxHash)
+				dataLen += database.DelaytxRecurrenceSize
+			}
 			i.h.DB().DelDelaytx(refTxHash)
-			cost := host.DelDelayTxCost(len(refTxHash)+len(i.publisherID), i.publisherID)
+			cost := host.DelDelayTxCost(dataLen, i.publisherID)
 			i.h.PayCost(cost, i.publisherID)
 		}()
 
-		if i.t.IsExpired(i.blockBaseCtx.Value("time").(int64)) {
+		if i.t.IsExpired(i.blockBaseCtx.Value("time").(int64), i.limits) {
 			i.tr.Status = &tx.Status{
 				Code:    tx.Success,
 				Message: "transaction expired",
@@ -216,8 +272,8 @@ func (i *Isolator) Run() (*tx.TxReceipt, error) { // nolint
 		actionCost.AddAssign(contract.NewCost(0, int64(len(ret)), 0))
 		if (status.Code == tx.ErrorRuntime && status.Message == "out of gas") ||
 			(vmGasLimit < actionCost.ToGas()) ||
-			(!i.genesisMode && !i.blockBaseMode && i.h.TotalGas(i.t.Publisher).Value/i.t.GasRatio < i.h.GasPayed()+vmGasLimit) {
-			ilog.Errorf("out of gas vmGasLimit %v actionCost %v totalGas %v gasPayed %v", vmGasLimit, actionCost.ToGas(), i.h.TotalGas(i.t.Publisher).ToString(), i.h.GasPayed())
+			(!i.genesisMode && !i.blockBaseMode && i.h.TotalGas(i.t.Payer()).Value/i.t.GasRatio < i.h.GasPayed()+vmGasLimit) {
+			ilog.Errorf("out of gas vmGasLimit %v actionCost %v totalGas %v gasPayed %v", vmGasLimit, actionCost.ToGas(), i.h.TotalGas(i.t.Payer()).ToString(), i.h.GasPayed())
 			status.Code = tx.ErrorRuntime
 			status.Message = "out of gas"
 			actionCost.CPU = vmGasLimit
@@ -230,6 +286,14 @@ func (i *Isolator) Run() (*tx.TxReceipt, error) { // nolint
 		}
 
 		i.h.PayCost(actionCost, i.publisherID)
+		// the actions before a failed one are reported as they were executed, though all of them are rolled back
+		if i.actionReceipt {
+			i.tr.ActionReceipts = append(i.tr.ActionReceipts, &tx.ActionReceipt{
+				Status:   &tx.Status{Code: status.Code, Message: status.Message},
+				Return:   ret,
+				GasUsage: actionCost.ToGas() * i.t.GasRatio,
+			})
+		}
 
 		if status.Code != tx.Success {
 			ilog.Warnf("isolator run action %v failed, status %v, will rollback", action, status)
@@ -253,15 +317,14 @@ func (i *Isolator) PayCost() (*tx.TxReceipt, error) {
 	if i.t.GasLimit < i.h.GasPayed()*i.t.GasRatio {
 		ilog.Fatalf("total gas cost is above limit %v < %v * %v", i.t.GasLimit, i.h.GasPayed(), i.t.GasRatio)
 	}
+	if i.t.Sponsor != "" && i.h.Costs()[i.t.Sponsor].Data > i.t.SponsorRAMLimit {
+		ilog.Warnf("ram usage above sponsor limit, rollback %v > %v", i.h.Costs()[i.t.Sponsor].Data, i.t.SponsorRAMLimit)
+		i.rollbackRAM(tx.ErrorBalanceNotEnough, fmt.Sprintf("ram usage above sponsor limit %v", i.t.SponsorRAMLimit))
+	}
 	payedGas, err := i.h.DoPay(i.h.Context().Value("witness").(string), i.t.GasRatio)
 	if err != nil {
 		ilog.Errorf("DoPay failed, rollback %v", err)
-		i.h.DB().Rollback()
-
-		i.h.ClearRAMCosts()
-		i.tr.RAMUsage = make(map[string]int64)
-		i.tr.Status.Code = tx.ErrorBalanceNotEnough
-		i.tr.Status.Message = "balance not enough after executing actions: " + err.Error()
+		i.rollbackRAM(tx.ErrorBalanceNotEnough, "balance not enough after executing actions: "+err.Error())
 		payedGas, err = i.h.DoPay(i.h.Context().Value("witness").(string), i.t.GasRatio)
 		if err != nil {
 			return nil, err
@@ -273,13 +336,29 @@ func (i *Isolator) PayCost() (*tx.TxReceipt, error) {
 			i.tr.RAMUsage[k] = v.Data
 		}
 	}
+	// the sequence is used even if the tx failed, so it's set after all the rollbacks
+	if i.t.Sequence != 0 {
+		i.h.DB().SetSequence(i.t.Publisher, i.t.Sequence)
+	}
 
 	return i.tr, nil
 }
 
-// Commit flush changes to db
+// rollbackRAM reverts the changes of tx and the ram costs with it, only the gas costs are left to pay.
+func (i *Isolator) rollbackRAM(code tx.StatusCode, message string) {
+	i.h.DB().Rollback()
+	i.h.ClearRAMCosts()
+	i.tr.RAMUsage = make(map[string]int64)
+	i.tr.Status.Code = code
+	i.tr.Status.Message = message
+}
+
+// Commit flush changes to db, and posts the events of tx with its final status settled by PayCost
 func (i *Isolator) Commit() {
 	i.h.DB().Commit()
+	if i.tr != nil {
+		i.h.PostHeldEvents(i.tr.Status.Code)
+	}
 }
 
 // ClearAll clear this isolator
@@ -295,8 +374,13 @@ func (i *Isolator) ClearTx() {
 	i.h.ClearCosts()
 	i.h.DB().Rollback()
 }
-func checkTxParams(t *tx.Tx) error {
-	return t.CheckGas()
+func checkTxParams(t *tx.Tx, l *tx.Limits) error {
+	return t.CheckGas(l)
+}
+
+// TxLimits returns the tx limits of the state the isolator is prepared with.
+func (i *Isolator) TxLimits() *tx.Limits {
+	return i.limits
 }
 
 func loadBlkInfo(ctx *host.Context, bh *block.BlockHead) *host.Context {
@@ -318,6 +402,7 @@ func loadTxInfo(h *host.Host, t *tx.Tx, publisherID string) {
 	h.Context().Set("gas_ratio", t.GasRatio)
 	h.Context().Set("tx_hash", common.Base58Encode(t.Hash()))
 	h.Context().Set("publisher", publisherID)
+	h.Context().Set("gas_payer", t.Payer())
 	h.Context().Set("amount_limit", t.AmountLimit)
 
 	authList := make(map[string]int)
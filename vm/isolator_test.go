@@ -0,0 +1,33 @@
+package vm
+
+import (
+	"testing"
+
+	"github.com/iost-official/go-iost/core/tx"
+	"github.com/iost-official/go-iost/vm/host"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckSequence(t *testing.T) {
+	vi, mvccdb := ininit(t)
+	defer closeMVCCDB(mvccdb)
+	vi.SetSequence("alice", 2)
+	i := &Isolator{h: host.NewHost(host.NewContext(nil), vi, nil, nil)}
+
+	newTx := func(sequence int64) *tx.Tx {
+		t := tx.NewTx([]*tx.Action{}, nil, 10000, 100, 10000, 0)
+		t.Publisher = "alice"
+		t.Sequence = sequence
+		return t
+	}
+	assert.Nil(t, i.checkSequence(newTx(0)))
+	assert.Equal(t, ErrSequenceUsed, i.checkSequence(newTx(1)))
+	assert.Equal(t, ErrSequenceUsed, i.checkSequence(newTx(2)))
+	assert.Nil(t, i.checkSequence(newTx(3)))
+	assert.Equal(t, ErrSequenceGap, i.checkSequence(newTx(4)))
+
+	// the held tx becomes the next one once the gap is filled
+	vi.SetSequence("alice", 3)
+	assert.Nil(t, i.checkSequence(newTx(4)))
+	assert.Equal(t, ErrSequenceUsed, i.checkSequence(newTx(3)))
+}
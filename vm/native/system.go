@@ -1,15 +1,37 @@
 package native
 
 import (
+	"encoding/base64"
 	"errors"
 
 	"encoding/json"
 
 	"github.com/bitly/go-simplejson"
+	"github.com/iost-official/go-iost/core/block"
 	"github.com/iost-official/go-iost/core/contract"
+	"github.com/iost-official/go-iost/vm/database"
 	"github.com/iost-official/go-iost/vm/host"
 )
 
+// errors of tx limits
+var (
+	ErrNoAdmin = errors.New("admin of chain not found")
+)
+
+// chainAdmin returns the admin of chain, which is set in base.iost by the genesis.
+func chainAdmin(h *host.Host) (string, contract.Cost) {
+	v, cost := h.GlobalGet("base.iost", "adminID")
+	s, ok := v.(string)
+	if !ok {
+		return "", cost
+	}
+	var admin string
+	if err := json.Unmarshal([]byte(s), &admin); err != nil {
+		return "", cost
+	}
+	return admin, cost
+}
+
 var systemABIs *abiSet
 
 func init() {
@@ -22,6 +44,8 @@ func init() {
 	systemABIs.Register(initSetCode)
 	systemABIs.Register(cancelDelaytx)
 	systemABIs.Register(hostSettings)
+	systemABIs.Register(setTxLimits)
+	systemABIs.Register(verifyDoubleSign)
 }
 
 // var .
@@ -172,4 +196,70 @@ var (
 			return nil, cost, nil
 		},
 	}
+
+	// setTxLimits sets the tx limits of chain, the fields not given are kept. only the admin of chain can do this.
+	setTxLimits = &abi{
+		name: "SetTxLimits",
+		args: []string{"string"},
+		do: func(h *host.Host, args ...interface{}) (rtn []interface{}, cost contract.Cost, err error) {
+			admin, cost := chainAdmin(h)
+			if admin == "" {
+				return nil, cost, ErrNoAdmin
+			}
+			ok, cost0 := h.RequireAuth(admin, "active")
+			cost.AddAssign(cost0)
+			if !ok {
+				return nil, cost, host.ErrPermissionLost
+			}
+			l := h.DB().TxLimits()
+			if err = json.Unmarshal([]byte(args[0].(string)), l); err != nil {
+				return nil, cost, err
+			}
+			if err = l.Validate(); err != nil {
+				return nil, cost, err
+			}
+			b, err := json.Marshal(l)
+			if err != nil {
+				return nil, cost, err
+			}
+			cost0, err = h.MapPut("settings", database.TxLimitsField, string(b))
+			cost.AddAssign(cost0)
+			return []interface{}{}, cost, err
+		},
+	}
+
+	// verifyDoubleSign verifies the base64 encoded evidence of double signing, and returns the witness, slot and number of it.
+	verifyDoubleSign = &abi{
+		name: "VerifyDoubleSign",
+		args: []string{"string"},
+		do: func(h *host.Host, args ...interface{}) (rtn []interface{}, cost contract.Cost, err error) {
+			cost = host.CommonOpCost(2)
+			s := args[0].(string)
+			if len(s) > base64.StdEncoding.EncodedLen(block.MaxEvidenceSize) {
+				return nil, cost, block.ErrEvidenceSize
+			}
+			// both the heads are hashed and their signatures are verified
+			cost.AddAssign(host.CryptoCost(len(s), 2))
+			b, err := base64.StdEncoding.DecodeString(s)
+			if err != nil {
+				return nil, cost, err
+			}
+			e := &block.DoubleSignEvidence{}
+			if err = e.Decode(b); err != nil {
+				return nil, cost, err
+			}
+			if err = e.Verify(); err != nil {
+				return nil, cost, err
+			}
+			j, err := json.Marshal(map[string]interface{}{
+				"witness": e.Witness(),
+				"slot":    e.Slot(),
+				"number":  e.Head1.Number,
+			})
+			if err != nil {
+				return nil, cost, err
+			}
+			return []interface{}{string(j)}, cost, nil
+		},
+	}
 )
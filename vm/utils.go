@@ -14,7 +14,7 @@ func CheckTxGasLimitValid(t *tx.Tx, currentGas *common.Fixed, dbVisitor *databas
 	if !currentGas.LessThan(gasLimit) {
 		return nil
 	}
-	defaultErr := fmt.Errorf("gas not enough: user %v has %v < %v", t.Publisher, currentGas.ToString(), gasLimit.ToString())
+	defaultErr := fmt.Errorf("gas not enough: user %v has %v < %v", t.Payer(), currentGas.ToString(), gasLimit.ToString())
 	if !(len(t.Actions) == 1 && t.Actions[0].Contract == native.GasContractName && t.Actions[0].ActionName == "pledge") {
 		return defaultErr
 	}